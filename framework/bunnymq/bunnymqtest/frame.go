@@ -0,0 +1,256 @@
+package bunnymqtest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Frame types of AMQP 0-9-1.
+const (
+	frameMethod    = 1
+	frameHeader    = 2
+	frameBody      = 3
+	frameHeartbeat = 8
+	frameEnd       = 0xCE
+)
+
+// frame is a frame read from or written to a client.
+type frame struct {
+	typ     byte
+	channel uint16
+	payload []byte
+}
+
+func readFrame(r *bufio.Reader) (frame, error) {
+	var head [7]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return frame{}, err
+	}
+
+	f := frame{
+		typ:     head[0],
+		channel: binary.BigEndian.Uint16(head[1:3]),
+		payload: make([]byte, binary.BigEndian.Uint32(head[3:7])),
+	}
+	if _, err := io.ReadFull(r, f.payload); err != nil {
+		return frame{}, err
+	}
+
+	end, err := r.ReadByte()
+	if err != nil {
+		return frame{}, err
+	}
+	if end != frameEnd {
+		return frame{}, fmt.Errorf("bunnymqtest: bad frame end %#x", end)
+	}
+	return f, nil
+}
+
+func writeFrame(w io.Writer, f frame) error {
+	buf := make([]byte, 0, len(f.payload)+8)
+	buf = append(buf, f.typ)
+	buf = binary.BigEndian.AppendUint16(buf, f.channel)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(f.payload)))
+	buf = append(buf, f.payload...)
+	buf = append(buf, frameEnd)
+
+	_, err := w.Write(buf)
+	return err
+}
+
+// method is the payload of a method frame.
+type method struct {
+	class, id uint16
+}
+
+// args decodes the arguments of a method frame.
+type args struct {
+	r   *bytes.Reader
+	err error
+}
+
+func (a *args) read(n int) []byte {
+	buf := make([]byte, n)
+	if a.err == nil {
+		_, a.err = io.ReadFull(a.r, buf)
+	}
+	return buf
+}
+
+func (a *args) octet() byte      { return a.read(1)[0] }
+func (a *args) short() uint16    { return binary.BigEndian.Uint16(a.read(2)) }
+func (a *args) long() uint32     { return binary.BigEndian.Uint32(a.read(4)) }
+func (a *args) longlong() uint64 { return binary.BigEndian.Uint64(a.read(8)) }
+
+func (a *args) shortstr() string { return string(a.read(int(a.octet()))) }
+func (a *args) longstr() string  { return string(a.read(int(a.long()))) }
+
+// bits reads n consecutive bit arguments packed in one octet.
+func (a *args) bits(n int) []bool {
+	octet := a.octet()
+	result := make([]bool, n)
+	for i := range result {
+		result[i] = octet&(1<<i) != 0
+	}
+	return result
+}
+
+func (a *args) table() amqp.Table {
+	data := a.read(int(a.long()))
+	if a.err != nil {
+		return nil
+	}
+
+	inner := &args{r: bytes.NewReader(data)}
+	table := amqp.Table{}
+	for inner.r.Len() > 0 && inner.err == nil {
+		key := inner.shortstr()
+		table[key] = inner.field()
+	}
+	if inner.err != nil {
+		a.err = inner.err
+	}
+	return table
+}
+
+func (a *args) field() any {
+	switch kind := a.octet(); kind {
+	case 't':
+		return a.octet() != 0
+	case 'B':
+		return a.octet()
+	case 'b':
+		return int8(a.octet())
+	case 's':
+		return int16(a.short())
+	case 'I':
+		return int32(a.long())
+	case 'l':
+		return int64(a.longlong())
+	case 'f':
+		return math.Float32frombits(a.long())
+	case 'd':
+		return math.Float64frombits(a.longlong())
+	case 'S':
+		return a.longstr()
+	case 'x':
+		return a.read(int(a.long()))
+	case 'T':
+		return time.Unix(int64(a.longlong()), 0)
+	case 'F':
+		return a.table()
+	case 'A':
+		data := a.read(int(a.long()))
+		inner := &args{r: bytes.NewReader(data)}
+		var values []any
+		for inner.r.Len() > 0 && inner.err == nil {
+			values = append(values, inner.field())
+		}
+		if inner.err != nil {
+			a.err = inner.err
+		}
+		return values
+	case 'V':
+		return nil
+	default:
+		if a.err == nil {
+			a.err = fmt.Errorf("bunnymqtest: unsupported field type %q", kind)
+		}
+		return nil
+	}
+}
+
+// encoder builds the arguments of a method frame sent to a client.
+type encoder struct {
+	buf []byte
+}
+
+func newMethod(m method) *encoder {
+	e := &encoder{}
+	return e.short(m.class).short(m.id)
+}
+
+func (e *encoder) octet(v byte) *encoder {
+	e.buf = append(e.buf, v)
+	return e
+}
+
+func (e *encoder) short(v uint16) *encoder {
+	e.buf = binary.BigEndian.AppendUint16(e.buf, v)
+	return e
+}
+
+func (e *encoder) long(v uint32) *encoder {
+	e.buf = binary.BigEndian.AppendUint32(e.buf, v)
+	return e
+}
+
+func (e *encoder) longlong(v uint64) *encoder {
+	e.buf = binary.BigEndian.AppendUint64(e.buf, v)
+	return e
+}
+
+func (e *encoder) shortstr(v string) *encoder {
+	e.buf = append(append(e.buf, byte(len(v))), v...)
+	return e
+}
+
+func (e *encoder) longstr(v string) *encoder {
+	e.buf = append(binary.BigEndian.AppendUint32(e.buf, uint32(len(v))), v...)
+	return e
+}
+
+// table encodes the subset of field types the server sends: booleans, strings and tables.
+func (e *encoder) table(t amqp.Table) *encoder {
+	inner := &encoder{}
+	for k, v := range t {
+		inner.shortstr(k)
+		switch v := v.(type) {
+		case bool:
+			inner.octet('t')
+			if v {
+				inner.octet(1)
+			} else {
+				inner.octet(0)
+			}
+		case string:
+			inner.octet('S').longstr(v)
+		case amqp.Table:
+			inner.octet('F').table(v)
+		default:
+			panic(fmt.Sprintf("bunnymqtest: cannot encode %T", v))
+		}
+	}
+	e.long(uint32(len(inner.buf)))
+	e.buf = append(e.buf, inner.buf...)
+	return e
+}
+
+func (e *encoder) frame(channel uint16) frame {
+	return frame{typ: frameMethod, channel: channel, payload: e.buf}
+}
+
+// parseMethod splits a method frame payload into the method and its arguments.
+func parseMethod(payload []byte) (method, *args, error) {
+	if len(payload) < 4 {
+		return method{}, nil, errors.New("bunnymqtest: short method frame")
+	}
+	m := method{class: binary.BigEndian.Uint16(payload[0:2]), id: binary.BigEndian.Uint16(payload[2:4])}
+	return m, &args{r: bytes.NewReader(payload[4:])}, nil
+}
+
+// bodySize returns the body size announced by a content header frame payload.
+func bodySize(payload []byte) (uint64, error) {
+	if len(payload) < 12 {
+		return 0, errors.New("bunnymqtest: short content header")
+	}
+	return binary.BigEndian.Uint64(payload[4:12]), nil
+}
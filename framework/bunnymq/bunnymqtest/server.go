@@ -0,0 +1,548 @@
+// Package bunnymqtest provides an in-memory AMQP 0-9-1 broker for tests.
+//
+// The broker understands enough of the protocol for bunnymq and the publisher: declaring
+// exchanges, queues and bindings, and publishing with mandatory routing and publisher confirms.
+// Messages are counted per queue but never delivered; consuming is not supported.
+// Topic exchanges match * and #, headers exchanges route like fanout exchanges.
+package bunnymqtest
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+var (
+	connectionStart   = method{10, 10}
+	connectionStartOk = method{10, 11}
+	connectionTune    = method{10, 30}
+	connectionTuneOk  = method{10, 31}
+	connectionOpen    = method{10, 40}
+	connectionOpenOk  = method{10, 41}
+	connectionClose   = method{10, 50}
+	connectionCloseOk = method{10, 51}
+	channelOpen       = method{20, 10}
+	channelOpenOk     = method{20, 11}
+	channelClose      = method{20, 40}
+	channelCloseOk    = method{20, 41}
+	exchangeDeclare   = method{40, 10}
+	exchangeDeclareOk = method{40, 11}
+	queueDeclare      = method{50, 10}
+	queueDeclareOk    = method{50, 11}
+	queueBind         = method{50, 20}
+	queueBindOk       = method{50, 21}
+	basicQos          = method{60, 10}
+	basicQosOk        = method{60, 11}
+	basicPublish      = method{60, 40}
+	basicReturn       = method{60, 50}
+	basicAck          = method{60, 80}
+	confirmSelect     = method{85, 10}
+	confirmSelectOk   = method{85, 11}
+)
+
+// Server is an in-memory AMQP broker listening on a local port.
+type Server struct {
+	ln net.Listener
+
+	mu        sync.Mutex
+	conns     map[net.Conn]struct{}
+	exchanges map[string]string     // name -> kind
+	queues    map[string]amqp.Table // name -> arguments
+	bindings  []binding
+	messages  map[string]int // queue -> routed messages
+}
+
+type binding struct {
+	queue, exchange, key string
+}
+
+// NewServer starts a broker that is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("bunnymqtest: listen: %v", err)
+	}
+
+	s := &Server{ln: ln, conns: map[net.Conn]struct{}{}}
+	s.reset()
+	go s.serve()
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// URL returns the AMQP URL of the broker.
+func (s *Server) URL() string {
+	return "amqp://guest:guest@" + s.ln.Addr().String() + "/"
+}
+
+// Close stops the broker and closes every client connection.
+func (s *Server) Close() {
+	_ = s.ln.Close()
+	s.closeConnections()
+}
+
+// Restart closes every client connection and forgets all declarations and messages,
+// like a broker node that lost its state. Clients can connect again right away.
+func (s *Server) Restart() {
+	s.closeConnections()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reset()
+}
+
+// Connections returns the number of open client connections.
+func (s *Server) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.conns)
+}
+
+// Exchange returns the kind of a declared exchange.
+func (s *Server) Exchange(name string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kind, ok := s.exchanges[name]
+	return kind, ok
+}
+
+// Queue returns the arguments a queue was declared with.
+func (s *Server) Queue(name string) (amqp.Table, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	args, ok := s.queues[name]
+	return args, ok
+}
+
+// Bound reports whether queue is bound to exchange with the routing key.
+func (s *Server) Bound(queue, exchange, key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range s.bindings {
+		if b == (binding{queue, exchange, key}) {
+			return true
+		}
+	}
+	return false
+}
+
+// Messages returns the number of messages routed to queue.
+func (s *Server) Messages(queue string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.messages[queue]
+}
+
+func (s *Server) reset() {
+	s.exchanges = map[string]string{}
+	s.queues = map[string]amqp.Table{}
+	s.bindings = nil
+	s.messages = map[string]int{}
+}
+
+func (s *Server) closeConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for c := range s.conns {
+		_ = c.Close()
+	}
+}
+
+func (s *Server) serve() {
+	for {
+		c, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.conns[c] = struct{}{}
+		s.mu.Unlock()
+
+		go func() {
+			defer func() {
+				_ = c.Close()
+				s.mu.Lock()
+				delete(s.conns, c)
+				s.mu.Unlock()
+			}()
+			_ = s.handle(c)
+		}()
+	}
+}
+
+// session is the state of one client connection.
+type session struct {
+	s        *Server
+	w        io.Writer
+	channels map[uint16]*channelState
+}
+
+type channelState struct {
+	confirm bool
+	tag     uint64
+	closing bool // A channel.close was sent, frames are ignored until the client's close-ok
+	publish *publishing
+}
+
+// publishing is a basic.publish waiting for its content.
+type publishing struct {
+	exchange, key string
+	mandatory     bool
+	header        []byte
+	body          []byte
+	size          uint64
+}
+
+func (s *Server) handle(c net.Conn) error {
+	r := bufio.NewReader(c)
+
+	protocol := make([]byte, 8)
+	if _, err := io.ReadFull(r, protocol); err != nil {
+		return err
+	}
+	if !bytes.Equal(protocol, []byte("AMQP\x00\x00\x09\x01")) {
+		_, err := c.Write([]byte("AMQP\x00\x00\x09\x01"))
+		return err
+	}
+
+	ss := &session{s: s, w: c, channels: map[uint16]*channelState{}}
+	start := newMethod(connectionStart).octet(0).octet(9).
+		table(amqp.Table{
+			"product":      "bunnymqtest",
+			"capabilities": amqp.Table{"publisher_confirms": true, "basic.nack": true},
+		}).
+		longstr("PLAIN AMQPLAIN").
+		longstr("en_US")
+	if err := writeFrame(c, start.frame(0)); err != nil {
+		return err
+	}
+
+	for {
+		f, err := readFrame(r)
+		if err != nil {
+			return err
+		}
+
+		var done bool
+		switch f.typ {
+		case frameMethod:
+			done, err = ss.method(f)
+		case frameHeader, frameBody:
+			err = ss.content(f)
+		case frameHeartbeat:
+		}
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// method handles a method frame and reports whether the connection is closed.
+func (ss *session) method(f frame) (bool, error) {
+	m, a, err := parseMethod(f.payload)
+	if err != nil {
+		return true, err
+	}
+
+	if f.channel == 0 {
+		switch m {
+		case connectionStartOk:
+			return false, ss.send(newMethod(connectionTune).short(2047).long(131072).short(0).frame(0))
+		case connectionTuneOk:
+			return false, nil
+		case connectionOpen:
+			return false, ss.send(newMethod(connectionOpenOk).shortstr("").frame(0))
+		case connectionClose:
+			return true, ss.send(newMethod(connectionCloseOk).frame(0))
+		case connectionCloseOk:
+			return true, nil
+		}
+		return false, nil
+	}
+
+	switch m {
+	case channelOpen:
+		ss.channels[f.channel] = &channelState{}
+		return false, ss.send(newMethod(channelOpenOk).longstr("").frame(f.channel))
+	case channelClose:
+		delete(ss.channels, f.channel)
+		return false, ss.send(newMethod(channelCloseOk).frame(f.channel))
+	case channelCloseOk:
+		delete(ss.channels, f.channel)
+		return false, nil
+	}
+
+	ch, ok := ss.channels[f.channel]
+	if !ok || ch.closing {
+		return false, nil
+	}
+
+	switch m {
+	case confirmSelect:
+		noWait := a.bits(1)[0]
+		ch.confirm = true
+		if noWait {
+			return false, nil
+		}
+		return false, ss.send(newMethod(confirmSelectOk).frame(f.channel))
+
+	case exchangeDeclare:
+		a.short()
+		name, kind := a.shortstr(), a.shortstr()
+		flags := a.bits(5) // passive, durable, auto-delete, internal, no-wait
+		a.table()
+		if a.err != nil {
+			return true, a.err
+		}
+		if code, text := ss.s.declareExchange(name, kind, flags[0]); code != 0 {
+			return false, ss.fail(f.channel, ch, m, code, text)
+		}
+		if flags[4] {
+			return false, nil
+		}
+		return false, ss.send(newMethod(exchangeDeclareOk).frame(f.channel))
+
+	case queueDeclare:
+		a.short()
+		name := a.shortstr()
+		flags := a.bits(5) // passive, durable, exclusive, auto-delete, no-wait
+		arguments := a.table()
+		if a.err != nil {
+			return true, a.err
+		}
+		count, code, text := ss.s.declareQueue(name, arguments, flags[0])
+		if code != 0 {
+			return false, ss.fail(f.channel, ch, m, code, text)
+		}
+		if flags[4] {
+			return false, nil
+		}
+		return false, ss.send(newMethod(queueDeclareOk).shortstr(name).long(uint32(count)).long(0).frame(f.channel))
+
+	case queueBind:
+		a.short()
+		queue, exchange, key := a.shortstr(), a.shortstr(), a.shortstr()
+		noWait := a.bits(1)[0]
+		a.table()
+		if a.err != nil {
+			return true, a.err
+		}
+		if code, text := ss.s.bind(binding{queue, exchange, key}); code != 0 {
+			return false, ss.fail(f.channel, ch, m, code, text)
+		}
+		if noWait {
+			return false, nil
+		}
+		return false, ss.send(newMethod(queueBindOk).frame(f.channel))
+
+	case basicQos:
+		return false, ss.send(newMethod(basicQosOk).frame(f.channel))
+
+	case basicPublish:
+		a.short()
+		exchange, key := a.shortstr(), a.shortstr()
+		flags := a.bits(2) // mandatory, immediate
+		if a.err != nil {
+			return true, a.err
+		}
+		ch.publish = &publishing{exchange: exchange, key: key, mandatory: flags[0]}
+		return false, nil
+	}
+
+	return false, ss.fail(f.channel, ch, m, 540, "NOT_IMPLEMENTED")
+}
+
+// content collects the header and body of a publishing and handles it once complete.
+func (ss *session) content(f frame) error {
+	ch, ok := ss.channels[f.channel]
+	if !ok || ch.closing || ch.publish == nil {
+		return nil
+	}
+	p := ch.publish
+
+	if f.typ == frameHeader {
+		size, err := bodySize(f.payload)
+		if err != nil {
+			return err
+		}
+		p.header, p.size = f.payload, size
+	} else {
+		p.body = append(p.body, f.payload...)
+	}
+	if p.header == nil || uint64(len(p.body)) < p.size {
+		return nil
+	}
+
+	ch.publish = nil
+	return ss.published(f.channel, ch, p)
+}
+
+// published routes a complete publishing, returns it when mandatory and unroutable, then confirms it.
+// Like RabbitMQ, the return is sent before the confirm.
+func (ss *session) published(channel uint16, ch *channelState, p *publishing) error {
+	queues, ok := ss.s.route(p.exchange, p.key)
+	if !ok {
+		return ss.fail(channel, ch, basicPublish, 404, "NOT_FOUND - no exchange '"+p.exchange+"'")
+	}
+
+	if len(queues) == 0 && p.mandatory {
+		ret := newMethod(basicReturn).short(312).shortstr("NO_ROUTE").shortstr(p.exchange).shortstr(p.key)
+		if err := ss.send(ret.frame(channel)); err != nil {
+			return err
+		}
+		if err := ss.send(frame{typ: frameHeader, channel: channel, payload: p.header}); err != nil {
+			return err
+		}
+		if len(p.body) > 0 {
+			if err := ss.send(frame{typ: frameBody, channel: channel, payload: p.body}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !ch.confirm {
+		return nil
+	}
+	ch.tag++
+	return ss.send(newMethod(basicAck).longlong(ch.tag).octet(0).frame(channel))
+}
+
+// fail closes the channel with a channel exception, as the broker does on a failed method.
+func (ss *session) fail(channel uint16, ch *channelState, m method, code uint16, text string) error {
+	ch.closing = true
+	return ss.send(newMethod(channelClose).short(code).shortstr(text).short(m.class).short(m.id).frame(channel))
+}
+
+func (ss *session) send(f frame) error {
+	return writeFrame(ss.w, f)
+}
+
+func (s *Server) declareExchange(name, kind string, passive bool) (uint16, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.exchanges[name]
+	switch {
+	case passive && !ok:
+		return 404, "NOT_FOUND - no exchange '" + name + "'"
+	case passive:
+	case ok && existing != kind:
+		return 406, "PRECONDITION_FAILED - inequivalent arg 'type' for exchange '" + name + "'"
+	default:
+		s.exchanges[name] = kind
+	}
+	return 0, ""
+}
+
+func (s *Server) declareQueue(name string, args amqp.Table, passive bool) (int, uint16, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(args) == 0 {
+		args = nil
+	}
+	existing, ok := s.queues[name]
+	switch {
+	case passive && !ok:
+		return 0, 404, "NOT_FOUND - no queue '" + name + "'"
+	case passive:
+	case ok && !reflect.DeepEqual(existing, args):
+		return 0, 406, "PRECONDITION_FAILED - inequivalent arg for queue '" + name + "'"
+	default:
+		s.queues[name] = args
+	}
+	return s.messages[name], 0, ""
+}
+
+func (s *Server) bind(b binding) (uint16, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.exchanges[b.exchange]; !ok {
+		return 404, "NOT_FOUND - no exchange '" + b.exchange + "'"
+	}
+	if _, ok := s.queues[b.queue]; !ok {
+		return 404, "NOT_FOUND - no queue '" + b.queue + "'"
+	}
+	for _, existing := range s.bindings {
+		if existing == b {
+			return 0, ""
+		}
+	}
+	s.bindings = append(s.bindings, b)
+	return 0, ""
+}
+
+// route counts the message in the queues it is routed to and returns them.
+// It reports false when the exchange does not exist.
+func (s *Server) route(exchange, key string) ([]string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var queues []string
+	if exchange == "" {
+		if _, ok := s.queues[key]; ok {
+			queues = append(queues, key)
+		}
+	} else {
+		kind, ok := s.exchanges[exchange]
+		if !ok {
+			return nil, false
+		}
+		for _, b := range s.bindings {
+			if b.exchange != exchange {
+				continue
+			}
+			switch kind {
+			case amqp.ExchangeFanout, amqp.ExchangeHeaders:
+			case amqp.ExchangeTopic:
+				if !matchTopic(strings.Split(b.key, "."), strings.Split(key, ".")) {
+					continue
+				}
+			default:
+				if b.key != key {
+					continue
+				}
+			}
+			queues = append(queues, b.queue)
+		}
+	}
+
+	for _, q := range queues {
+		s.messages[q]++
+	}
+	return queues, true
+}
+
+// matchTopic matches the words of a routing key against a binding pattern,
+// where * matches exactly one word and # zero or more.
+func matchTopic(pattern, key []string) bool {
+	if len(pattern) == 0 {
+		return len(key) == 0
+	}
+
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(key); i++ {
+			if matchTopic(pattern[1:], key[i:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(key) > 0 && matchTopic(pattern[1:], key[1:])
+	default:
+		return len(key) > 0 && pattern[0] == key[0] && matchTopic(pattern[1:], key[1:])
+	}
+}
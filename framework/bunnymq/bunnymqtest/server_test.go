@@ -0,0 +1,161 @@
+package bunnymqtest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// dial opens a confirm-mode channel to s.
+func dial(t *testing.T, s *Server) (*amqp.Connection, *amqp.Channel) {
+	t.Helper()
+
+	conn, err := amqp.Dial(s.URL())
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	ch, err := conn.Channel()
+	if err != nil {
+		t.Fatalf("Channel() error = %v", err)
+	}
+	if err := ch.Confirm(false); err != nil {
+		t.Fatalf("Confirm() error = %v", err)
+	}
+	return conn, ch
+}
+
+func TestServer_Declare(t *testing.T) {
+	s := NewServer(t)
+	_, ch := dial(t, s)
+
+	if err := ch.ExchangeDeclare("morgan.events", amqp.ExchangeTopic, true, false, false, false, nil); err != nil {
+		t.Fatalf("ExchangeDeclare() error = %v", err)
+	}
+	if _, err := ch.QueueDeclare("tickets", true, false, false, false, amqp.Table{"x-max-length": int64(10)}); err != nil {
+		t.Fatalf("QueueDeclare() error = %v", err)
+	}
+	if err := ch.QueueBind("tickets", "ticket.*", "morgan.events", false, nil); err != nil {
+		t.Fatalf("QueueBind() error = %v", err)
+	}
+
+	if kind, ok := s.Exchange("morgan.events"); !ok || kind != amqp.ExchangeTopic {
+		t.Errorf("Exchange() = %q, %v, want topic", kind, ok)
+	}
+	if args, ok := s.Queue("tickets"); !ok || args["x-max-length"] != int64(10) {
+		t.Errorf("Queue() = %v, %v, want x-max-length 10", args, ok)
+	}
+	if !s.Bound("tickets", "morgan.events", "ticket.*") {
+		t.Error("Bound() = false, want true")
+	}
+
+	// Redeclaring with other arguments is refused and closes the channel
+	_, err := ch.QueueDeclare("tickets", true, false, false, false, nil)
+	var amqpErr *amqp.Error
+	if !errors.As(err, &amqpErr) || amqpErr.Code != amqp.PreconditionFailed {
+		t.Errorf("QueueDeclare() with other arguments error = %v, want PRECONDITION_FAILED", err)
+	}
+}
+
+func TestServer_Publish(t *testing.T) {
+	s := NewServer(t)
+	_, ch := dial(t, s)
+
+	if err := ch.ExchangeDeclare("morgan.events", amqp.ExchangeTopic, true, false, false, false, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ch.QueueDeclare("tickets", true, false, false, false, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := ch.QueueBind("tickets", "ticket.#", "morgan.events", false, nil); err != nil {
+		t.Fatal(err)
+	}
+	returns := ch.NotifyReturn(make(chan amqp.Return, 1))
+
+	publish := func(key string) bool {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx, "morgan.events", key, true, false, amqp.Publishing{Body: []byte("{}")})
+		if err != nil {
+			t.Fatalf("Publish(%s) error = %v", key, err)
+		}
+		acked, err := confirmation.WaitContext(ctx)
+		if err != nil {
+			t.Fatalf("Wait(%s) error = %v", key, err)
+		}
+		return acked
+	}
+
+	if !publish("ticket.created.v1") {
+		t.Error("routed publish was not acked")
+	}
+	if got := s.Messages("tickets"); got != 1 {
+		t.Errorf("Messages() = %d, want 1", got)
+	}
+
+	if !publish("role.created") {
+		t.Error("unroutable publish was not acked")
+	}
+	select {
+	case r := <-returns:
+		if r.ReplyCode != amqp.NoRoute || r.RoutingKey != "role.created" || string(r.Body) != "{}" {
+			t.Errorf("return = %d %q %q, want NO_ROUTE for role.created", r.ReplyCode, r.RoutingKey, r.Body)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("unroutable mandatory message was not returned")
+	}
+}
+
+func TestServer_Restart(t *testing.T) {
+	s := NewServer(t)
+	conn, ch := dial(t, s)
+	closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+
+	if _, err := ch.QueueDeclare("tickets", false, false, false, false, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Restart()
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection was not closed by Restart")
+	}
+	if _, ok := s.Queue("tickets"); ok {
+		t.Error("queue survived Restart")
+	}
+
+	dial(t, s)
+	if got := s.Connections(); got != 1 {
+		t.Errorf("Connections() after reconnecting = %d, want 1", got)
+	}
+}
+
+func TestMatchTopic(t *testing.T) {
+	tests := []struct {
+		pattern, key string
+		want         bool
+	}{
+		{"ticket.created", "ticket.created", true},
+		{"ticket.*", "ticket.created", true},
+		{"ticket.*", "ticket.created.v1", false},
+		{"ticket.#", "ticket", true},
+		{"ticket.#", "ticket.created.v1", true},
+		{"#.v1", "ticket.created.v1", true},
+		{"*.created", "role.updated", false},
+	}
+
+	for _, tt := range tests {
+		if got := matchTopic(strings.Split(tt.pattern, "."), strings.Split(tt.key, ".")); got != tt.want {
+			t.Errorf("matchTopic(%q, %q) = %v, want %v", tt.pattern, tt.key, got, tt.want)
+		}
+	}
+}
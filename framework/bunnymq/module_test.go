@@ -0,0 +1,149 @@
+package bunnymq
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/siakup/morgan-be/framework/bunnymq/bunnymqtest"
+	"go.uber.org/fx/fxtest"
+)
+
+// eventsTopology declares a topic exchange with a bound queue of the given name.
+func eventsTopology(queue string) Topology {
+	return Topology{
+		Exchanges: []Exchange{{Name: "morgan.events", Durable: true}},
+		Queues:    []Queue{{Name: queue, Durable: true, MessageTTL: time.Minute}},
+		Bindings:  []Binding{{Queue: queue, Exchange: "morgan.events", RoutingKey: queue + ".*"}},
+	}
+}
+
+// declared reports whether server holds every declaration of topology.
+func declared(server *bunnymqtest.Server, topology Topology) bool {
+	for _, e := range topology.Exchanges {
+		if _, ok := server.Exchange(e.Name); !ok {
+			return false
+		}
+	}
+	for _, q := range topology.Queues {
+		if _, ok := server.Queue(q.Name); !ok {
+			return false
+		}
+	}
+	for _, b := range topology.Bindings {
+		if !server.Bound(b.Queue, b.Exchange, b.RoutingKey) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRabbitMQ_Declare(t *testing.T) {
+	server := bunnymqtest.NewServer(t)
+
+	t.Run("Connected", func(t *testing.T) {
+		rmq, err := NewRabbitMQ(fxtest.NewLifecycle(t), &Config{URL: server.URL(), Topology: eventsTopology("tickets")})
+		if err != nil {
+			t.Fatalf("NewRabbitMQ() error = %v", err)
+		}
+		defer rmq.Connection().Close()
+
+		if !declared(server, eventsTopology("tickets")) {
+			t.Fatal("configured topology not declared on connect")
+		}
+		if args, _ := server.Queue("tickets"); args["x-message-ttl"] != int64(60000) {
+			t.Errorf("queue arguments = %v, want x-message-ttl 60000", args)
+		}
+
+		if err := rmq.Declare(eventsTopology("audit")); err != nil {
+			t.Fatalf("Declare() error = %v", err)
+		}
+		if !declared(server, eventsTopology("audit")) {
+			t.Error("Declare() did not declare on the current connection")
+		}
+
+		// The broker refuses to redeclare a queue with other arguments
+		err = rmq.Declare(Topology{Queues: []Queue{{Name: "tickets", Durable: true}}})
+		var amqpErr *amqp.Error
+		if !errors.As(err, &amqpErr) || amqpErr.Code != amqp.PreconditionFailed || !strings.Contains(err.Error(), `declare queue "tickets"`) {
+			t.Errorf("Declare() of an inequivalent queue error = %v, want PRECONDITION_FAILED", err)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		rmq := &RabbitMQ{cfg: &Config{}, done: make(chan struct{})}
+		if err := rmq.Declare(Topology{Queues: []Queue{{Name: "audit", Type: QueueQuorum}}}); err == nil {
+			t.Fatal("Declare() of an invalid topology error = nil")
+		}
+		if !rmq.topology.IsEmpty() {
+			t.Errorf("invalid topology remembered: %+v", rmq.topology)
+		}
+
+		if _, err := NewRabbitMQ(fxtest.NewLifecycle(t), &Config{URL: server.URL(), Topology: Topology{Bindings: []Binding{{Queue: "tickets"}}}}); err == nil {
+			t.Error("NewRabbitMQ() with an invalid configured topology error = nil")
+		}
+	})
+
+	t.Run("Disconnected", func(t *testing.T) {
+		server.Restart()
+
+		// Without a connection the topology is only remembered...
+		rmq := &RabbitMQ{cfg: &Config{}, done: make(chan struct{})}
+		if err := rmq.Declare(eventsTopology("tickets")); err != nil {
+			t.Fatalf("Declare() without a connection error = %v", err)
+		}
+		if _, ok := server.Exchange("morgan.events"); ok {
+			t.Fatal("Declare() without a connection reached the broker")
+		}
+
+		// ...and declared once connected
+		rmq.cfg.URL = server.URL()
+		if err := rmq.connect(); err != nil {
+			t.Fatalf("connect() error = %v", err)
+		}
+		defer rmq.Connection().Close()
+		if err := rmq.redeclare(); err != nil {
+			t.Fatalf("redeclare() error = %v", err)
+		}
+		if !declared(server, eventsTopology("tickets")) {
+			t.Error("remembered topology not declared after connecting")
+		}
+	})
+}
+
+func TestRabbitMQ_Reconnect(t *testing.T) {
+	server := bunnymqtest.NewServer(t)
+
+	lc := fxtest.NewLifecycle(t)
+	rmq, err := NewRabbitMQ(lc, &Config{URL: server.URL(), Topology: eventsTopology("tickets")})
+	if err != nil {
+		t.Fatalf("NewRabbitMQ() error = %v", err)
+	}
+	if err := rmq.Declare(eventsTopology("audit")); err != nil {
+		t.Fatalf("Declare() error = %v", err)
+	}
+	lc.RequireStart()
+	defer lc.RequireStop()
+
+	before := rmq.Connection()
+
+	// The node loses its declarations and drops the connection
+	server.Restart()
+	if declared(server, eventsTopology("tickets")) {
+		t.Fatal("Restart() kept the declarations")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !(declared(server, eventsTopology("tickets")) && declared(server, eventsTopology("audit"))) {
+		if time.Now().After(deadline) {
+			t.Fatal("configured and code topologies not declared again after reconnecting")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if conn := rmq.Connection(); conn == before || conn.IsClosed() {
+		t.Error("Connection() did not return the new open connection")
+	}
+}
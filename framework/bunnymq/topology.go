@@ -0,0 +1,173 @@
+package bunnymq
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// QueueType selects the RabbitMQ queue implementation.
+type QueueType string
+
+const (
+	QueueClassic QueueType = "classic"
+	QueueQuorum  QueueType = "quorum"
+)
+
+// Topology describes exchanges, queues and bindings to declare on the broker.
+// It is declared at startup and again after every reconnect, so it must be idempotent:
+// changing the arguments of an existing queue requires deleting it first.
+type Topology struct {
+	Exchanges []Exchange `config:"exchanges"`
+	Queues    []Queue    `config:"queues"`
+	Bindings  []Binding  `config:"bindings"`
+}
+
+// Exchange describes an exchange declaration.
+type Exchange struct {
+	Name string `config:"name"`
+	// Kind is the exchange type: direct, fanout, topic or headers. Defaults to topic.
+	Kind       string     `config:"kind"`
+	Durable    bool       `config:"durable"`
+	AutoDelete bool       `config:"auto_delete"`
+	Internal   bool       `config:"internal"`
+	Args       amqp.Table `config:"args"`
+}
+
+// Queue describes a queue declaration.
+type Queue struct {
+	Name string `config:"name"`
+	// Type defaults to classic. Quorum queues must be durable and cannot be exclusive or auto-delete.
+	Type       QueueType `config:"type"`
+	Durable    bool      `config:"durable"`
+	AutoDelete bool      `config:"auto_delete"`
+	Exclusive  bool      `config:"exclusive"`
+	// DeadLetterExchange receives rejected and expired messages; "" with a routing key uses the default exchange.
+	DeadLetterExchange   string `config:"dead_letter_exchange"`
+	DeadLetterRoutingKey string `config:"dead_letter_routing_key"`
+	// MessageTTL expires messages after the given duration. Zero keeps them forever.
+	MessageTTL time.Duration `config:"message_ttl"`
+	// MaxLength caps the number of ready messages. Zero is unlimited.
+	MaxLength int        `config:"max_length"`
+	Args      amqp.Table `config:"args"`
+}
+
+// Binding binds a queue to an exchange.
+type Binding struct {
+	Queue      string     `config:"queue"`
+	Exchange   string     `config:"exchange"`
+	RoutingKey string     `config:"routing_key"`
+	Args       amqp.Table `config:"args"`
+}
+
+// Merge returns the topology with the declarations of other appended.
+func (t Topology) Merge(other Topology) Topology {
+	return Topology{
+		Exchanges: append(append([]Exchange{}, t.Exchanges...), other.Exchanges...),
+		Queues:    append(append([]Queue{}, t.Queues...), other.Queues...),
+		Bindings:  append(append([]Binding{}, t.Bindings...), other.Bindings...),
+	}
+}
+
+// IsEmpty reports whether the topology declares nothing.
+func (t Topology) IsEmpty() bool {
+	return len(t.Exchanges) == 0 && len(t.Queues) == 0 && len(t.Bindings) == 0
+}
+
+// Validate checks the topology for declarations the broker would reject.
+func (t Topology) Validate() error {
+	var errs []error
+
+	for _, e := range t.Exchanges {
+		if e.Name == "" {
+			errs = append(errs, errors.New("bunnymq: exchange without name"))
+		}
+	}
+
+	for _, q := range t.Queues {
+		if q.Name == "" {
+			errs = append(errs, errors.New("bunnymq: queue without name"))
+			continue
+		}
+		switch q.Type {
+		case "", QueueClassic:
+		case QueueQuorum:
+			if !q.Durable || q.AutoDelete || q.Exclusive {
+				errs = append(errs, fmt.Errorf("bunnymq: quorum queue %q must be durable, not auto-delete and not exclusive", q.Name))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("bunnymq: queue %q has unknown type %q", q.Name, q.Type))
+		}
+		if q.MessageTTL < 0 || q.MaxLength < 0 {
+			errs = append(errs, fmt.Errorf("bunnymq: queue %q has a negative ttl or max length", q.Name))
+		}
+	}
+
+	for _, b := range t.Bindings {
+		if b.Queue == "" || b.Exchange == "" {
+			errs = append(errs, fmt.Errorf("bunnymq: binding %q -> %q needs both queue and exchange", b.Exchange, b.Queue))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Declare declares the exchanges, then the queues, then the bindings on ch.
+// The broker closes the channel on the first failed declaration, so ch must not be reused after an error.
+func (t Topology) Declare(ch *amqp.Channel) error {
+	for _, e := range t.Exchanges {
+		kind := e.Kind
+		if kind == "" {
+			kind = amqp.ExchangeTopic
+		}
+		if err := ch.ExchangeDeclare(e.Name, kind, e.Durable, e.AutoDelete, e.Internal, false, e.Args); err != nil {
+			return fmt.Errorf("bunnymq: declare exchange %q: %w", e.Name, err)
+		}
+	}
+
+	for _, q := range t.Queues {
+		if _, err := ch.QueueDeclare(q.Name, q.Durable, q.AutoDelete, q.Exclusive, false, q.arguments()); err != nil {
+			return fmt.Errorf("bunnymq: declare queue %q: %w", q.Name, err)
+		}
+	}
+
+	for _, b := range t.Bindings {
+		if err := ch.QueueBind(b.Queue, b.RoutingKey, b.Exchange, false, b.Args); err != nil {
+			return fmt.Errorf("bunnymq: bind queue %q to %q: %w", b.Queue, b.Exchange, err)
+		}
+	}
+
+	return nil
+}
+
+// arguments builds the x-arguments of the queue from its typed fields and Args.
+// Typed fields take precedence over the same keys in Args.
+func (q Queue) arguments() amqp.Table {
+	args := amqp.Table{}
+	for k, v := range q.Args {
+		args[k] = v
+	}
+
+	if q.Type != "" {
+		args[amqp.QueueTypeArg] = string(q.Type)
+	}
+	if q.DeadLetterExchange != "" || q.DeadLetterRoutingKey != "" {
+		args["x-dead-letter-exchange"] = q.DeadLetterExchange
+	}
+	if q.DeadLetterRoutingKey != "" {
+		args["x-dead-letter-routing-key"] = q.DeadLetterRoutingKey
+	}
+	if q.MessageTTL > 0 {
+		args["x-message-ttl"] = q.MessageTTL.Milliseconds()
+	}
+	if q.MaxLength > 0 {
+		args["x-max-length"] = int64(q.MaxLength)
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+	return args
+}
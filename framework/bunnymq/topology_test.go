@@ -0,0 +1,181 @@
+package bunnymq
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+func TestTopology_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		topology Topology
+		want     []string
+	}{
+		{
+			name: "Valid",
+			topology: Topology{
+				Exchanges: []Exchange{{Name: "morgan.events", Durable: true}},
+				Queues: []Queue{
+					{Name: "tickets", Durable: true},
+					{Name: "audit", Type: QueueQuorum, Durable: true, MessageTTL: time.Hour},
+					{Name: "replies", Type: QueueClassic, Exclusive: true, AutoDelete: true},
+				},
+				Bindings: []Binding{{Queue: "tickets", Exchange: "morgan.events", RoutingKey: "ticket.*"}},
+			},
+		},
+		{
+			name:     "QuorumNotDurable",
+			topology: Topology{Queues: []Queue{{Name: "audit", Type: QueueQuorum}}},
+			want:     []string{`quorum queue "audit" must be durable`},
+		},
+		{
+			name:     "QuorumExclusive",
+			topology: Topology{Queues: []Queue{{Name: "audit", Type: QueueQuorum, Durable: true, Exclusive: true}}},
+			want:     []string{`quorum queue "audit"`},
+		},
+		{
+			name:     "QuorumAutoDelete",
+			topology: Topology{Queues: []Queue{{Name: "audit", Type: QueueQuorum, Durable: true, AutoDelete: true}}},
+			want:     []string{`quorum queue "audit"`},
+		},
+		{
+			name:     "UnknownType",
+			topology: Topology{Queues: []Queue{{Name: "audit", Type: "stream", Durable: true}}},
+			want:     []string{`queue "audit" has unknown type "stream"`},
+		},
+		{
+			name:     "Negative",
+			topology: Topology{Queues: []Queue{{Name: "audit", MessageTTL: -time.Second}, {Name: "tickets", MaxLength: -1}}},
+			want:     []string{`queue "audit" has a negative ttl`, `queue "tickets" has a negative ttl`},
+		},
+		{
+			name: "AllErrors",
+			topology: Topology{
+				Exchanges: []Exchange{{Kind: amqp.ExchangeFanout}},
+				Queues:    []Queue{{}, {Name: "audit", Type: QueueQuorum}},
+				Bindings:  []Binding{{Queue: "tickets"}},
+			},
+			want: []string{"exchange without name", "queue without name", `quorum queue "audit"`, `binding "" -> "tickets" needs both queue and exchange`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.topology.Validate()
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Validate() error = nil, want %q", tt.want)
+			}
+
+			lines := strings.Split(err.Error(), "\n")
+			if len(lines) != len(tt.want) {
+				t.Fatalf("Validate() reported %d errors, want %d:\n%v", len(lines), len(tt.want), err)
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(lines[i], want) {
+					t.Errorf("error %d = %q, want it to contain %q", i, lines[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestQueue_Arguments(t *testing.T) {
+	tests := []struct {
+		name  string
+		queue Queue
+		want  amqp.Table
+	}{
+		{
+			name:  "None",
+			queue: Queue{Name: "tickets", Durable: true},
+			want:  nil,
+		},
+		{
+			name:  "Args",
+			queue: Queue{Name: "tickets", Args: amqp.Table{"x-overflow": "reject-publish"}},
+			want:  amqp.Table{"x-overflow": "reject-publish"},
+		},
+		{
+			name: "Typed",
+			queue: Queue{
+				Name:                 "tickets",
+				Type:                 QueueQuorum,
+				DeadLetterExchange:   "morgan.dlx",
+				DeadLetterRoutingKey: "tickets.dead",
+				MessageTTL:           90 * time.Second,
+				MaxLength:            1000,
+			},
+			want: amqp.Table{
+				amqp.QueueTypeArg:           "quorum",
+				"x-dead-letter-exchange":    "morgan.dlx",
+				"x-dead-letter-routing-key": "tickets.dead",
+				"x-message-ttl":             int64(90000),
+				"x-max-length":              int64(1000),
+			},
+		},
+		{
+			// Dead-lettering to the default exchange routes by the key alone
+			name:  "DefaultExchangeDLX",
+			queue: Queue{Name: "tickets", DeadLetterRoutingKey: "tickets.dead"},
+			want:  amqp.Table{"x-dead-letter-exchange": "", "x-dead-letter-routing-key": "tickets.dead"},
+		},
+		{
+			name: "TypedOverArgs",
+			queue: Queue{
+				Name:               "tickets",
+				MessageTTL:         time.Minute,
+				DeadLetterExchange: "morgan.dlx",
+				Args: amqp.Table{
+					"x-message-ttl":          int64(1000),
+					"x-dead-letter-exchange": "other.dlx",
+					"x-max-length":           int64(5),
+				},
+			},
+			want: amqp.Table{"x-message-ttl": int64(60000), "x-dead-letter-exchange": "morgan.dlx", "x-max-length": int64(5)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var before amqp.Table
+			if tt.queue.Args != nil {
+				before = amqp.Table{}
+				for k, v := range tt.queue.Args {
+					before[k] = v
+				}
+			}
+
+			if got := tt.queue.arguments(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("arguments() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.queue.Args, before) {
+				t.Errorf("arguments() modified Args: %v, was %v", tt.queue.Args, before)
+			}
+		})
+	}
+}
+
+func TestTopology_Merge(t *testing.T) {
+	base := Topology{Exchanges: []Exchange{{Name: "morgan.events"}}}
+	merged := base.Merge(Topology{Queues: []Queue{{Name: "tickets"}}, Bindings: []Binding{{Queue: "tickets", Exchange: "morgan.events"}}})
+
+	if len(merged.Exchanges) != 1 || len(merged.Queues) != 1 || len(merged.Bindings) != 1 {
+		t.Errorf("Merge() = %+v, want one exchange, queue and binding", merged)
+	}
+	if len(base.Queues) != 0 {
+		t.Errorf("Merge() modified the receiver: %+v", base)
+	}
+	if !(Topology{}).IsEmpty() || merged.IsEmpty() {
+		t.Errorf("IsEmpty() of the empty and merged topologies = %v, %v, want true, false", (Topology{}).IsEmpty(), merged.IsEmpty())
+	}
+}
@@ -22,10 +22,10 @@ var Module = fx.Module("logger",
 // Config holds configuration for the Logger.
 type Config struct {
 	// Level is the logging level (debug, info, warn, error).
-	Level string `config:"log_level"`
+	Level string `config:"log_level" default:"info" validate:"oneof=trace debug info warn error fatal panic disabled"`
 
 	// Format is the logging format (json, console).
-	Format string `config:"log_format"`
+	Format string `config:"log_format" default:"json" validate:"oneof=json console"`
 }
 
 // TracingHook is a zerolog hook that injects trace_id and span_id from the context.
@@ -51,13 +51,18 @@ func (h TracingHook) Run(e *zerolog.Event, level zerolog.Level, msg string) {
 	}
 }
 
-// Configure sets up the global zerolog logger.
-func Configure(cfg *Config) {
-	level, err := zerolog.ParseLevel(cfg.Level)
+// SetLevel changes the global logging level at runtime. Unknown levels fall back to info.
+func SetLevel(level string) {
+	parsed, err := zerolog.ParseLevel(level)
 	if err != nil {
-		level = zerolog.InfoLevel
+		parsed = zerolog.InfoLevel
 	}
-	zerolog.SetGlobalLevel(level)
+	zerolog.SetGlobalLevel(parsed)
+}
+
+// Configure sets up the global zerolog logger.
+func Configure(cfg *Config) {
+	SetLevel(cfg.Level)
 
 	// Default to JSON
 	var logger zerolog.Logger
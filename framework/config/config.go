@@ -24,6 +24,7 @@ package config
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/pkg/errors"
 
@@ -32,8 +33,10 @@ import (
 
 // LoaderConfig holds the configuration for loading config values.
 type LoaderConfig struct {
-	resolvers []Resolver
-	sources   []Source
+	resolvers   []Resolver
+	sources     []Source
+	provenance  func(Provenance)
+	unknownKeys UnknownKeys
 }
 
 // ConfigOption is a functional option for configuring the config loader.
@@ -55,6 +58,10 @@ func WithSources(sources ...Source) ConfigOption {
 //   - FileResolver: resolves "file:///path/to/file"
 //   - Base64Resolver: resolves "base64://ENCODED_VALUE"
 //   - VaultResolver: resolves "vault://secret/path"
+//   - SOPSResolver: resolves "sops://path/to/file#field"
+//   - AWSSecretsManagerResolver: resolves "awssm://secret-id#field"
+//   - GCPSecretManagerResolver: resolves "gcpsm://secret#field"
+//   - Registry.Resolver: dispatches on the scheme, including chains like "base64+awssm://..."
 //
 // Example:
 //
@@ -69,12 +76,42 @@ func WithResolvers(resolvers ...Resolver) ConfigOption {
 	}
 }
 
+// WithProvenance makes the loader report which source supplied each final key into p.
+// Every load stores a new map, so a previous one can still be read safely.
+//
+// Example:
+//
+//	var provenance config.Provenance
+//	err := config.ReadInConfig(ctx, &cfg, config.WithSources(...), config.WithProvenance(&provenance))
+//	fmt.Print(provenance)
+func WithProvenance(p *Provenance) ConfigOption {
+	return withProvenanceFunc(func(provenance Provenance) {
+		*p = provenance
+	})
+}
+
+// withProvenanceFunc hands the provenance of every load to report.
+func withProvenanceFunc(report func(Provenance)) ConfigOption {
+	return func(cfg *LoaderConfig) {
+		cfg.provenance = report
+	}
+}
+
 // ReadInConfig loads configuration from the provided sources into the target struct.
 // The target must be a pointer to a struct. Configuration values are mapped to
 // struct fields using the "config" tag (defaults to field name if not specified).
 //
+// Sources are deep-merged in order, later sources overriding earlier ones key by key.
+// A dotted key such as "database.url" overrides only that nested value.
+// Keys of a source that match no field are logged (see WithUnknownKeys).
+//
+// After decoding, fields tagged `default:"..."` whose key no source set get their default,
+// and the struct is checked against its `validate:"..."` tags (go-playground/validator
+// syntax, e.g. `validate:"required,url"`). All failures are returned together as ValidationErrors.
+//
 // The function applies resolvers to string values that contain resolver schemes
-// (e.g., "env://VAR", "file:///path", "vault://secret").
+// (e.g., "env://VAR", "file:///path", "vault://secret"), including values nested
+// in objects and slices.
 //
 // Parameters:
 //   - ctx: Context for cancellation and timeouts
@@ -87,8 +124,9 @@ func WithResolvers(resolvers ...Resolver) ConfigOption {
 // Example:
 //
 //	type Config struct {
-//		DatabaseURL string `config:"database.url"`
-//		SecretKey   string `config:"secret.key"`
+//		DatabaseURL string        `config:"database.url" validate:"required,url"`
+//		SecretKey   string        `config:"secret.key"`
+//		Timeout     time.Duration `config:"timeout" default:"10s"`
 //	}
 //
 //	var cfg Config
@@ -114,45 +152,63 @@ func ReadInConfig[T any](ctx context.Context, arg *T, opts ...ConfigOption) erro
 }
 
 func readFromSources[T any](ctx context.Context, arg *T, options LoaderConfig) error {
+	s := newSchema(reflect.TypeOf(arg))
+
 	values := make(map[string]any)
+	provenance := make(Provenance)
 	for _, source := range options.sources {
 		sourceValues, err := source.source(ctx)
 		if err != nil {
 			return errors.Wrapf(err, "failed to load source %q config", source.name)
 		}
-
-		for k, v := range sourceValues {
-			values[k] = v
+		if err := s.reportUnknown(source, sourceValues, options.unknownKeys); err != nil {
+			return err
 		}
+
+		mergeValues(values, sourceValues, source.name, "", provenance)
+	}
+
+	if err := resolveSources(ctx, arg, values, provenance, options); err != nil {
+		return err
 	}
 
-	return resolveSources(ctx, arg, values, options)
+	if err := s.applyDefaults(reflect.ValueOf(arg), values); err != nil {
+		return err
+	}
+
+	return s.validate(arg)
 }
 
-func resolveSources[T any](ctx context.Context, arg *T, sources map[string]any, options LoaderConfig) error {
+func resolveSources[T any](ctx context.Context, arg *T, sources map[string]any, provenance Provenance, options LoaderConfig) error {
 	if options.resolvers == nil {
 		options.resolvers = []Resolver{}
 	}
 
-	for k, v := range sources {
-		str, ok := v.(string)
-		if ok {
-			resolveStr, err := Resolve(ctx, str, options.resolvers...)
-			if err != nil {
-				return errors.Wrapf(err, "failed to resolve config key %s", k)
-			}
+	if err := resolveValues(ctx, sources, options.resolvers, "", provenance); err != nil {
+		return err
+	}
 
-			sources[k] = resolveStr
-		}
+	if options.provenance != nil {
+		options.provenance(provenance)
 	}
 
-	return decodeSources(arg, sources)
+	return decodeSources(arg, flatten(sources))
 }
 
 func decodeSources[T any](arg *T, sources map[string]any) error {
+	err := decodeValue(arg, sources)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode sources")
+	}
+
+	return nil
+}
+
+// decodeValue decodes input into result the way source values are decoded.
+func decodeValue(result any, input any) error {
 	decodeConfig := &mapstructure.DecoderConfig{
 		TagName:          "config",
-		Result:           arg,
+		Result:           result,
 		WeaklyTypedInput: true,
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
 			mapstructure.StringToTimeDurationHookFunc(),
@@ -165,10 +221,5 @@ func decodeSources[T any](arg *T, sources map[string]any) error {
 		return errors.Wrap(err, "failed to new decoder")
 	}
 
-	err = decoder.Decode(sources)
-	if err != nil {
-		return errors.Wrap(err, "failed to decode sources")
-	}
-
-	return nil
+	return decoder.Decode(input)
 }
@@ -0,0 +1,175 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Entry is one effective configuration value with its origin.
+type Entry struct {
+	Key   string
+	Value string
+	Origin
+}
+
+// Entries lists every field of cfg, a struct or a pointer to one, as decoded, in field order.
+// The origin of each key comes from provenance; fields no source set show "default" when tagged
+// with a default, "-" otherwise.
+//
+// Values are redacted when the field is a Secret, is tagged `secret:"..."` or was resolved
+// through a resolver (vault://, file://, ...).
+func Entries(cfg any, provenance Provenance) []Entry {
+	index := make(map[string]string, len(provenance))
+	for k := range provenance {
+		index[strings.ToLower(k)] = k
+	}
+
+	d := &dumper{provenance: provenance, index: index}
+	d.walk(reflect.ValueOf(cfg), "")
+	return d.entries
+}
+
+// Dump writes the entries of cfg as an aligned KEY / VALUE / SOURCE / RESOLVER table.
+func Dump(w io.Writer, cfg any, provenance Provenance) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tVALUE\tSOURCE\tRESOLVER")
+	for _, e := range Entries(cfg, provenance) {
+		scheme := e.Scheme
+		if scheme == "" {
+			scheme = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", e.Key, e.Value, e.Source, scheme)
+	}
+	return tw.Flush()
+}
+
+type dumper struct {
+	provenance Provenance
+	// index maps lower-cased provenance keys to the keys as the sources spelled them.
+	index   map[string]string
+	entries []Entry
+}
+
+func (d *dumper) walk(v reflect.Value, prefix string) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		key, option, skip := fieldKey(f)
+		if skip || option == "remain" {
+			continue
+		}
+
+		field := v.Field(i)
+		if option == "squash" {
+			d.walk(field, prefix)
+			continue
+		}
+
+		fullKey := joinKey(prefix, key)
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if kindOf(ft) == keyNode {
+			d.walk(field, fullKey)
+			continue
+		}
+
+		origin := d.origin(fullKey)
+		if origin.Source == "" {
+			origin.Source = "-"
+			if _, ok := f.Tag.Lookup("default"); ok {
+				origin.Source = "default"
+			}
+		}
+
+		value := format(field)
+		if mode, ok := f.Tag.Lookup("secret"); ok {
+			value = redact(value, mode)
+		} else if origin.Scheme != "" {
+			value = redact(value, "")
+		}
+
+		d.entries = append(d.entries, Entry{Key: fullKey, Value: value, Origin: origin})
+	}
+}
+
+// origin returns the origin of key, combining the origins of its children for objects and slices.
+func (d *dumper) origin(key string) Origin {
+	key = strings.ToLower(key)
+	if k, ok := d.index[key]; ok {
+		return d.provenance[k]
+	}
+
+	sources := map[string]bool{}
+	schemes := map[string]bool{}
+	for lower, k := range d.index {
+		if strings.HasPrefix(lower, key+".") || strings.HasPrefix(lower, key+"[") {
+			o := d.provenance[k]
+			sources[o.Source] = true
+			if o.Scheme != "" {
+				schemes[o.Scheme] = true
+			}
+		}
+	}
+	return Origin{Source: joinSet(sources), Scheme: joinSet(schemes)}
+}
+
+func joinSet(set map[string]bool) string {
+	items := make([]string, 0, len(set))
+	for item := range set {
+		items = append(items, item)
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
+
+// format renders a field value; objects and slices are rendered as JSON, in which a Secret is redacted.
+func format(v reflect.Value) string {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	switch value := v.Interface().(type) {
+	case time.Time:
+		return value.Format(time.RFC3339)
+	case fmt.Stringer:
+		return value.String()
+	}
+
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct, reflect.Interface:
+		if v.Kind() != reflect.Struct && v.Kind() != reflect.Array && v.IsNil() {
+			return ""
+		}
+		b, err := json.Marshal(v.Interface())
+		if err != nil {
+			return fmt.Sprint(v.Interface())
+		}
+		return string(b)
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestEntries_Redaction(t *testing.T) {
+	type database struct {
+		URL      string `config:"url" secret:"url"`
+		Password string `config:"password" secret:""`
+		Host     string `config:"host"`
+	}
+	type appConfig struct {
+		Database database `config:"database"`
+		APIKey   Secret   `config:"api_key"`
+		Token    string   `config:"token"`
+		Port     int      `config:"port" default:"8080"`
+	}
+
+	cfg := appConfig{
+		Database: database{URL: "postgres://app:hunter2@db:5432/morgan", Password: "hunter2", Host: "db"},
+		APIKey:   "key-123",
+		Token:    "from-vault",
+		Port:     8080,
+	}
+	provenance := Provenance{
+		"database.url":      {Source: "file:config.yaml"},
+		"database.password": {Source: "env"},
+		"database.host":     {Source: "env"},
+		"api_key":           {Source: "env"},
+		"token":             {Source: "env", Scheme: "vault"},
+	}
+
+	got := map[string]Entry{}
+	for _, e := range Entries(&cfg, provenance) {
+		got[e.Key] = e
+	}
+
+	cases := map[string]string{
+		"database.url":      "postgres://app:" + Redacted + "@db:5432/morgan",
+		"database.password": Redacted,
+		"database.host":     "db",
+		"api_key":           Redacted,
+		"token":             Redacted,
+		"port":              "8080",
+	}
+	for key, want := range cases {
+		if got[key].Value != want {
+			t.Errorf("%s: expected %q, got %q", key, want, got[key].Value)
+		}
+	}
+	if got["port"].Source != "default" || got["token"].Scheme != "vault" {
+		t.Errorf("expected the origins to be reported, got %+v and %+v", got["port"], got["token"])
+	}
+
+	var out bytes.Buffer
+	if err := Dump(&out, &cfg, provenance); err != nil {
+		t.Fatalf("dump failed: %v", err)
+	}
+	for _, secret := range []string{"hunter2", "key-123", "from-vault"} {
+		if strings.Contains(out.String(), secret) {
+			t.Errorf("expected %q to be redacted from the dump:\n%s", secret, out.String())
+		}
+	}
+}
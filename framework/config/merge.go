@@ -0,0 +1,244 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+)
+
+// Origin records where the final value of a configuration key came from.
+type Origin struct {
+	// Source is the name of the source that supplied the value, e.g. "file:config/config.json".
+	Source string
+	// Scheme is the resolver scheme the raw value was resolved through, e.g. "env", or "" if it was used as-is.
+	Scheme string
+}
+
+// Provenance maps every final configuration key, in dotted form, to its Origin.
+// It never holds values, so it is safe to print even when the configuration contains secrets.
+type Provenance map[string]Origin
+
+// Keys returns the keys in sorted order.
+func (p Provenance) Keys() []string {
+	keys := make([]string, 0, len(p))
+	for k := range p {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// String renders the provenance as an aligned KEY / SOURCE / RESOLVER table.
+func (p Provenance) String() string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tSOURCE\tRESOLVER")
+	for _, k := range p.Keys() {
+		o := p[k]
+		scheme := o.Scheme
+		if scheme == "" {
+			scheme = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", k, o.Source, scheme)
+	}
+	_ = w.Flush()
+	return b.String()
+}
+
+// forget removes key and every key nested below it.
+func (p Provenance) forget(key string) {
+	delete(p, key)
+	for k := range p {
+		if strings.HasPrefix(k, key+".") || strings.HasPrefix(k, key+"[") {
+			delete(p, k)
+		}
+	}
+}
+
+// resolved records the resolver scheme on key, or on the slice holding it for an element key like "hosts[0]".
+func (p Provenance) resolved(key, scheme string) {
+	for {
+		if origin, ok := p[key]; ok {
+			origin.Scheme = scheme
+			p[key] = origin
+			return
+		}
+		i := strings.LastIndex(key, "[")
+		if i < 0 {
+			return
+		}
+		key = key[:i]
+	}
+}
+
+// splitKey splits a dotted key into its path, dropping empty segments.
+func splitKey(key string) []string {
+	parts := strings.Split(key, ".")
+	path := parts[:0]
+	for _, part := range parts {
+		if part != "" {
+			path = append(path, part)
+		}
+	}
+	return path
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// asMap returns v as a string-keyed map if it is one. YAML may produce map[any]any.
+func asMap(v any) (map[string]any, bool) {
+	switch m := v.(type) {
+	case map[string]any:
+		return m, true
+	case map[any]any:
+		out := make(map[string]any, len(m))
+		for k, v := range m {
+			out[fmt.Sprint(k)] = v
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+// mergeValues deep-merges src into dst and records source as the origin of every key it sets.
+// A dotted key such as "database.pool.max" overrides only that nested value; nested objects are
+// merged key by key while scalars and slices replace what was there.
+func mergeValues(dst, src map[string]any, source, prefix string, prov Provenance) {
+	for key, value := range src {
+		path := splitKey(key)
+		if len(path) == 0 {
+			continue
+		}
+
+		// Walk to the parent of the last segment, creating objects as needed.
+		parent := dst
+		parentKey := prefix
+		for _, segment := range path[:len(path)-1] {
+			parentKey = joinKey(parentKey, segment)
+			next, ok := asMap(parent[segment])
+			if !ok {
+				// A scalar is replaced by the object the override needs.
+				prov.forget(parentKey)
+				next = make(map[string]any)
+			}
+			parent[segment] = next
+			parent = next
+		}
+
+		last := path[len(path)-1]
+		fullKey := joinKey(parentKey, last)
+
+		if srcMap, ok := asMap(value); ok {
+			dstMap, ok := asMap(parent[last])
+			if !ok {
+				prov.forget(fullKey)
+				dstMap = make(map[string]any)
+			}
+			parent[last] = dstMap
+			mergeValues(dstMap, srcMap, source, fullKey, prov)
+			continue
+		}
+
+		prov.forget(fullKey)
+		parent[last] = value
+		prov[fullKey] = Origin{Source: source}
+	}
+}
+
+// resolveValues applies the resolvers to every string in values, recursing through objects and slices.
+// The scheme of every resolved value is recorded in prov.
+func resolveValues(ctx context.Context, values map[string]any, resolvers []Resolver, prefix string, prov Provenance) error {
+	for key, value := range values {
+		resolved, err := resolveValue(ctx, value, resolvers, joinKey(prefix, key), prov)
+		if err != nil {
+			return err
+		}
+		values[key] = resolved
+	}
+	return nil
+}
+
+func resolveValue(ctx context.Context, value any, resolvers []Resolver, key string, prov Provenance) (any, error) {
+	if m, ok := asMap(value); ok {
+		if err := resolveValues(ctx, m, resolvers, key, prov); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		resolved, err := Resolve(ctx, v, resolvers...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve config key %s", key)
+		}
+		if resolved != v {
+			if scheme, _, ok := strings.Cut(v, "://"); ok {
+				prov.resolved(key, scheme)
+			}
+		}
+		return resolved, nil
+	case []any:
+		// Slices are shared with the source, so resolve into a copy to keep its references for the next load.
+		out := make([]any, len(v))
+		for i, item := range v {
+			resolved, err := resolveValue(ctx, item, resolvers, fmt.Sprintf("%s[%d]", key, i), prov)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolved
+		}
+		return out, nil
+	case []string:
+		out := make([]string, len(v))
+		for i, item := range v {
+			resolved, err := resolveValue(ctx, item, resolvers, fmt.Sprintf("%s[%d]", key, i), prov)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolved.(string)
+		}
+		return out, nil
+	default:
+		return value, nil
+	}
+}
+
+// flatten returns values plus a dotted alias for every nested key, so fields tagged with a
+// dotted name (`config:"database.url"`) decode the same as nested structs.
+func flatten(values map[string]any) map[string]any {
+	out := make(map[string]any, len(values))
+	for k, v := range values {
+		out[k] = v
+	}
+
+	var walk func(prefix string, m map[string]any)
+	walk = func(prefix string, m map[string]any) {
+		for k, v := range m {
+			key := joinKey(prefix, k)
+			if _, exists := out[key]; !exists {
+				out[key] = v
+			}
+			if nested, ok := asMap(v); ok {
+				walk(key, nested)
+			}
+		}
+	}
+	for k, v := range values {
+		if nested, ok := asMap(v); ok {
+			walk(k, nested)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMergeValues(t *testing.T) {
+	cases := []struct {
+		name    string
+		sources []map[string]any
+		want    map[string]any
+		origins Provenance
+	}{
+		{
+			name: "LaterOverrides",
+			sources: []map[string]any{
+				{"port": 8080, "host": "a"},
+				{"port": 9090},
+			},
+			want:    map[string]any{"port": 9090, "host": "a"},
+			origins: Provenance{"port": {Source: "s1"}, "host": {Source: "s0"}},
+		},
+		{
+			name: "NestedMergedKeyByKey",
+			sources: []map[string]any{
+				{"db": map[string]any{"host": "a", "port": 5432}},
+				{"db": map[string]any{"host": "b"}},
+			},
+			want:    map[string]any{"db": map[string]any{"host": "b", "port": 5432}},
+			origins: Provenance{"db.host": {Source: "s1"}, "db.port": {Source: "s0"}},
+		},
+		{
+			name: "DottedKeyOverridesNested",
+			sources: []map[string]any{
+				{"db": map[string]any{"host": "a", "port": 5432}},
+				{"db.port": 6432},
+			},
+			want:    map[string]any{"db": map[string]any{"host": "a", "port": 6432}},
+			origins: Provenance{"db.host": {Source: "s0"}, "db.port": {Source: "s1"}},
+		},
+		{
+			name: "ObjectReplacesScalar",
+			sources: []map[string]any{
+				{"db": "postgres://a"},
+				{"db.host": "b"},
+			},
+			want:    map[string]any{"db": map[string]any{"host": "b"}},
+			origins: Provenance{"db.host": {Source: "s1"}},
+		},
+		{
+			name: "SlicesReplaced",
+			sources: []map[string]any{
+				{"hosts": []any{"a", "b"}},
+				{"hosts": []any{"c"}},
+			},
+			want:    map[string]any{"hosts": []any{"c"}},
+			origins: Provenance{"hosts": {Source: "s1"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			values := make(map[string]any)
+			prov := make(Provenance)
+			for i, src := range tc.sources {
+				mergeValues(values, src, fmt.Sprintf("s%d", i), "", prov)
+			}
+			if !reflect.DeepEqual(values, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, values)
+			}
+			if !reflect.DeepEqual(prov, tc.origins) {
+				t.Errorf("expected provenance %v, got %v", tc.origins, prov)
+			}
+		})
+	}
+}
+
+func TestResolveValues(t *testing.T) {
+	upper := func(ctx context.Context, raw string) (string, error) {
+		value, ok := strings.CutPrefix(raw, "upper://")
+		if !ok {
+			return "", ErrInvalidResolver
+		}
+		return strings.ToUpper(value), nil
+	}
+
+	cases := []struct {
+		name    string
+		values  map[string]any
+		want    map[string]any
+		origins Provenance
+	}{
+		{
+			name:    "Scalar",
+			values:  map[string]any{"password": "upper://s3cret", "user": "app"},
+			want:    map[string]any{"password": "S3CRET", "user": "app"},
+			origins: Provenance{"password": {Source: "src", Scheme: "upper"}, "user": {Source: "src"}},
+		},
+		{
+			name:    "Nested",
+			values:  map[string]any{"db": map[string]any{"password": "upper://x"}},
+			want:    map[string]any{"db": map[string]any{"password": "X"}},
+			origins: Provenance{"db.password": {Source: "src", Scheme: "upper"}},
+		},
+		{
+			name:    "SliceElementMarksSlice",
+			values:  map[string]any{"hosts": []any{"a", "upper://b"}},
+			want:    map[string]any{"hosts": []any{"a", "B"}},
+			origins: Provenance{"hosts": {Source: "src", Scheme: "upper"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			values := make(map[string]any)
+			prov := make(Provenance)
+			mergeValues(values, tc.values, "src", "", prov)
+
+			if err := resolveValues(context.Background(), values, []Resolver{upper}, "", prov); err != nil {
+				t.Fatalf("resolve failed: %v", err)
+			}
+			if !reflect.DeepEqual(values, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, values)
+			}
+			if !reflect.DeepEqual(prov, tc.origins) {
+				t.Errorf("expected provenance %v, got %v", tc.origins, prov)
+			}
+		})
+	}
+
+	t.Run("SourceSliceUntouched", func(t *testing.T) {
+		hosts := []any{"upper://a"}
+		values := map[string]any{}
+		mergeValues(values, map[string]any{"hosts": hosts}, "src", "", make(Provenance))
+
+		if err := resolveValues(context.Background(), values, []Resolver{upper}, "", make(Provenance)); err != nil {
+			t.Fatalf("resolve failed: %v", err)
+		}
+		if hosts[0] != "upper://a" {
+			t.Errorf("expected the source slice to keep its reference, got %v", hosts)
+		}
+	})
+}
+
+func TestLoaderProvenance(t *testing.T) {
+	type appConfig struct {
+		Port int    `config:"port"`
+		Host string `config:"host"`
+	}
+	source := func(name string, values map[string]any) Source {
+		return Source{name: name, source: func(context.Context) (map[string]any, error) { return values, nil }}
+	}
+
+	loader := NewLoader(LoaderParams{}).WithOptions(WithSources(
+		source("base", map[string]any{"port": 8080, "host": "a"}),
+		source("override", map[string]any{"port": 9090}),
+	))
+	if loader.Provenance() != nil {
+		t.Error("expected no provenance before the first load")
+	}
+
+	var cfg appConfig
+	if err := ReadInConfig(context.Background(), &cfg, loader.options()...); err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	first := loader.Provenance()
+	want := Provenance{"port": {Source: "override"}, "host": {Source: "base"}}
+	if !reflect.DeepEqual(first, want) {
+		t.Errorf("expected %v, got %v", want, first)
+	}
+
+	if err := ReadInConfig(context.Background(), &cfg, loader.options()...); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	if reflect.ValueOf(first).Pointer() == reflect.ValueOf(loader.Provenance()).Pointer() {
+		t.Error("expected every load to publish a new provenance")
+	}
+}
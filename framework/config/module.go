@@ -4,6 +4,7 @@ package config
 
 import (
 	"context"
+	"sync/atomic"
 
 	"go.uber.org/fx"
 )
@@ -19,19 +20,29 @@ var Module = fx.Module("config",
 // Loader holds the configuration options (sources and resolvers) for loading values.
 // It allows for deferred loading and customization within the Fx lifecycle.
 type Loader struct {
-	opts []ConfigOption
+	opts       []ConfigOption
+	registry   *Registry
+	provenance atomic.Pointer[Provenance]
 }
 
 type LoaderParams struct {
 	fx.In
-	Options []ConfigOption `group:"config_options"`
+	Options   []ConfigOption   `group:"config_options"`
+	Resolvers []SchemeResolver `group:"config_resolvers"`
 }
 
-// NewLoader creates a new configuration Loader instance with empty options.
+// NewLoader creates a new configuration Loader instance with the options of the "config_options" group.
+// Values are resolved through a DefaultRegistry extended with the "config_resolvers" group.
 // Use WithOptions to add sources and resolvers.
 func NewLoader(params LoaderParams) *Loader {
+	registry := DefaultRegistry()
+	for _, r := range params.Resolvers {
+		registry.Register(r.Scheme, r.Resolver)
+	}
+
 	return &Loader{
-		opts: params.Options,
+		opts:     params.Options,
+		registry: registry,
 	}
 }
 
@@ -42,6 +53,20 @@ func (l *Loader) WithOptions(opts ...ConfigOption) *Loader {
 	return l
 }
 
+// Registry returns the registry resolving values by scheme, to register more resolvers.
+func (l *Loader) Registry() *Registry {
+	return l.registry
+}
+
+// Provenance reports which source supplied each key of the configuration loaded last.
+// It is safe to call while a watcher reloads; the returned map is not modified afterwards.
+func (l *Loader) Provenance() Provenance {
+	if p := l.provenance.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
 // ProvideConfig creates an Fx provider that reads configuration into a struct type T.
 // T must be a struct with "config" tags.
 //
@@ -54,16 +79,80 @@ func ProvideConfig[T any]() any {
 	return func(loader *Loader) (*T, error) {
 		var cfg T
 
-		// If no sources configured, add default Env source to avoid error
-		opts := loader.opts
-		if len(opts) == 0 {
-			opts = append(opts, WithSources(EnvSource("", DefaultEnvMapper())))
-		}
-
-		err := ReadInConfig(context.Background(), &cfg, opts...)
+		err := ReadInConfig(context.Background(), &cfg, loader.options()...)
 		if err != nil {
 			return nil, err
 		}
 		return &cfg, nil
 	}
 }
+
+// ProvideWatcher creates an Fx provider for a *Watcher[T] together with its initial *T snapshot.
+// The watcher runs for the lifetime of the application, so components holding the Watcher
+// can Get the latest snapshot or subscribe to changes, while those depending on *T keep the
+// configuration they started with.
+func ProvideWatcher[T any]() any {
+	return func(lc fx.Lifecycle, loader *Loader) (*Watcher[T], *T, error) {
+		w, err := NewWatcher[T](context.Background(), loader.options()...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		runInBackground(lc, w.Run)
+
+		return w, w.Get(), nil
+	}
+}
+
+// SupplyVault provides v to the application and renews its token and leases while the application runs.
+// Register v.Resolver() for the "vault" scheme in the "config_resolvers" group to resolve "vault://" values.
+func SupplyVault(v *Vault) fx.Option {
+	return fx.Options(
+		fx.Supply(v),
+		fx.Invoke(func(lc fx.Lifecycle) {
+			runInBackground(lc, v.Run)
+		}),
+	)
+}
+
+// runInBackground runs run from start until stop, waiting for it to return on stop.
+func runInBackground(lc fx.Lifecycle, run func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
+
+// options returns the loader options, defaulting to environment variables when no sources are
+// configured, resolving through the registry and recording the provenance of every load.
+func (l *Loader) options() []ConfigOption {
+	// If no sources configured, add default Env source to avoid error
+	opts := l.opts
+	if len(opts) == 0 {
+		opts = append(opts, WithSources(EnvSource("", DefaultEnvMapper())))
+	}
+	opts = opts[:len(opts):len(opts)]
+	if l.registry != nil {
+		opts = append(opts, WithResolvers(l.registry.Resolver()))
+	}
+	return append(opts, withProvenanceFunc(func(p Provenance) {
+		l.provenance.Store(&p)
+	}))
+}
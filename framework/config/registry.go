@@ -0,0 +1,128 @@
+package config
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+)
+
+// SchemeResolver registers a resolver for a scheme.
+// Under Fx, supply it to the "config_resolvers" group to add it to the Loader's Registry.
+//
+// Example:
+//
+//	fx.Supply(fx.Annotated{
+//		Group:  "config_resolvers",
+//		Target: config.SchemeResolver{Scheme: "sops", Resolver: config.SOPSResolver()},
+//	})
+type SchemeResolver struct {
+	Scheme   string
+	Resolver Resolver
+}
+
+// Registry resolves values by their scheme.
+//
+// Schemes can be chained with "+": the rightmost scheme fetches the value and every scheme to
+// its left is applied to the result, so "base64+awssm://tls-key" decodes the secret fetched
+// from AWS Secrets Manager. Only explicit chains are followed; a fetched value that merely
+// looks like "file://..." is never resolved again.
+type Registry struct {
+	mu        sync.RWMutex
+	resolvers map[string]Resolver
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{resolvers: make(map[string]Resolver)}
+}
+
+// DefaultRegistry creates a Registry with the env, file and base64 resolvers.
+func DefaultRegistry() *Registry {
+	return NewRegistry().
+		Register("env", EnvResolver()).
+		Register("file", FileResolver()).
+		Register("base64", Base64Resolver())
+}
+
+// Register adds or replaces the resolver for scheme (without "://").
+// The resolver receives the value with its scheme, e.g. "sops://secrets.enc.yaml#db.password".
+func (r *Registry) Register(scheme string, resolver Resolver) *Registry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resolvers[scheme] = resolver
+	return r
+}
+
+// Schemes returns the registered schemes in sorted order.
+func (r *Registry) Schemes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schemes := make([]string, 0, len(r.resolvers))
+	for scheme := range r.resolvers {
+		schemes = append(schemes, scheme)
+	}
+	sort.Strings(schemes)
+	return schemes
+}
+
+// Resolver returns a Resolver dispatching on the scheme of the value.
+// Values with an unregistered scheme are left to other resolvers (ErrInvalidResolver).
+func (r *Registry) Resolver() Resolver {
+	return func(ctx context.Context, raw string) (string, error) {
+		scheme, rest, ok := strings.Cut(raw, "://")
+		if !ok {
+			return "", ErrInvalidResolver
+		}
+
+		chain := strings.Split(scheme, "+")
+		r.mu.RLock()
+		resolvers := make([]Resolver, len(chain))
+		for i, s := range chain {
+			resolvers[i] = r.resolvers[s]
+		}
+		r.mu.RUnlock()
+
+		// Leave values whose fetching scheme is not ours to other resolvers
+		last := len(chain) - 1
+		if resolvers[last] == nil {
+			return "", ErrInvalidResolver
+		}
+
+		value, err := resolvers[last](ctx, chain[last]+"://"+rest)
+		if err != nil {
+			return "", err
+		}
+		for i := last - 1; i >= 0; i-- {
+			if resolvers[i] == nil {
+				return "", NewValidationError("scheme", "unknown scheme in chain", chain[i])
+			}
+			if value, err = resolvers[i](ctx, chain[i]+"://"+value); err != nil {
+				return "", errors.Wrapf(err, "failed to apply %s", chain[i])
+			}
+		}
+
+		return value, nil
+	}
+}
+
+// selectField returns field (a gjson path) of the JSON document, or the whole document if field is empty.
+// String fields are returned as-is, others as JSON.
+func selectField(raw string, document []byte, field string) (string, error) {
+	if field == "" {
+		return string(document), nil
+	}
+
+	res := gjson.GetBytes(document, field)
+	if !res.Exists() {
+		return "", NewSourceError(raw, errors.Wrapf(ErrNotFound, "field %q", field))
+	}
+	if res.Type == gjson.String {
+		return res.String(), nil
+	}
+	return res.Raw, nil
+}
@@ -13,7 +13,7 @@
 //		config.EnvResolver(),      // Resolves "env://VAR"
 //		config.FileResolver(),     // Resolves "file:///path"
 //		config.Base64Resolver(),   // Resolves "base64://VALUE"
-//		config.VaultResolver(url, token, mount, ""),
+//		vault.Resolver(),         // Resolves "vault://path#field"
 //	}
 //
 //	// Apply to configuration
@@ -25,9 +25,9 @@ package config
 import (
 	"context"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -181,8 +181,8 @@ func Base64Resolver() Resolver {
 	}
 }
 
-// VaultResolver creates a resolver that fetches secrets from HashiCorp Vault.
-// It resolves values with the "vault://" scheme and returns JSON-encoded secrets.
+// VaultResolver creates a resolver that fetches secrets from HashiCorp Vault with a static token.
+// It resolves values with the "vault://" scheme from a KV v2 engine.
 //
 // Parameters:
 //   - url: Vault server URL (e.g., "https://vault:8200")
@@ -192,41 +192,18 @@ func Base64Resolver() Resolver {
 //
 // Examples:
 //   - "vault://database" -> JSON secret from secret/data/database
-//   - "vault://api/keys" -> JSON secret from secret/data/api/keys
+//   - "vault://database#password" -> the password field of secret/data/database
 //
 // Returns:
 //   - Resolver: A resolver that can be used with ReadInConfig
 //
 // Note:
-// The resolver returns the JSON representation of the secret from Vault.
-// The key is extracted from the portion of the string after "vault://".
+// Use NewVault for AppRole or Kubernetes auth, KV v1 and dynamic secrets with lease renewal.
 func VaultResolver(url, token, mountPath, vaultPath string) Resolver {
-	const scheme = "vault://"
-	client := VaultClient(url, token, mountPath)
-	return func(ctx context.Context, raw string) (string, error) {
-		if !strings.HasPrefix(raw, scheme) {
-			return "", ErrInvalidResolver
-		}
-
-		// Extract the key from raw input, not from the vaultPath parameter
-		raw = strings.TrimPrefix(raw, scheme)
-		if raw == "" {
-			return "", ErrInvalidPath
-		}
-
-		// Use the extracted key (raw), not the vaultPath parameter
-		key, err := client(ctx, raw)
-		if err != nil {
-			return "", errors.Wrapf(err, "failed to fetch from vault for key %q", raw)
-		}
-
-		rawVal, err := json.Marshal(key)
-		if err != nil {
-			return "", errors.Wrapf(err, "failed to marshal vault key %q", raw)
-		}
-
-		return string(rawVal), nil
-	}
+	return NewVault(url, VaultTokenAuth(token),
+		WithVaultKV(mountPath, 2),
+		WithVaultPrefix(path.Join(mountPath, vaultPath)),
+	).Resolver()
 }
 
 // validateResolverPath ensures the resolver file path is safe
@@ -0,0 +1,252 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+)
+
+// AWSCredentials are the credentials used to sign AWS requests.
+type AWSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// AWSOption configures AWSSecretsManagerResolver.
+type AWSOption func(*awsSecretsManager)
+
+// WithAWSRegion sets the region, instead of AWS_REGION or AWS_DEFAULT_REGION.
+func WithAWSRegion(region string) AWSOption {
+	return func(a *awsSecretsManager) {
+		a.region = region
+	}
+}
+
+// WithAWSEndpoint sets the Secrets Manager endpoint, e.g. a local stand-in,
+// instead of AWS_ENDPOINT_URL_SECRETS_MANAGER, AWS_ENDPOINT_URL or the regional endpoint.
+func WithAWSEndpoint(endpoint string) AWSOption {
+	return func(a *awsSecretsManager) {
+		a.endpoint = strings.TrimSuffix(endpoint, "/")
+	}
+}
+
+// WithAWSCredentials sets the credentials, instead of AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN.
+func WithAWSCredentials(creds AWSCredentials) AWSOption {
+	return func(a *awsSecretsManager) {
+		a.creds = creds
+	}
+}
+
+// WithAWSHTTPClient sets the HTTP client used to call AWS.
+func WithAWSHTTPClient(client *http.Client) AWSOption {
+	return func(a *awsSecretsManager) {
+		a.client = client
+	}
+}
+
+type awsSecretsManager struct {
+	region   string
+	endpoint string
+	creds    AWSCredentials
+	client   *http.Client
+	now      func() time.Time
+}
+
+// AWSSecretsManagerResolver creates a resolver for AWS Secrets Manager.
+// It resolves values with the "awssm://" scheme; the secret is given by name or ARN,
+// optionally followed by "#field" to select a field of a JSON secret (gjson path).
+// Binary secrets are returned decoded.
+//
+// Examples:
+//   - "awssm://prod/postgres#password"
+//   - "awssm://arn:aws:secretsmanager:ap-southeast-3:123456789012:secret:prod/api-key"
+//
+// Region, credentials and endpoint default to the standard AWS environment variables.
+func AWSSecretsManagerResolver(opts ...AWSOption) Resolver {
+	const scheme = "awssm://"
+	a := &awsSecretsManager{
+		region: firstEnv("AWS_REGION", "AWS_DEFAULT_REGION"),
+		creds: AWSCredentials{
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		},
+		endpoint: strings.TrimSuffix(firstEnv("AWS_ENDPOINT_URL_SECRETS_MANAGER", "AWS_ENDPOINT_URL"), "/"),
+		client:   &http.Client{Timeout: DefaultHTTPTimeout},
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return func(ctx context.Context, raw string) (string, error) {
+		if !strings.HasPrefix(raw, scheme) {
+			return "", ErrInvalidResolver
+		}
+
+		id, field, _ := strings.Cut(strings.TrimPrefix(raw, scheme), "#")
+		if id == "" {
+			return "", NewValidationError("secret", "secret id cannot be empty", nil)
+		}
+
+		value, err := a.getSecretValue(ctx, id)
+		if err != nil {
+			return "", NewSourceError(scheme+id, err)
+		}
+
+		return selectField(raw, value, field)
+	}
+}
+
+// getSecretValue calls the GetSecretValue action of the Secrets Manager JSON API.
+func (a *awsSecretsManager) getSecretValue(ctx context.Context, id string) ([]byte, error) {
+	if a.region == "" {
+		return nil, NewValidationError("region", "AWS region is not set", nil)
+	}
+	if a.creds.AccessKeyID == "" || a.creds.SecretAccessKey == "" {
+		return nil, NewValidationError("credentials", "AWS credentials are not set", nil)
+	}
+
+	endpoint := a.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com", a.region)
+	}
+
+	body, err := json.Marshal(map[string]string{"SecretId": id})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	a.sign(req, body)
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "request failed")
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		// e.g. {"__type":"ResourceNotFoundException","message":"..."}
+		kind := gjson.GetBytes(raw, "__type").String()
+		if strings.HasSuffix(kind, "ResourceNotFoundException") {
+			return nil, ErrNotFound
+		}
+		message := gjson.GetBytes(raw, "message").String()
+		if message == "" {
+			message = gjson.GetBytes(raw, "Message").String()
+		}
+		return nil, NewHTTPError(resp.StatusCode, strings.TrimSpace(kind+" "+message))
+	}
+
+	if s := gjson.GetBytes(raw, "SecretString"); s.Exists() {
+		return []byte(s.String()), nil
+	}
+	if b := gjson.GetBytes(raw, "SecretBinary"); b.Exists() {
+		return base64.StdEncoding.DecodeString(b.String())
+	}
+	return nil, errors.New("secret has no value")
+}
+
+// sign adds an AWS Signature Version 4 to req.
+func (a *awsSecretsManager) sign(req *http.Request, body []byte) {
+	const service = "secretsmanager"
+
+	now := a.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if a.creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", a.creds.SessionToken)
+	}
+
+	// Header names are already sorted
+	signed := []string{"content-type", "host", "x-amz-content-sha256", "x-amz-date"}
+	if a.creds.SessionToken != "" {
+		signed = append(signed, "x-amz-security-token")
+	}
+	signed = append(signed, "x-amz-target")
+
+	var canonicalHeaders strings.Builder
+	for _, name := range signed {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	signedHeaders := strings.Join(signed, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, a.region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+a.creds.SecretAccessKey), date)
+	key = hmacSHA256(key, a.region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		a.creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// firstEnv returns the first non-empty environment variable of names.
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
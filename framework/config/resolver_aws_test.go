@@ -0,0 +1,189 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSecretsManager serves GetSecretValue for "prod/postgres" (a JSON string with a base64
+// "tls_key" field), "prod/tls" (binary) and "prod/denied" (access denied) to requests signed
+// for ap-southeast-3 by AKIDEXAMPLE.
+func fakeSecretsManager(t *testing.T) *httptest.Server {
+	t.Helper()
+	reply := func(w http.ResponseWriter, code int, body any) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(body)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || r.URL.Path != "/" || r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" {
+			t.Errorf("expected POST / with the GetSecretValue target, got %s %s %q", r.Method, r.URL.Path, r.Header.Get("X-Amz-Target"))
+		}
+		if r.Header.Get("Content-Type") != "application/x-amz-json-1.1" {
+			t.Errorf("expected the AWS JSON content type, got %q", r.Header.Get("Content-Type"))
+		}
+		if r.Header.Get("X-Amz-Content-Sha256") != sha256Hex(body) {
+			t.Errorf("expected X-Amz-Content-Sha256 to hash the body")
+		}
+		auth := r.Header.Get("Authorization")
+		date := r.Header.Get("X-Amz-Date")
+		if len(date) < 8 || !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/"+date[:8]+"/ap-southeast-3/secretsmanager/aws4_request, ") {
+			reply(w, http.StatusForbidden, map[string]string{"__type": "InvalidSignatureException", "message": "bad credential scope"})
+			return
+		}
+
+		var req struct{ SecretId string }
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Errorf("invalid request body %s: %v", body, err)
+		}
+		switch req.SecretId {
+		case "prod/postgres":
+			reply(w, http.StatusOK, map[string]any{"Name": req.SecretId, "SecretString": `{"username":"morgan","password":"s3cret","port":5432,"tls_key":"LS0tLS1CRUdJTiBLRVktLS0tLQ=="}`})
+		case "prod/tls":
+			reply(w, http.StatusOK, map[string]any{"Name": req.SecretId, "SecretBinary": base64.StdEncoding.EncodeToString([]byte("-----BEGIN KEY-----"))})
+		case "prod/denied":
+			reply(w, http.StatusBadRequest, map[string]string{"__type": "AccessDeniedException", "Message": "not authorized to perform secretsmanager:GetSecretValue"})
+		default:
+			reply(w, http.StatusBadRequest, map[string]string{"__type": "ResourceNotFoundException", "message": "Secrets Manager can't find the specified secret."})
+		}
+	}))
+}
+
+func newTestAWSResolver(server *httptest.Server) Resolver {
+	return AWSSecretsManagerResolver(
+		WithAWSRegion("ap-southeast-3"),
+		WithAWSEndpoint(server.URL+"/"),
+		WithAWSCredentials(AWSCredentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}),
+		WithAWSHTTPClient(server.Client()),
+	)
+}
+
+func TestAWSSecretsManager_Sign(t *testing.T) {
+	a := &awsSecretsManager{
+		region: "ap-southeast-3",
+		creds: AWSCredentials{
+			AccessKeyID:     "AKIDEXAMPLE",
+			SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+			SessionToken:    "session-token",
+		},
+		now: func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) },
+	}
+
+	body := []byte(`{"SecretId":"prod/postgres"}`)
+	req, err := http.NewRequest(http.MethodPost, "http://secrets.test/", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	a.sign(req, body)
+
+	want := map[string]string{
+		"X-Amz-Date":           "20260102T030405Z",
+		"X-Amz-Content-Sha256": "de066ed3c287d18c44703a86781d4736044f019eb20ebc6488dbbf318bc2ec46",
+		"X-Amz-Security-Token": "session-token",
+		"Authorization": "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20260102/ap-southeast-3/secretsmanager/aws4_request, " +
+			"SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date;x-amz-security-token;x-amz-target, " +
+			"Signature=c3986b3394cebee43843bccb56eb38c7b82d6b04a48e3fe84c5d1a7b8a444da9",
+	}
+	for name, value := range want {
+		if got := req.Header.Get(name); got != value {
+			t.Errorf("%s: expected %q, got %q", name, value, got)
+		}
+	}
+}
+
+func TestAWSSecretsManagerResolver(t *testing.T) {
+	server := fakeSecretsManager(t)
+	defer server.Close()
+
+	resolve := newTestAWSResolver(server)
+	ctx := context.Background()
+
+	cases := []struct {
+		raw  string
+		want string
+	}{
+		{"awssm://prod/postgres", `{"username":"morgan","password":"s3cret","port":5432,"tls_key":"LS0tLS1CRUdJTiBLRVktLS0tLQ=="}`},
+		{"awssm://prod/postgres#password", "s3cret"},
+		{"awssm://prod/postgres#port", "5432"},
+		{"awssm://prod/tls", "-----BEGIN KEY-----"},
+	}
+	for _, tc := range cases {
+		got, err := resolve(ctx, tc.raw)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.raw, err)
+		}
+		if got != tc.want {
+			t.Errorf("%s: expected %q, got %q", tc.raw, tc.want, got)
+		}
+	}
+
+	t.Run("NotFound", func(t *testing.T) {
+		for _, raw := range []string{"awssm://prod/missing", "awssm://prod/postgres#missing"} {
+			if _, err := resolve(ctx, raw); !errors.Is(err, ErrNotFound) {
+				t.Errorf("%s: expected ErrNotFound, got %v", raw, err)
+			}
+		}
+	})
+
+	t.Run("AccessDenied", func(t *testing.T) {
+		_, err := resolve(ctx, "awssm://prod/denied#password")
+		var httpErr *HTTPError
+		if !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest || !strings.Contains(httpErr.Message, "AccessDeniedException") {
+			t.Fatalf("expected an AccessDeniedException HTTP error, got %v", err)
+		}
+		if errors.Is(err, ErrNotFound) {
+			t.Error("expected access denied not to be reported as not found")
+		}
+	})
+
+	t.Run("WrongScheme", func(t *testing.T) {
+		if _, err := resolve(ctx, "gcpsm://prod/postgres"); err != ErrInvalidResolver {
+			t.Errorf("expected ErrInvalidResolver for another scheme, got %v", err)
+		}
+	})
+
+	t.Run("MissingCredentials", func(t *testing.T) {
+		t.Setenv("AWS_ACCESS_KEY_ID", "")
+		t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+		resolve := AWSSecretsManagerResolver(WithAWSRegion("ap-southeast-3"), WithAWSEndpoint(server.URL))
+		var validation *ValidationError
+		if _, err := resolve(ctx, "awssm://prod/postgres"); !errors.As(err, &validation) {
+			t.Errorf("expected a validation error without credentials, got %v", err)
+		}
+	})
+}
+
+func TestAWSSecretsManagerResolver_Chain(t *testing.T) {
+	server := fakeSecretsManager(t)
+	defer server.Close()
+
+	resolve := DefaultRegistry().Register("awssm", newTestAWSResolver(server)).Resolver()
+	ctx := context.Background()
+
+	// The field holds base64 text, decoded by the scheme on its left
+	got, err := resolve(ctx, "base64+awssm://prod/postgres#tls_key")
+	if err != nil || got != "-----BEGIN KEY-----" {
+		t.Fatalf("expected the decoded field, got %q, %v", got, err)
+	}
+
+	// A field that is not base64 fails the chain, as does a secret that cannot be fetched
+	if _, err := resolve(ctx, "base64+awssm://prod/postgres#username"); err == nil {
+		t.Error("expected decoding a plain username to fail")
+	}
+	if _, err := resolve(ctx, "base64+awssm://prod/missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected the fetching error through the chain, got %v", err)
+	}
+}
@@ -0,0 +1,227 @@
+package config
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+)
+
+// GCPTokenSource returns an OAuth2 access token for Google Cloud APIs.
+type GCPTokenSource func(ctx context.Context) (string, error)
+
+// GCPOption configures GCPSecretManagerResolver.
+type GCPOption func(*gcpSecretManager)
+
+// WithGCPProject sets the project of short secret names, instead of GOOGLE_CLOUD_PROJECT.
+func WithGCPProject(project string) GCPOption {
+	return func(g *gcpSecretManager) {
+		g.project = project
+	}
+}
+
+// WithGCPEndpoint sets the Secret Manager endpoint, e.g. a local stand-in.
+func WithGCPEndpoint(endpoint string) GCPOption {
+	return func(g *gcpSecretManager) {
+		g.endpoint = strings.TrimSuffix(endpoint, "/")
+	}
+}
+
+// WithGCPTokenSource sets how access tokens are obtained,
+// instead of GOOGLE_OAUTH_ACCESS_TOKEN or the metadata server.
+func WithGCPTokenSource(source GCPTokenSource) GCPOption {
+	return func(g *gcpSecretManager) {
+		g.token = source
+	}
+}
+
+// WithGCPHTTPClient sets the HTTP client used to call Google Cloud.
+func WithGCPHTTPClient(client *http.Client) GCPOption {
+	return func(g *gcpSecretManager) {
+		g.client = client
+	}
+}
+
+type gcpSecretManager struct {
+	project  string
+	endpoint string
+	token    GCPTokenSource
+	client   *http.Client
+}
+
+// GCPSecretManagerResolver creates a resolver for Google Cloud Secret Manager.
+// It resolves values with the "gcpsm://" scheme; the secret is given by name in the default
+// project, with an optional version (latest otherwise), or by its full resource name,
+// optionally followed by "#field" to select a field of a JSON secret (gjson path).
+//
+// Examples:
+//   - "gcpsm://postgres-password"
+//   - "gcpsm://postgres/versions/3#password"
+//   - "gcpsm://projects/morgan/secrets/api-key/versions/latest"
+//
+// Access tokens come from GOOGLE_OAUTH_ACCESS_TOKEN or, on Google Cloud, the metadata server.
+func GCPSecretManagerResolver(opts ...GCPOption) Resolver {
+	const scheme = "gcpsm://"
+	g := &gcpSecretManager{
+		project:  os.Getenv("GOOGLE_CLOUD_PROJECT"),
+		endpoint: "https://secretmanager.googleapis.com",
+		client:   &http.Client{Timeout: DefaultHTTPTimeout},
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	if g.token == nil {
+		if token := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"); token != "" {
+			g.token = func(context.Context) (string, error) { return token, nil }
+		} else {
+			g.token = GCPMetadataTokenSource(g.client)
+		}
+	}
+
+	return func(ctx context.Context, raw string) (string, error) {
+		if !strings.HasPrefix(raw, scheme) {
+			return "", ErrInvalidResolver
+		}
+
+		secret, field, _ := strings.Cut(strings.TrimPrefix(raw, scheme), "#")
+		name, err := g.resourceName(secret)
+		if err != nil {
+			return "", err
+		}
+
+		value, err := g.access(ctx, name)
+		if err != nil {
+			return "", NewSourceError(scheme+name, err)
+		}
+
+		return selectField(raw, value, field)
+	}
+}
+
+// resourceName expands a secret reference to "projects/{project}/secrets/{secret}/versions/{version}".
+func (g *gcpSecretManager) resourceName(secret string) (string, error) {
+	if secret == "" {
+		return "", NewValidationError("secret", "secret name cannot be empty", nil)
+	}
+
+	name := secret
+	if !strings.HasPrefix(name, "projects/") {
+		if g.project == "" {
+			return "", NewValidationError("project", "GCP project is not set", secret)
+		}
+		name = fmt.Sprintf("projects/%s/secrets/%s", g.project, secret)
+	}
+	if !strings.Contains(name, "/versions/") {
+		name += "/versions/latest"
+	}
+
+	parts := strings.Split(name, "/")
+	if len(parts) != 6 || parts[0] != "projects" || parts[2] != "secrets" || parts[4] != "versions" {
+		return "", NewValidationError("secret", "invalid secret name", secret)
+	}
+	for _, part := range parts {
+		if part == "" || part == "." || part == ".." {
+			return "", NewValidationError("secret", "invalid secret name", secret)
+		}
+	}
+	return name, nil
+}
+
+// access calls the AccessSecretVersion method of the Secret Manager REST API.
+func (g *gcpSecretManager) access(ctx context.Context, name string) ([]byte, error) {
+	token, err := g.token(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get access token")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/%s:access", g.endpoint, name), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "request failed")
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewHTTPError(resp.StatusCode, gjson.GetBytes(raw, "error.message").String())
+	}
+
+	data := gjson.GetBytes(raw, "payload.data")
+	if !data.Exists() {
+		return nil, errors.New("secret has no payload")
+	}
+	return base64.StdEncoding.DecodeString(data.String())
+}
+
+// GCPMetadataTokenSource returns tokens of the instance's service account from the metadata server
+// (GCE_METADATA_HOST, or metadata.google.internal), caching them until shortly before they expire.
+func GCPMetadataTokenSource(client *http.Client) GCPTokenSource {
+	var (
+		mu      sync.Mutex
+		token   string
+		expires time.Time
+	)
+
+	return func(ctx context.Context) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if token != "" && time.Now().Before(expires) {
+			return token, nil
+		}
+
+		host := os.Getenv("GCE_METADATA_HOST")
+		if host == "" {
+			host = "metadata.google.internal"
+		}
+		url := fmt.Sprintf("http://%s/computeMetadata/v1/instance/service-accounts/default/token", host)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Metadata-Flavor", "Google")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return "", errors.Wrap(err, "metadata server request failed")
+		}
+		defer resp.Body.Close()
+
+		raw, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return "", NewHTTPError(resp.StatusCode, strings.TrimSpace(string(raw)))
+		}
+
+		token = gjson.GetBytes(raw, "access_token").String()
+		if token == "" {
+			return "", errors.New("metadata server returned no access token")
+		}
+		ttl := time.Duration(gjson.GetBytes(raw, "expires_in").Int()) * time.Second
+		expires = time.Now().Add(ttl - time.Minute)
+		return token, nil
+	}
+}
@@ -0,0 +1,151 @@
+package config
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// fakeSecretManager serves AccessSecretVersion in the project "morgan" for the token "token":
+// "postgres" (a JSON secret whose version 1 is older), "tls-key" (base64 text) and "denied".
+func fakeSecretManager(t *testing.T) *httptest.Server {
+	t.Helper()
+	reply := func(w http.ResponseWriter, code int, body any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(body)
+	}
+	payload := func(w http.ResponseWriter, name, data string) {
+		reply(w, http.StatusOK, map[string]any{
+			"name":    name,
+			"payload": map[string]any{"data": base64.StdEncoding.EncodeToString([]byte(data))},
+		})
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			reply(w, http.StatusUnauthorized, map[string]any{"error": map[string]any{"code": 401, "message": "Request had invalid authentication credentials."}})
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/projects/morgan/secrets/postgres/versions/latest:access":
+			payload(w, "projects/morgan/secrets/postgres/versions/2", `{"username":"morgan","password":"s3cret","port":5432}`)
+		case "/v1/projects/morgan/secrets/postgres/versions/1:access":
+			payload(w, "projects/morgan/secrets/postgres/versions/1", `{"username":"morgan","password":"old"}`)
+		case "/v1/projects/morgan/secrets/tls-key/versions/latest:access":
+			payload(w, "projects/morgan/secrets/tls-key/versions/1", "LS0tLS1CRUdJTiBLRVktLS0tLQ==")
+		case "/v1/projects/morgan/secrets/denied/versions/latest:access":
+			reply(w, http.StatusForbidden, map[string]any{"error": map[string]any{"code": 403, "message": "Permission 'secretmanager.versions.access' denied"}})
+		default:
+			reply(w, http.StatusNotFound, map[string]any{"error": map[string]any{"code": 404, "message": "Secret not found"}})
+		}
+	}))
+}
+
+func newTestGCPResolver(server *httptest.Server, token string) Resolver {
+	return GCPSecretManagerResolver(
+		WithGCPProject("morgan"),
+		WithGCPEndpoint(server.URL+"/"),
+		WithGCPTokenSource(func(context.Context) (string, error) { return token, nil }),
+		WithGCPHTTPClient(server.Client()),
+	)
+}
+
+func TestGCPSecretManagerResolver(t *testing.T) {
+	server := fakeSecretManager(t)
+	defer server.Close()
+
+	resolve := newTestGCPResolver(server, "token")
+	ctx := context.Background()
+
+	cases := []struct {
+		raw  string
+		want string
+	}{
+		{"gcpsm://postgres", `{"username":"morgan","password":"s3cret","port":5432}`},
+		{"gcpsm://postgres#password", "s3cret"},
+		{"gcpsm://postgres#port", "5432"},
+		{"gcpsm://postgres/versions/1#password", "old"},
+		{"gcpsm://projects/morgan/secrets/postgres/versions/latest#username", "morgan"},
+		{"gcpsm://projects/morgan/secrets/postgres#password", "s3cret"},
+	}
+	for _, tc := range cases {
+		got, err := resolve(ctx, tc.raw)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.raw, err)
+		}
+		if got != tc.want {
+			t.Errorf("%s: expected %q, got %q", tc.raw, tc.want, got)
+		}
+	}
+
+	t.Run("NotFound", func(t *testing.T) {
+		for _, raw := range []string{"gcpsm://missing", "gcpsm://postgres#missing"} {
+			if _, err := resolve(ctx, raw); !errors.Is(err, ErrNotFound) {
+				t.Errorf("%s: expected ErrNotFound, got %v", raw, err)
+			}
+		}
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		_, err := resolve(ctx, "gcpsm://denied")
+		var httpErr *HTTPError
+		if !errors.As(err, &httpErr) || httpErr.Code != http.StatusForbidden || httpErr.Message != "Permission 'secretmanager.versions.access' denied" {
+			t.Fatalf("expected a 403 HTTP error with the API message, got %v", err)
+		}
+
+		_, err = newTestGCPResolver(server, "expired")(ctx, "gcpsm://postgres")
+		if !errors.As(err, &httpErr) || httpErr.Code != http.StatusUnauthorized {
+			t.Errorf("expected a 401 HTTP error for a rejected token, got %v", err)
+		}
+	})
+
+	t.Run("InvalidName", func(t *testing.T) {
+		for _, raw := range []string{"gcpsm://", "gcpsm://../secrets/postgres", "gcpsm://projects/morgan/postgres"} {
+			var validation *ValidationError
+			if _, err := resolve(ctx, raw); !errors.As(err, &validation) {
+				t.Errorf("%s: expected a validation error, got %v", raw, err)
+			}
+		}
+
+		t.Setenv("GOOGLE_CLOUD_PROJECT", "")
+		noProject := GCPSecretManagerResolver(WithGCPEndpoint(server.URL), WithGCPTokenSource(func(context.Context) (string, error) { return "token", nil }))
+		var validation *ValidationError
+		if _, err := noProject(ctx, "gcpsm://postgres"); !errors.As(err, &validation) {
+			t.Errorf("expected a validation error without a project, got %v", err)
+		}
+	})
+
+	t.Run("WrongScheme", func(t *testing.T) {
+		if _, err := resolve(ctx, "awssm://postgres"); err != ErrInvalidResolver {
+			t.Errorf("expected ErrInvalidResolver for another scheme, got %v", err)
+		}
+	})
+}
+
+func TestGCPSecretManagerResolver_Chain(t *testing.T) {
+	server := fakeSecretManager(t)
+	defer server.Close()
+
+	resolve := DefaultRegistry().Register("gcpsm", newTestGCPResolver(server, "token")).Resolver()
+	ctx := context.Background()
+
+	got, err := resolve(ctx, "base64+gcpsm://tls-key")
+	if err != nil || got != "-----BEGIN KEY-----" {
+		t.Fatalf("expected the decoded secret, got %q, %v", got, err)
+	}
+
+	// Without the chain the fetched text is returned as stored
+	got, err = resolve(ctx, "gcpsm://tls-key")
+	if err != nil || got != "LS0tLS1CRUdJTiBLRVktLS0tLQ==" {
+		t.Fatalf("expected the secret as stored, got %q, %v", got, err)
+	}
+}
@@ -0,0 +1,278 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// SOPSOption configures SOPSResolver.
+type SOPSOption func(*sopsResolver)
+
+// WithSOPSIdentities sets the age identities used to decrypt data keys,
+// instead of reading them from SOPS_AGE_KEY, SOPS_AGE_KEY_FILE or ~/.config/sops/age/keys.txt.
+func WithSOPSIdentities(identities ...age.Identity) SOPSOption {
+	return func(r *sopsResolver) {
+		r.identities = identities
+	}
+}
+
+type sopsResolver struct {
+	identities []age.Identity
+
+	mu sync.Mutex
+	// files caches decrypted files by path, until they are modified.
+	files map[string]sopsFile
+}
+
+type sopsFile struct {
+	modTime time.Time
+	data    []byte
+}
+
+// SOPSResolver creates a resolver for files encrypted with SOPS and age.
+// It resolves values with the "sops://" scheme; "#field" selects a value (gjson path),
+// otherwise the whole decrypted document is returned as JSON.
+//
+// Examples:
+//   - "sops://config/secrets.enc.yaml#postgres.password"
+//   - "sops://config/secrets.enc.json"
+//
+// YAML and JSON files are supported. Every value is authenticated by AES-GCM bound to its
+// key path; the document-wide MAC is not checked.
+func SOPSResolver(opts ...SOPSOption) Resolver {
+	const scheme = "sops://"
+	r := &sopsResolver{files: make(map[string]sopsFile)}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return func(ctx context.Context, raw string) (string, error) {
+		if !strings.HasPrefix(raw, scheme) {
+			return "", ErrInvalidResolver
+		}
+
+		path, field, _ := strings.Cut(strings.TrimPrefix(raw, scheme), "#")
+		if path == "" {
+			return "", NewValidationError("path", "file path cannot be empty", nil)
+		}
+		if err := validateResolverPath(path); err != nil {
+			return "", errors.Wrapf(err, "invalid file path %q", path)
+		}
+
+		document, err := r.decrypt(path)
+		if err != nil {
+			return "", NewSourceError(fmt.Sprintf("sops://%s", path), err)
+		}
+
+		return selectField(raw, document, field)
+	}
+}
+
+// decrypt returns the decrypted document at path as JSON.
+func (r *sopsResolver) decrypt(path string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cached, ok := r.files[path]; ok && cached.modTime.Equal(info.ModTime()) {
+		return cached.data, nil
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read file")
+	}
+
+	// JSON is valid YAML
+	var tree map[string]any
+	if err := yaml.Unmarshal(raw, &tree); err != nil {
+		return nil, errors.Wrap(err, "failed to parse file")
+	}
+
+	metadata, ok := tree["sops"].(map[string]any)
+	if !ok {
+		return nil, errors.New("file has no sops metadata")
+	}
+	delete(tree, "sops")
+
+	key, err := r.dataKey(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted, err := sopsDecryptTree(tree, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(decrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	r.files[path] = sopsFile{modTime: info.ModTime(), data: data}
+	return data, nil
+}
+
+// dataKey decrypts the file's data key with the first age identity that matches a recipient.
+func (r *sopsResolver) dataKey(metadata map[string]any) ([]byte, error) {
+	identities := r.identities
+	if len(identities) == 0 {
+		var err error
+		if identities, err = sopsAgeIdentities(); err != nil {
+			return nil, err
+		}
+	}
+
+	recipients, _ := metadata["age"].([]any)
+	if len(recipients) == 0 {
+		return nil, errors.New("file has no age recipients, only age is supported")
+	}
+
+	var lastErr error
+	for _, recipient := range recipients {
+		entry, _ := recipient.(map[string]any)
+		enc, _ := entry["enc"].(string)
+		if enc == "" {
+			continue
+		}
+
+		plain, err := age.Decrypt(armor.NewReader(strings.NewReader(enc)), identities...)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		key, err := io.ReadAll(plain)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return key, nil
+	}
+
+	return nil, errors.Wrap(lastErr, "failed to decrypt data key with the available age identities")
+}
+
+// sopsAgeIdentities loads the age identities the way sops does.
+func sopsAgeIdentities() ([]age.Identity, error) {
+	if key := os.Getenv("SOPS_AGE_KEY"); key != "" {
+		return age.ParseIdentities(strings.NewReader(key))
+	}
+
+	path := os.Getenv("SOPS_AGE_KEY_FILE")
+	if path == "" {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to locate age key file")
+		}
+		path = filepath.Join(dir, "sops", "age", "keys.txt")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read age key file")
+	}
+	return age.ParseIdentities(bytes.NewReader(data))
+}
+
+// sopsValue matches an encrypted value, e.g. ENC[AES256_GCM,data:...,iv:...,tag:...,type:str].
+var sopsValue = regexp.MustCompile(`^ENC\[AES256_GCM,data:(.+),iv:(.+),tag:(.+),type:(.+)\]$`)
+
+// sopsDecryptTree decrypts every encrypted value. As in sops, the key path ("db:password:")
+// is the additional data of each value; list items share the path of their list.
+func sopsDecryptTree(value any, key []byte, path []string) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, item := range v {
+			decrypted, err := sopsDecryptTree(item, key, append(path[:len(path):len(path)], k))
+			if err != nil {
+				return nil, err
+			}
+			v[k] = decrypted
+		}
+		return v, nil
+	case []any:
+		for i, item := range v {
+			decrypted, err := sopsDecryptTree(item, key, path)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = decrypted
+		}
+		return v, nil
+	case string:
+		if !strings.HasPrefix(v, "ENC[") {
+			return v, nil
+		}
+		return sopsDecryptValue(v, key, strings.Join(path, ":")+":")
+	default:
+		return v, nil
+	}
+}
+
+func sopsDecryptValue(value string, key []byte, additionalData string) (any, error) {
+	m := sopsValue.FindStringSubmatch(value)
+	if m == nil {
+		return nil, errors.Errorf("malformed encrypted value at %q", additionalData)
+	}
+
+	var parts [3][]byte
+	for i := range parts {
+		decoded, err := base64.StdEncoding.DecodeString(m[i+1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "malformed encrypted value at %q", additionalData)
+		}
+		parts[i] = decoded
+	}
+	data, iv, tag := parts[0], parts[1], parts[2]
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid data key")
+	}
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(iv))
+	if err != nil {
+		return nil, err
+	}
+	plain, err := gcm.Open(nil, iv, append(data, tag...), []byte(additionalData))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt value at %q", additionalData)
+	}
+
+	switch m[4] {
+	case "int":
+		return strconv.Atoi(string(plain))
+	case "float":
+		return strconv.ParseFloat(string(plain), 64)
+	case "bool":
+		return strconv.ParseBool(string(plain))
+	default:
+		// str, bytes and comment
+		return string(plain), nil
+	}
+}
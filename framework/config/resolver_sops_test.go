@@ -0,0 +1,88 @@
+package config
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+)
+
+// testdata/secrets.enc.yaml is encrypted for the age identity in testdata/age.key.
+func sopsIdentities(t *testing.T) []age.Identity {
+	t.Helper()
+	key, err := os.ReadFile("testdata/age.key")
+	if err != nil {
+		t.Fatalf("read key: %v", err)
+	}
+	identities, err := age.ParseIdentities(strings.NewReader(string(key)))
+	if err != nil {
+		t.Fatalf("parse key: %v", err)
+	}
+	return identities
+}
+
+func TestSOPSResolver(t *testing.T) {
+	resolve := SOPSResolver(WithSOPSIdentities(sopsIdentities(t)...))
+	ctx := context.Background()
+
+	cases := []struct {
+		raw  string
+		want string
+	}{
+		{"sops://testdata/secrets.enc.yaml#postgres.password", "s3cret"},
+		{"sops://testdata/secrets.enc.yaml#postgres.port", "5432"},
+		{"sops://testdata/secrets.enc.yaml#hosts", `["db-1","db-2"]`},
+	}
+	for _, tc := range cases {
+		got, err := resolve(ctx, tc.raw)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.raw, err)
+		}
+		if got != tc.want {
+			t.Errorf("%s: expected %q, got %q", tc.raw, tc.want, got)
+		}
+	}
+
+	if _, err := resolve(ctx, "sops://testdata/secrets.enc.yaml#postgres.user"); err == nil {
+		t.Error("expected a missing field to fail")
+	}
+	if _, err := resolve(ctx, "sops://../secrets.enc.yaml"); err == nil {
+		t.Error("expected path traversal to be rejected")
+	}
+}
+
+func TestSOPSResolver_WrongIdentity(t *testing.T) {
+	other, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolve := SOPSResolver(WithSOPSIdentities(other))
+	if _, err := resolve(context.Background(), "sops://testdata/secrets.enc.yaml#postgres.password"); err == nil {
+		t.Error("expected decryption with another identity to fail")
+	}
+}
+
+func TestSOPSResolver_Tampered(t *testing.T) {
+	raw, err := os.ReadFile("testdata/secrets.enc.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A value moved under another key fails authentication, as the key path is the additional data.
+	lines := strings.Split(string(raw), "\n")
+	password, port := lines[1], lines[2]
+	lines[1] = strings.Replace(port, "port:", "password:", 1)
+	lines[2] = strings.Replace(password, "password:", "port:", 1)
+
+	path := t.TempDir() + "/swapped.enc.yaml"
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resolve := SOPSResolver(WithSOPSIdentities(sopsIdentities(t)...))
+	if _, err := resolve(context.Background(), "sops://"+path+"#postgres.password"); err == nil {
+		t.Error("expected a swapped value to fail authentication")
+	}
+}
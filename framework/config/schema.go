@@ -0,0 +1,336 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// UnknownKeys decides what happens when a source holds keys that no field of the target maps to.
+type UnknownKeys int
+
+const (
+	// UnknownKeysWarn logs the unknown keys of every source. It is the default.
+	UnknownKeysWarn UnknownKeys = iota
+	// UnknownKeysIgnore skips the check.
+	UnknownKeysIgnore
+	// UnknownKeysError fails loading when a source holds unknown keys.
+	UnknownKeysError
+)
+
+// WithUnknownKeys sets how keys that match no field of the target are reported.
+// Sources that read the whole environment (EnvSource without a prefix) are never checked.
+func WithUnknownKeys(policy UnknownKeys) ConfigOption {
+	return func(cfg *LoaderConfig) {
+		cfg.unknownKeys = policy
+	}
+}
+
+// ValidationErrors aggregates every field of the configuration that failed validation.
+type ValidationErrors []*ValidationError
+
+// Error returns all failures on one line.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("invalid configuration: %s", strings.Join(messages, "; "))
+}
+
+// Unwrap lets errors.As find the individual *ValidationError.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// keyKind classifies a configuration key of the target struct.
+type keyKind int
+
+const (
+	// keyNode is an object whose children are known, e.g. a nested struct.
+	keyNode keyKind = iota + 1
+	// keyLeaf is a value decoded as a whole.
+	keyLeaf
+	// keyAny holds arbitrary children, e.g. a map or a slice of structs.
+	keyAny
+)
+
+// schema describes how the keys of a source map to the fields of a struct.
+type schema struct {
+	// keys maps every lower-cased dotted key to its kind.
+	keys map[string]keyKind
+	// fields maps the Go path of a field ("Postgres.URL") to its dotted key ("postgres_url").
+	fields map[string]string
+	// open holds the prefixes of structs with a `config:",remain"` field, which take any key.
+	open map[string]bool
+}
+
+// fieldKey returns the key of a struct field following the mapstructure rules of the "config" tag,
+// and the tag option ("squash" or "remain") that changes how it is decoded.
+func fieldKey(f reflect.StructField) (key string, option string, skip bool) {
+	tag := f.Tag.Get("config")
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "-" {
+		return "", "", true
+	}
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "squash" || opt == "remain" {
+			return "", opt, false
+		}
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, "", false
+}
+
+// isLeafStruct reports whether a struct type is decoded from a single value.
+func isLeafStruct(t reflect.Type) bool {
+	return t == reflect.TypeOf(time.Time{})
+}
+
+// newSchema walks the struct type t.
+func newSchema(t reflect.Type) *schema {
+	s := &schema{keys: make(map[string]keyKind), fields: make(map[string]string), open: make(map[string]bool)}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Struct {
+		s.walk(t, "", "")
+	}
+	return s
+}
+
+func (s *schema) walk(t reflect.Type, prefix, path string) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		key, option, skip := fieldKey(f)
+		if skip {
+			continue
+		}
+		if option == "remain" {
+			s.open[strings.ToLower(prefix)] = true
+			continue
+		}
+
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		fieldPath := joinKey(path, f.Name)
+
+		if option == "squash" {
+			if ft.Kind() == reflect.Struct {
+				s.walk(ft, prefix, fieldPath)
+			}
+			continue
+		}
+
+		fullKey := joinKey(prefix, key)
+		s.fields[fieldPath] = fullKey
+		s.add(fullKey, kindOf(ft))
+		if ft.Kind() == reflect.Struct && !isLeafStruct(ft) {
+			s.walk(ft, fullKey, fieldPath)
+		}
+	}
+}
+
+func kindOf(t reflect.Type) keyKind {
+	switch t.Kind() {
+	case reflect.Struct:
+		if isLeafStruct(t) {
+			return keyLeaf
+		}
+		return keyNode
+	case reflect.Map, reflect.Interface:
+		return keyAny
+	case reflect.Slice, reflect.Array:
+		elem := t.Elem()
+		for elem.Kind() == reflect.Pointer {
+			elem = elem.Elem()
+		}
+		if elem.Kind() == reflect.Struct || elem.Kind() == reflect.Map || elem.Kind() == reflect.Interface {
+			return keyAny
+		}
+		return keyLeaf
+	default:
+		return keyLeaf
+	}
+}
+
+// add registers key and every dotted prefix of it as a node, so `config:"database.url"`
+// accepts a nested {"database": {"url": ...}}.
+func (s *schema) add(key string, kind keyKind) {
+	key = strings.ToLower(key)
+	path := splitKey(key)
+	for i := 1; i < len(path); i++ {
+		prefix := strings.Join(path[:i], ".")
+		if _, ok := s.keys[prefix]; !ok {
+			s.keys[prefix] = keyNode
+		}
+	}
+	s.keys[strings.Join(path, ".")] = kind
+}
+
+// unknown returns the sorted keys of values that match no field.
+func (s *schema) unknown(values map[string]any) []string {
+	var out []string
+	s.collectUnknown(values, "", &out)
+	sort.Strings(out)
+	return out
+}
+
+func (s *schema) collectUnknown(values map[string]any, prefix string, out *[]string) {
+	if s.open[prefix] {
+		return
+	}
+	for key, value := range values {
+		full := prefix
+		known := true
+		descend := true
+		for _, segment := range splitKey(key) {
+			full = joinKey(full, strings.ToLower(segment))
+			kind, ok := s.keys[full]
+			if !ok {
+				// Report the whole key, e.g. "rabbitmq.url" rather than "rabbitmq"
+				full = joinKey(prefix, strings.ToLower(strings.Join(splitKey(key), ".")))
+				known = false
+				break
+			}
+			if kind != keyNode {
+				descend = false
+				break
+			}
+		}
+		if !known {
+			*out = append(*out, full)
+			continue
+		}
+		if !descend {
+			continue
+		}
+		if nested, ok := asMap(value); ok {
+			s.collectUnknown(nested, full, out)
+		}
+	}
+}
+
+// applyDefaults sets every zero field tagged `default:"..."` whose key is missing from values.
+// The default is decoded like a source value, so "10s" sets a time.Duration and "a,b" a slice.
+func (s *schema) applyDefaults(target reflect.Value, values map[string]any) error {
+	present := make(map[string]bool, len(values))
+	for k := range flatten(values) {
+		present[strings.ToLower(strings.Join(splitKey(k), "."))] = true
+	}
+	return s.defaults(target, "", present)
+}
+
+func (s *schema) defaults(v reflect.Value, path string, present map[string]bool) error {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		fieldPath := joinKey(path, f.Name)
+		field := v.Field(i)
+
+		if def, ok := f.Tag.Lookup("default"); ok {
+			key, tracked := s.fields[fieldPath]
+			if tracked && !present[strings.ToLower(key)] && field.IsZero() {
+				if err := decodeValue(field.Addr().Interface(), def); err != nil {
+					return errors.Wrapf(err, "invalid default for config key %s", key)
+				}
+			}
+			continue
+		}
+
+		if err := s.defaults(field, fieldPath, present); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate checks the `validate` tags of target and reports failures by configuration key.
+func (s *schema) validate(target any) error {
+	err := validate.Struct(target)
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrors validator.ValidationErrors
+	if !errors.As(err, &fieldErrors) {
+		// Not a struct, nothing to validate
+		var invalid *validator.InvalidValidationError
+		if errors.As(err, &invalid) {
+			return nil
+		}
+		return err
+	}
+
+	out := make(ValidationErrors, 0, len(fieldErrors))
+	for _, fe := range fieldErrors {
+		// StructNamespace starts with the name of the target type
+		_, path, _ := strings.Cut(fe.StructNamespace(), ".")
+		key, ok := s.fields[path]
+		if !ok {
+			key = path
+		}
+		out = append(out, NewValidationError(key, validationMessage(fe), fe.Value()))
+	}
+	return out
+}
+
+// validate is shared, as the validator caches struct metadata.
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func validationMessage(fe validator.FieldError) string {
+	switch {
+	case fe.Tag() == "required":
+		return "is required"
+	case fe.Param() != "":
+		return fmt.Sprintf("must satisfy %s=%s", fe.Tag(), fe.Param())
+	default:
+		return fmt.Sprintf("must satisfy %s", fe.Tag())
+	}
+}
+
+// reportUnknown checks the keys of one source against the schema.
+func (s *schema) reportUnknown(source Source, values map[string]any, policy UnknownKeys) error {
+	if policy == UnknownKeysIgnore || source.anyKeys {
+		return nil
+	}
+	unknown := s.unknown(values)
+	if len(unknown) == 0 {
+		return nil
+	}
+	if policy == UnknownKeysError {
+		return NewSourceError(source.name, errors.Errorf("unknown keys: %s", strings.Join(unknown, ", ")))
+	}
+	log.Warn().Str("source", source.name).Strs("keys", unknown).Msg("Configuration source has keys that match no field")
+	return nil
+}
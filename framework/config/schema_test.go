@@ -0,0 +1,239 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type schemaCommon struct {
+	LogLevel string `config:"log_level" default:"info" validate:"oneof=debug info warn error"`
+}
+
+type schemaDatabase struct {
+	URL     string        `config:"url" validate:"required"`
+	MaxConn int           `config:"max_conn" default:"10" validate:"min=1"`
+	Timeout time.Duration `config:"timeout" default:"5s"`
+}
+
+type schemaConfig struct {
+	Common   schemaCommon   `config:",squash"`
+	Database schemaDatabase `config:"database"`
+	Port     int            `config:"port" default:"8080" validate:"min=1,max=65535"`
+	Hosts    []string       `config:"hosts" default:"a,b"`
+	Extra    map[string]any `config:"extra"`
+	Ignored  string         `config:"-" default:"never"`
+}
+
+// mapSource returns a source named name holding values.
+func mapSource(name string, values map[string]any) Source {
+	return Source{name: name, source: func(context.Context) (map[string]any, error) {
+		return values, nil
+	}}
+}
+
+func TestFieldKey(t *testing.T) {
+	type tagged struct {
+		Plain    string
+		Named    string         `config:"named"`
+		Options  string         `config:"with_options,omitempty"`
+		Skipped  string         `config:"-"`
+		Squashed schemaCommon   `config:",squash"`
+		Remain   map[string]any `config:",remain"`
+	}
+
+	cases := []struct {
+		field  string
+		key    string
+		option string
+		skip   bool
+	}{
+		{field: "Plain", key: "Plain"},
+		{field: "Named", key: "named"},
+		{field: "Options", key: "with_options"},
+		{field: "Skipped", skip: true},
+		{field: "Squashed", option: "squash"},
+		{field: "Remain", option: "remain"},
+	}
+	typ := reflect.TypeOf(tagged{})
+	for _, tc := range cases {
+		f, _ := typ.FieldByName(tc.field)
+		key, option, skip := fieldKey(f)
+		if key != tc.key || option != tc.option || skip != tc.skip {
+			t.Errorf("%s: expected (%q, %q, %v), got (%q, %q, %v)", tc.field, tc.key, tc.option, tc.skip, key, option, skip)
+		}
+	}
+}
+
+func TestSchema_Defaults(t *testing.T) {
+	t.Run("Missing", func(t *testing.T) {
+		var cfg schemaConfig
+		err := ReadInConfig(context.Background(), &cfg, WithSources(mapSource("test", map[string]any{
+			"database": map[string]any{"url": "postgres://db"},
+		})))
+		if err != nil {
+			t.Fatalf("load failed: %v", err)
+		}
+
+		want := schemaConfig{
+			Common:   schemaCommon{LogLevel: "info"},
+			Database: schemaDatabase{URL: "postgres://db", MaxConn: 10, Timeout: 5 * time.Second},
+			Port:     8080,
+			Hosts:    []string{"a", "b"},
+		}
+		if !reflect.DeepEqual(cfg, want) {
+			t.Errorf("expected defaults in nested and squashed structs\n got %+v\nwant %+v", cfg, want)
+		}
+	})
+
+	t.Run("Present", func(t *testing.T) {
+		var cfg schemaConfig
+		err := ReadInConfig(context.Background(), &cfg, WithSources(mapSource("test", map[string]any{
+			"log_level":         "debug",
+			"database.url":      "postgres://db",
+			"database.max_conn": 3,
+			"port":              9090,
+			"hosts":             []any{"c"},
+		})))
+		if err != nil {
+			t.Fatalf("load failed: %v", err)
+		}
+
+		if cfg.Common.LogLevel != "debug" || cfg.Database.MaxConn != 3 || cfg.Port != 9090 || !reflect.DeepEqual(cfg.Hosts, []string{"c"}) {
+			t.Errorf("expected source values to win over defaults, got %+v", cfg)
+		}
+		if cfg.Database.Timeout != 5*time.Second {
+			t.Errorf("expected the missing timeout to get its default, got %v", cfg.Database.Timeout)
+		}
+	})
+
+	t.Run("ExplicitZero", func(t *testing.T) {
+		// A key set to zero is present, so its default does not apply and validation sees the zero
+		var cfg schemaConfig
+		err := ReadInConfig(context.Background(), &cfg, WithSources(mapSource("test", map[string]any{
+			"database": map[string]any{"url": "postgres://db", "max_conn": 0},
+		})))
+		var errs ValidationErrors
+		if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Field != "database.max_conn" {
+			t.Fatalf("expected database.max_conn to fail validation, got %v", err)
+		}
+	})
+}
+
+func TestSchema_Validate(t *testing.T) {
+	var cfg schemaConfig
+	err := ReadInConfig(context.Background(), &cfg, WithSources(mapSource("test", map[string]any{
+		"log_level": "loud",
+		"port":      70000,
+		"database":  map[string]any{"max_conn": -1},
+	})))
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected ValidationErrors, got %T: %v", err, err)
+	}
+
+	got := make(map[string]string, len(errs))
+	for _, e := range errs {
+		got[e.Field] = e.Message
+	}
+	want := map[string]string{
+		"log_level":         "must satisfy oneof=debug info warn error",
+		"database.url":      "is required",
+		"database.max_conn": "must satisfy min=1",
+		"port":              "must satisfy max=65535",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected every failing field by configuration key\n got %v\nwant %v", got, want)
+	}
+
+	for key := range want {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("expected the message to name %s, got %q", key, err.Error())
+		}
+	}
+
+	var single *ValidationError
+	if !errors.As(err, &single) {
+		t.Error("expected errors.As to find an individual *ValidationError")
+	}
+}
+
+func TestSchema_UnknownKeys(t *testing.T) {
+	values := map[string]any{
+		"porr":          1,
+		"database":      map[string]any{"url": "postgres://db", "usr": "morgan"},
+		"database.pass": "s3cret",
+		"extra":         map[string]any{"anything": map[string]any{"goes": true}},
+		"log_level":     "info",
+	}
+	wantKeys := []string{"database.pass", "database.usr", "porr"}
+
+	// captureLog returns what the global logger wrote while running fn.
+	captureLog := func(fn func()) string {
+		var buf bytes.Buffer
+		previous := log.Logger
+		log.Logger = zerolog.New(&buf)
+		defer func() { log.Logger = previous }()
+		fn()
+		return buf.String()
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		got := newSchema(reflect.TypeOf(schemaConfig{})).unknown(values)
+		if !sort.StringsAreSorted(got) || !reflect.DeepEqual(got, wantKeys) {
+			t.Errorf("expected %v, got %v", wantKeys, got)
+		}
+	})
+
+	t.Run("Warn", func(t *testing.T) {
+		var cfg schemaConfig
+		var err error
+		out := captureLog(func() {
+			err = ReadInConfig(context.Background(), &cfg, WithSources(mapSource("test", values)))
+		})
+		if err != nil {
+			t.Fatalf("expected unknown keys only to be logged by default, got %v", err)
+		}
+		for _, key := range wantKeys {
+			if !strings.Contains(out, key) {
+				t.Errorf("expected the warning to name %s, got %q", key, out)
+			}
+		}
+		if cfg.Database.URL != "postgres://db" {
+			t.Errorf("expected the known keys to load, got %+v", cfg)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		var cfg schemaConfig
+		err := ReadInConfig(context.Background(), &cfg, WithSources(mapSource("test", values)), WithUnknownKeys(UnknownKeysError))
+
+		var sourceErr *SourceError
+		if !errors.As(err, &sourceErr) || sourceErr.Source != "test" {
+			t.Fatalf("expected a SourceError of the test source, got %v", err)
+		}
+		if !strings.Contains(err.Error(), strings.Join(wantKeys, ", ")) {
+			t.Errorf("expected the error to list %v, got %q", wantKeys, err.Error())
+		}
+	})
+
+	t.Run("Ignore", func(t *testing.T) {
+		var cfg schemaConfig
+		var err error
+		out := captureLog(func() {
+			err = ReadInConfig(context.Background(), &cfg, WithSources(mapSource("test", values)), WithUnknownKeys(UnknownKeysIgnore))
+		})
+		if err != nil || out != "" {
+			t.Errorf("expected no error and no warning, got %v and %q", err, out)
+		}
+	})
+}
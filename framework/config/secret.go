@@ -0,0 +1,71 @@
+package config
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Redacted is shown in place of a secret value.
+const Redacted = "******"
+
+// Secret is a string that never reveals its value when printed, logged or marshalled to JSON.
+// Sources decode into it like into a plain string; call Value to use it.
+//
+// Example:
+//
+//	type Config struct {
+//		Password config.Secret `config:"redis_password"`
+//	}
+//
+//	redis.NewClient(&redis.Options{Password: cfg.Password.Value()})
+type Secret string
+
+// Value returns the secret in plain text.
+func (s Secret) Value() string {
+	return string(s)
+}
+
+// String returns Redacted, or "" for an empty secret so unset values stay recognisable.
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return Redacted
+}
+
+// GoString keeps %#v from printing the value.
+func (s Secret) GoString() string {
+	return strconv.Quote(s.String())
+}
+
+// MarshalJSON encodes the redacted value.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// redact masks value according to a `secret:"..."` tag:
+// "url" masks only the passwords of a comma-separated list of URLs, anything else the whole value.
+func redact(value, mode string) string {
+	if value == "" {
+		return ""
+	}
+	if mode != "url" {
+		return Redacted
+	}
+
+	parts := strings.Split(value, ",")
+	for i, part := range parts {
+		u, err := url.Parse(strings.TrimSpace(part))
+		if err != nil {
+			return Redacted
+		}
+		if _, ok := u.User.Password(); ok {
+			// Set a placeholder, as String would percent-encode the asterisks of Redacted
+			u.User = url.UserPassword(u.User.Username(), "redacted")
+			parts[i] = strings.Replace(u.String(), ":redacted@", ":"+Redacted+"@", 1)
+		}
+	}
+	return strings.Join(parts, ",")
+}
@@ -7,10 +7,18 @@ import (
 // SourceFunc is a function that loads configuration values from a source.
 type SourceFunc func(ctx context.Context) (map[string]any, error)
 
+// WatchFunc blocks until ctx is done, calling changed whenever the source may hold new values.
+// It is used by Watcher to reload the configuration.
+type WatchFunc func(ctx context.Context, changed func()) error
+
 // Source represents a configuration source with a name and loading function.
 type Source struct {
 	name   string
 	source SourceFunc
+	watch  WatchFunc
+	// anyKeys marks sources that hold keys unrelated to the configuration, e.g. the whole
+	// environment, so they are not checked for unknown keys.
+	anyKeys bool
 }
 
 // Name returns the name of the configuration source.
@@ -22,3 +30,14 @@ func (s Source) Name() string {
 func (s Source) Load(ctx context.Context) (map[string]any, error) {
 	return s.source(ctx)
 }
+
+// WithWatch returns a copy of the source that Watcher watches with watch.
+func (s Source) WithWatch(watch WatchFunc) Source {
+	s.watch = watch
+	return s
+}
+
+// Watchable reports whether a Watcher can detect changes of the source.
+func (s Source) Watchable() bool {
+	return s.watch != nil
+}
@@ -44,7 +44,8 @@ func EnvSource(prefix string, mapper Mapper) Source {
 		name = fmt.Sprintf("env:%s", prefix)
 	}
 	return Source{
-		name: name,
+		name:    name,
+		anyKeys: prefix == "",
 		source: func(ctx context.Context) (map[string]any, error) {
 			results := make(map[string]any)
 			for _, kv := range os.Environ() {
@@ -15,6 +15,7 @@ import (
 
 // FileSource creates a configuration source that reads from a file.
 // Supported formats: .json, .yaml, .yml, .toml.
+// A Watcher reloads the configuration when the file changes.
 func FileSource(path string) Source {
 	return Source{
 		name: fmt.Sprintf("file:%s", path),
@@ -31,6 +32,7 @@ func FileSource(path string) Source {
 			ext := strings.ToLower(filepath.Ext(path))
 			return extUnmarshalling(ext, data)
 		},
+		watch: watchFile(path),
 	}
 }
 
@@ -2,7 +2,9 @@ package config
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,24 +17,27 @@ import (
 )
 
 const (
-	DefaultHTTPTimeout   = 5 * time.Second
-	DefaultHTTPRetry     = 3
-	DefaultHTTPRetryWait = 300 * time.Millisecond
+	DefaultHTTPTimeout      = 5 * time.Second
+	DefaultHTTPRetry        = 3
+	DefaultHTTPRetryWait    = 300 * time.Millisecond
+	DefaultHTTPPollInterval = 30 * time.Second
 )
 
 var DefaultHTTPSetting = &HTTPSetting{
-	timeout:   DefaultHTTPTimeout,
-	retry:     DefaultHTTPRetry,
-	retryWait: DefaultHTTPRetryWait,
+	timeout:      DefaultHTTPTimeout,
+	retry:        DefaultHTTPRetry,
+	retryWait:    DefaultHTTPRetryWait,
+	pollInterval: DefaultHTTPPollInterval,
 }
 
 type HTTPSetting struct {
-	url       string
-	headers   http.Header
-	timeout   time.Duration
-	tlsConfig *tls.Config
-	retry     int
-	retryWait time.Duration
+	url          string
+	headers      http.Header
+	timeout      time.Duration
+	tlsConfig    *tls.Config
+	retry        int
+	retryWait    time.Duration
+	pollInterval time.Duration
 }
 
 type HTTPOption func(*HTTPSetting)
@@ -81,7 +86,15 @@ func WithHTTPRetry(count int, wait time.Duration) HTTPOption {
 	}
 }
 
+// WithHTTPPollInterval sets how often a Watcher checks the URL for changes.
+func WithHTTPPollInterval(d time.Duration) HTTPOption {
+	return func(h *HTTPSetting) {
+		h.pollInterval = d
+	}
+}
+
 // HTTPSource fetches configuration from a URL.
+// A Watcher polls it with If-None-Match and reloads when the ETag changes.
 func HTTPSource(urlStr string, opts ...HTTPOption) Source {
 	return Source{
 		name: fmt.Sprintf("http:%s", urlStr),
@@ -100,6 +113,84 @@ func HTTPSource(urlStr string, opts ...HTTPOption) Source {
 
 			return extUnmarshalling(ext, resp)
 		},
+		watch: watchHTTP(urlStr, opts...),
+	}
+}
+
+// watchHTTP polls urlStr every poll interval and reports a change when its ETag differs from
+// the previous one. Servers without ETags are compared by a hash of the body.
+func watchHTTP(urlStr string, opts ...HTTPOption) WatchFunc {
+	return func(ctx context.Context, changed func()) error {
+		var cfg = *DefaultHTTPSetting
+		cfg.url = urlStr
+		for _, opt := range opts {
+			opt(&cfg)
+		}
+		if cfg.pollInterval <= 0 {
+			cfg.pollInterval = DefaultHTTPPollInterval
+		}
+
+		ticker := time.NewTicker(cfg.pollInterval)
+		defer ticker.Stop()
+
+		var etag string
+		for {
+			tag, err := probeETag(ctx, &cfg, etag)
+			if err == nil {
+				if etag != "" && tag != etag {
+					changed()
+				}
+				etag = tag
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	}
+}
+
+// probeETag requests cfg.url conditionally and returns its current ETag.
+func probeETag(ctx context.Context, cfg *HTTPSetting, etag string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cfg.url, nil)
+	if err != nil {
+		return "", err
+	}
+	for k, v := range cfg.headers {
+		req.Header[k] = v
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	client := &http.Client{Timeout: cfg.timeout}
+	if cfg.tlsConfig != nil {
+		client.Transport = &http.Transport{TLSClientConfig: cfg.tlsConfig}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		return etag, nil
+	case http.StatusOK:
+		if tag := resp.Header.Get("ETag"); tag != "" {
+			return tag, nil
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", err
+		}
+		sum := sha256.Sum256(body)
+		return hex.EncodeToString(sum[:]), nil
+	default:
+		return "", NewHTTPError(resp.StatusCode, resp.Status)
 	}
 }
 
@@ -132,6 +223,9 @@ func fetch(ctx context.Context, url string, opts ...HTTPOption) ([]byte, error)
 		if err != nil {
 			return nil, err
 		}
+		for k, v := range cfg.headers {
+			req.Header[k] = v
+		}
 
 		client := &http.Client{Timeout: cfg.timeout}
 		if cfg.tlsConfig != nil {
@@ -0,0 +1,114 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHTTPSource(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		switch r.URL.Path {
+		case "/config.json":
+			if r.Header.Get("Authorization") != "Bearer t" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{"port": 9090, "db": {"host": "db"}}`))
+		case "/flaky.json":
+			if n == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.Write([]byte(`{"port": 1}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Run("Load", func(t *testing.T) {
+		values, err := HTTPSource(server.URL+"/config.json", WithHTTPHeader("Authorization", "Bearer t")).Load(context.Background())
+		if err != nil {
+			t.Fatalf("load failed: %v", err)
+		}
+		if values["port"] != float64(9090) || values["db"].(map[string]any)["host"] != "db" {
+			t.Errorf("unexpected values %v", values)
+		}
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		_, err := HTTPSource(server.URL+"/config.json", WithHTTPRetry(1, 0)).Load(context.Background())
+		var httpErr *HTTPError
+		if !errors.As(err, &httpErr) || httpErr.Code != http.StatusUnauthorized {
+			t.Errorf("expected a 401 HTTPError, got %v", err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := HTTPSource(server.URL+"/missing.json", WithHTTPRetry(1, 0)).Load(context.Background())
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		calls.Store(0)
+		values, err := HTTPSource(server.URL+"/flaky.json", WithHTTPRetry(2, time.Millisecond)).Load(context.Background())
+		if err != nil || values["port"] != float64(1) {
+			t.Errorf("expected the retry to succeed, got %v, %v", values, err)
+		}
+	})
+
+	t.Run("InvalidURL", func(t *testing.T) {
+		if _, err := HTTPSource("ftp://example.com/config.json").Load(context.Background()); err == nil {
+			t.Error("expected the scheme to be rejected")
+		}
+	})
+}
+
+func TestHTTPSource_Watch(t *testing.T) {
+	var etag atomic.Value
+	etag.Store(`"v1"`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := etag.Load().(string)
+		if r.Header.Get("If-None-Match") == current {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", current)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	source := HTTPSource(server.URL+"/config.json", WithHTTPPollInterval(5*time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changed := make(chan struct{}, 1)
+	go source.watch(ctx, func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	})
+
+	select {
+	case <-changed:
+		t.Fatal("expected no change while the ETag is the same")
+	case <-time.After(30 * time.Millisecond):
+	}
+
+	etag.Store(`"v2"`)
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("expected a change once the ETag differs")
+	}
+}
@@ -5,8 +5,12 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
@@ -118,6 +122,89 @@ func ConsulClient(baseURL, token string) KVClient {
 	}
 }
 
+// consulWait is how long a Consul blocking query waits for a change before returning.
+const consulWait = 5 * time.Minute
+
+// consulRetry is how long ConsulWatch backs off after a failed query.
+var consulRetry = 5 * time.Second
+
+// ConsulWatch watches a Consul KV prefix with blocking queries and reports a change whenever
+// its X-Consul-Index moves. Attach it to the matching KVSource:
+//
+//	config.KVSource(prefix, config.ConsulClient(addr, token), mapper).
+//		WithWatch(config.ConsulWatch(addr, token, prefix))
+func ConsulWatch(baseURL, token, prefix string) WatchFunc {
+	return func(ctx context.Context, changed func()) error {
+		if err := validateConsulBaseURL(baseURL); err != nil {
+			return err
+		}
+		endpoint := fmt.Sprintf("%s/v1/kv/%s", strings.TrimRight(baseURL, "/"), strings.TrimLeft(prefix, "/"))
+		client := &http.Client{Timeout: consulWait + 30*time.Second}
+
+		var index uint64
+		for {
+			next, err := consulIndex(ctx, client, endpoint, token, index)
+			switch {
+			case ctx.Err() != nil:
+				return nil
+			case err != nil:
+				// Consul unreachable: back off and keep the last index, so the first query that
+				// succeeds returns at once when the keys changed during the outage
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(consulRetry):
+				}
+				continue
+			case next < index:
+				// The index went backwards (e.g. a snapshot restore); reset as Consul recommends
+				index = 0
+				changed()
+				continue
+			}
+
+			if index != 0 && next != index {
+				changed()
+			}
+			index = next
+		}
+	}
+}
+
+// consulIndex runs a blocking query for the keys under endpoint and returns the resulting index.
+func consulIndex(ctx context.Context, client *http.Client, endpoint, token string, index uint64) (uint64, error) {
+	query := url.Values{"recurse": {"true"}, "keys": {"true"}}
+	if index > 0 {
+		query.Set("index", strconv.FormatUint(index, 10))
+		query.Set("wait", consulWait.String())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return 0, err
+	}
+	if token != "" {
+		req.Header.Set("X-Consul-Token", token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return 0, NewHTTPError(resp.StatusCode, resp.Status)
+	}
+
+	next, err := strconv.ParseUint(resp.Header.Get("X-Consul-Index"), 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid X-Consul-Index")
+	}
+	return next, nil
+}
+
 func validateConsulBaseURL(baseURL string) error {
 	if baseURL == "" {
 		return errors.New("empty baseURL")
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// consulServer serves X-Consul-Index for blocking queries; while down it fails every request.
+type consulServer struct {
+	index  atomic.Uint64
+	down   atomic.Bool
+	failed atomic.Int32
+}
+
+func (s *consulServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.down.Load() {
+		s.failed.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// Block briefly while the index is the one the client already has, as Consul does
+	if requested, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64); requested != 0 {
+		deadline := time.After(20 * time.Millisecond)
+		for requested == s.index.Load() {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-deadline:
+				requested = 0
+			case <-time.After(time.Millisecond):
+			}
+		}
+	}
+
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(s.index.Load(), 10))
+	w.Write([]byte(`["app/log_level"]`))
+}
+
+func TestConsulWatch(t *testing.T) {
+	retry := consulRetry
+	consulRetry = 5 * time.Millisecond
+	t.Cleanup(func() { consulRetry = retry })
+
+	consul := &consulServer{}
+	consul.index.Store(10)
+	server := httptest.NewServer(consul)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changed := make(chan struct{}, 1)
+	go ConsulWatch(server.URL, "", "app")(ctx, func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	})
+
+	expectChange := func(t *testing.T, want bool) {
+		t.Helper()
+		select {
+		case <-changed:
+			if !want {
+				t.Fatal("unexpected change")
+			}
+		case <-time.After(100 * time.Millisecond):
+			if want {
+				t.Fatal("expected a change")
+			}
+		}
+	}
+
+	t.Run("Unchanged", func(t *testing.T) {
+		expectChange(t, false)
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		consul.index.Store(11)
+		expectChange(t, true)
+	})
+
+	t.Run("ChangedDuringOutage", func(t *testing.T) {
+		consul.down.Store(true)
+		for consul.failed.Load() < 2 {
+			time.Sleep(time.Millisecond)
+		}
+		consul.index.Store(12)
+		consul.down.Store(false)
+
+		expectChange(t, true)
+		expectChange(t, false)
+	})
+
+	t.Run("UnchangedOutage", func(t *testing.T) {
+		failed := consul.failed.Load()
+		consul.down.Store(true)
+		for consul.failed.Load() < failed+2 {
+			time.Sleep(time.Millisecond)
+		}
+		consul.down.Store(false)
+
+		expectChange(t, false)
+	})
+
+	t.Run("IndexWentBackwards", func(t *testing.T) {
+		// A snapshot restore resets the index
+		consul.index.Store(3)
+		expectChange(t, true)
+	})
+}
@@ -0,0 +1,612 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	// DefaultVaultCacheTTL is how long a secret without a lease (e.g. KV) is served from cache.
+	DefaultVaultCacheTTL = time.Minute
+
+	// DefaultKubernetesTokenPath is where Kubernetes mounts the service account token.
+	DefaultKubernetesTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+	// vaultMaxWait caps the pause between two renewal checks.
+	vaultMaxWait = time.Minute
+)
+
+// VaultLogin is the client token obtained by a VaultAuth.
+type VaultLogin struct {
+	Token string
+	// TTL is zero for tokens that never expire.
+	TTL       time.Duration
+	Renewable bool
+}
+
+// VaultAuth logs in to Vault. It is called on first use and whenever the token can no longer be renewed.
+type VaultAuth func(ctx context.Context, v *Vault) (*VaultLogin, error)
+
+// VaultTokenAuth authenticates with a static token. Its TTL is looked up so a renewable
+// token is kept alive while Run is active.
+func VaultTokenAuth(token string) VaultAuth {
+	return func(ctx context.Context, v *Vault) (*VaultLogin, error) {
+		if token == "" {
+			return nil, NewValidationError("vault token", "token cannot be empty", nil)
+		}
+
+		login := &VaultLogin{Token: token}
+		res, err := v.requestWithToken(ctx, http.MethodGet, "auth/token/lookup-self", nil, token)
+		if err != nil {
+			// The token may lack the lookup capability; use it without renewal
+			log.Warn().Err(err).Msg("Failed to look up vault token, it will not be renewed")
+			return login, nil
+		}
+		login.TTL = time.Duration(res.Get("data.ttl").Int()) * time.Second
+		login.Renewable = res.Get("data.renewable").Bool()
+		return login, nil
+	}
+}
+
+// VaultAppRoleAuth logs in with an AppRole role and secret ID. An empty mount defaults to "approle".
+func VaultAppRoleAuth(mount, roleID, secretID string) VaultAuth {
+	if mount == "" {
+		mount = "approle"
+	}
+	return func(ctx context.Context, v *Vault) (*VaultLogin, error) {
+		return v.login(ctx, mount, map[string]string{
+			"role_id":   roleID,
+			"secret_id": secretID,
+		})
+	}
+}
+
+// VaultKubernetesAuth logs in with the pod's service account token.
+// An empty mount defaults to "kubernetes", an empty jwtPath to DefaultKubernetesTokenPath.
+func VaultKubernetesAuth(mount, role, jwtPath string) VaultAuth {
+	if mount == "" {
+		mount = "kubernetes"
+	}
+	if jwtPath == "" {
+		jwtPath = DefaultKubernetesTokenPath
+	}
+	return func(ctx context.Context, v *Vault) (*VaultLogin, error) {
+		// Read the token on every login, as Kubernetes rotates it
+		jwt, err := os.ReadFile(jwtPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read kubernetes service account token")
+		}
+		return v.login(ctx, mount, map[string]string{
+			"role": role,
+			"jwt":  strings.TrimSpace(string(jwt)),
+		})
+	}
+}
+
+// VaultOption configures a Vault client.
+type VaultOption func(*Vault)
+
+// WithVaultNamespace sets the Vault Enterprise namespace.
+func WithVaultNamespace(namespace string) VaultOption {
+	return func(v *Vault) {
+		v.namespace = namespace
+	}
+}
+
+// WithVaultPrefix prepends prefix to every secret path.
+func WithVaultPrefix(prefix string) VaultOption {
+	return func(v *Vault) {
+		v.prefix = strings.Trim(prefix, "/")
+	}
+}
+
+// WithVaultKV declares mount as a KV engine of the given version (1 or 2), skipping detection.
+func WithVaultKV(mount string, version int) VaultOption {
+	return func(v *Vault) {
+		v.mounts[strings.Trim(mount, "/")+"/"] = vaultMount{kv: true, version: version}
+	}
+}
+
+// WithVaultCacheTTL sets how long secrets without a lease are cached.
+func WithVaultCacheTTL(d time.Duration) VaultOption {
+	return func(v *Vault) {
+		v.cacheTTL = d
+	}
+}
+
+// WithVaultHTTPClient sets the HTTP client, e.g. for custom TLS.
+func WithVaultHTTPClient(client *http.Client) VaultOption {
+	return func(v *Vault) {
+		v.client = client
+	}
+}
+
+// Vault reads secrets from HashiCorp Vault.
+//
+// KV v1 and v2 engines are detected per mount. Secrets are cached: KV secrets for the cache TTL,
+// leased secrets (e.g. dynamic database credentials) until their lease runs out. While Run is
+// active the client token and every lease are renewed, and secrets whose lease can no longer be
+// renewed are read again, notifying the OnRotate subscribers of their path.
+type Vault struct {
+	address   string
+	auth      VaultAuth
+	namespace string
+	prefix    string
+	cacheTTL  time.Duration
+	client    *http.Client
+
+	// authMu serialises logins, readMu reads of secrets, so concurrent callers share one result.
+	authMu sync.Mutex
+	readMu sync.Mutex
+
+	mu      sync.Mutex
+	token   *vaultToken
+	mounts  map[string]vaultMount
+	secrets map[string]*vaultSecret
+	subs    map[string][]func(data map[string]any)
+}
+
+type vaultToken struct {
+	VaultLogin
+	issued time.Time
+}
+
+type vaultMount struct {
+	kv      bool
+	version int
+}
+
+type vaultSecret struct {
+	data      map[string]any
+	leaseID   string
+	duration  time.Duration
+	renewable bool
+	issued    time.Time
+	// final is set once Vault capped a renewal at the lease's max TTL.
+	final bool
+}
+
+// expiresAt returns when the secret must no longer be served from cache.
+func (s *vaultSecret) expiresAt(cacheTTL time.Duration) time.Time {
+	if s.leaseID != "" {
+		return s.issued.Add(s.duration)
+	}
+	return s.issued.Add(cacheTTL)
+}
+
+// renewAt returns when the lease of the secret should be renewed or replaced.
+func (s *vaultSecret) renewAt() time.Time {
+	return s.issued.Add(s.duration * 2 / 3)
+}
+
+// NewVault creates a Vault client for the server at address (e.g. "https://vault:8200").
+//
+// Example:
+//
+//	vault := config.NewVault(os.Getenv("VAULT_ADDR"),
+//		config.VaultAppRoleAuth("", os.Getenv("VAULT_ROLE_ID"), os.Getenv("VAULT_SECRET_ID")),
+//	)
+//	config.WithResolvers(vault.Resolver())
+func NewVault(address string, auth VaultAuth, opts ...VaultOption) *Vault {
+	v := &Vault{
+		address:  strings.TrimRight(address, "/"),
+		auth:     auth,
+		cacheTTL: DefaultVaultCacheTTL,
+		client:   &http.Client{Timeout: DefaultHTTPTimeout},
+		mounts:   make(map[string]vaultMount),
+		secrets:  make(map[string]*vaultSecret),
+		subs:     make(map[string][]func(map[string]any)),
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Resolver resolves "vault://path#field" to a field of the secret at path,
+// e.g. "vault://database/creds/morgan#password" or "vault://secret/morgan#db.password".
+// Without a field the whole secret is returned as JSON. Non-string fields are returned as JSON.
+func (v *Vault) Resolver() Resolver {
+	const scheme = "vault://"
+	return func(ctx context.Context, raw string) (string, error) {
+		if !strings.HasPrefix(raw, scheme) {
+			return "", ErrInvalidResolver
+		}
+
+		secretPath, field, _ := strings.Cut(strings.TrimPrefix(raw, scheme), "#")
+		if secretPath == "" {
+			return "", ErrInvalidPath
+		}
+
+		data, err := v.Read(ctx, secretPath)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to fetch from vault for key %q", secretPath)
+		}
+
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to marshal vault key %q", secretPath)
+		}
+		return selectField(raw, encoded, field)
+	}
+}
+
+// Read returns the data of the secret at secretPath, from cache while it is valid.
+func (v *Vault) Read(ctx context.Context, secretPath string) (map[string]any, error) {
+	secretPath = v.path(secretPath)
+
+	v.readMu.Lock()
+	defer v.readMu.Unlock()
+
+	v.mu.Lock()
+	cached, ok := v.secrets[secretPath]
+	valid := ok && time.Now().Before(cached.expiresAt(v.cacheTTL))
+	v.mu.Unlock()
+	if valid {
+		return cached.data, nil
+	}
+
+	secret, err := v.fetch(ctx, secretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	v.secrets[secretPath] = secret
+	v.mu.Unlock()
+
+	return secret.data, nil
+}
+
+// OnRotate calls fn with the new data whenever the secret at secretPath is replaced because its
+// lease could no longer be renewed, e.g. to reconnect with new database credentials.
+func (v *Vault) OnRotate(secretPath string, fn func(data map[string]any)) {
+	secretPath = v.path(secretPath)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.subs[secretPath] = append(v.subs[secretPath], fn)
+}
+
+// Run renews the client token and the leases of cached secrets until ctx is done.
+func (v *Vault) Run(ctx context.Context) {
+	for {
+		wait := v.renew(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// renew renews what is due and returns how long to wait before the next check.
+func (v *Vault) renew(ctx context.Context) time.Duration {
+	now := time.Now()
+	next := now.Add(vaultMaxWait)
+
+	v.mu.Lock()
+	token := v.token
+	v.mu.Unlock()
+
+	if token != nil && token.TTL > 0 {
+		renewAt := token.issued.Add(token.TTL * 2 / 3)
+		if !now.Before(renewAt) {
+			if err := v.renewToken(ctx, token); err != nil {
+				log.Error().Err(err).Msg("Failed to renew vault token")
+			}
+		} else if renewAt.Before(next) {
+			next = renewAt
+		}
+	}
+
+	v.mu.Lock()
+	due := make(map[string]*vaultSecret)
+	for secretPath, secret := range v.secrets {
+		if secret.leaseID == "" {
+			continue
+		}
+		renewAt := secret.renewAt()
+		if !now.Before(renewAt) {
+			due[secretPath] = secret
+		} else if renewAt.Before(next) {
+			next = renewAt
+		}
+	}
+	v.mu.Unlock()
+
+	for secretPath, secret := range due {
+		if err := v.renewSecret(ctx, secretPath, secret); err != nil {
+			log.Error().Err(err).Str("path", secretPath).Msg("Failed to renew vault lease")
+		}
+	}
+	if len(due) > 0 {
+		// Renewed leases have new deadlines, check again shortly
+		return time.Second
+	}
+
+	return max(time.Until(next), time.Second)
+}
+
+// renewToken extends the client token, logging in again when it cannot be renewed.
+func (v *Vault) renewToken(ctx context.Context, token *vaultToken) error {
+	if token.Renewable {
+		res, err := v.request(ctx, http.MethodPost, "auth/token/renew-self", map[string]any{}, true)
+		if err == nil {
+			ttl := time.Duration(res.Get("auth.lease_duration").Int()) * time.Second
+			v.mu.Lock()
+			v.token = &vaultToken{VaultLogin: VaultLogin{Token: token.Token, TTL: ttl, Renewable: res.Get("auth.renewable").Bool()}, issued: time.Now()}
+			v.mu.Unlock()
+			// A shorter TTL than before means the token reached its max TTL; log in again next time
+			if ttl >= token.TTL {
+				return nil
+			}
+		} else {
+			log.Warn().Err(err).Msg("Failed to renew vault token, logging in again")
+		}
+	}
+
+	v.mu.Lock()
+	v.token = nil
+	v.mu.Unlock()
+
+	_, err := v.clientToken(ctx)
+	return err
+}
+
+// renewSecret extends the lease of a secret or, once that is no longer possible, reads it again
+// and notifies the subscribers.
+func (v *Vault) renewSecret(ctx context.Context, secretPath string, secret *vaultSecret) error {
+	if secret.renewable && !secret.final {
+		increment := int(secret.duration.Seconds())
+		res, err := v.request(ctx, http.MethodPut, "sys/leases/renew", map[string]any{
+			"lease_id":  secret.leaseID,
+			"increment": increment,
+		}, true)
+		if err == nil {
+			duration := time.Duration(res.Get("lease_duration").Int()) * time.Second
+			v.mu.Lock()
+			secret.issued = time.Now()
+			// Vault caps the lease at its max TTL; replace the secret before that runs out
+			secret.final = duration < secret.duration
+			secret.duration = duration
+			v.mu.Unlock()
+			return nil
+		}
+		log.Warn().Err(err).Str("path", secretPath).Msg("Failed to renew vault lease, reading the secret again")
+	}
+
+	v.readMu.Lock()
+	rotated, err := v.fetch(ctx, secretPath)
+	if err != nil {
+		v.readMu.Unlock()
+		return err
+	}
+	v.mu.Lock()
+	v.secrets[secretPath] = rotated
+	subs := append([]func(map[string]any){}, v.subs[secretPath]...)
+	v.mu.Unlock()
+	v.readMu.Unlock()
+
+	log.Info().Str("path", secretPath).Msg("Vault secret rotated")
+	for _, fn := range subs {
+		fn(rotated.data)
+	}
+	return nil
+}
+
+// fetch reads the secret at secretPath from Vault.
+func (v *Vault) fetch(ctx context.Context, secretPath string) (*vaultSecret, error) {
+	mount, err := v.mount(ctx, secretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	apiPath := secretPath
+	dataPath := "data"
+	if mount.kv && mount.version == 2 {
+		// KV v2 serves secrets under <mount>/data/ and wraps them in another "data"
+		prefix := v.mountPrefix(secretPath)
+		apiPath = prefix + "data/" + strings.TrimPrefix(secretPath, prefix)
+		dataPath = "data.data"
+	}
+
+	res, err := v.request(ctx, http.MethodGet, apiPath, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := res.Get(dataPath).Value().(map[string]any)
+	if !ok {
+		return nil, NewSourceError("vault://"+secretPath, ErrNotFound)
+	}
+
+	return &vaultSecret{
+		data:      data,
+		leaseID:   res.Get("lease_id").String(),
+		duration:  time.Duration(res.Get("lease_duration").Int()) * time.Second,
+		renewable: res.Get("renewable").Bool(),
+		issued:    time.Now(),
+	}, nil
+}
+
+// mount returns the engine mounted at secretPath, asking Vault the first time.
+// When Vault does not tell, the path is read as-is, like KV v1 or a dynamic secrets engine.
+func (v *Vault) mount(ctx context.Context, secretPath string) (vaultMount, error) {
+	if prefix := v.mountPrefix(secretPath); prefix != "" {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		return v.mounts[prefix], nil
+	}
+
+	res, err := v.request(ctx, http.MethodGet, "sys/internal/ui/mounts/"+secretPath, nil, true)
+	if err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && (httpErr.Code == http.StatusForbidden || httpErr.Code == http.StatusNotFound) {
+			v.mu.Lock()
+			v.mounts[secretPath+"/"] = vaultMount{}
+			v.mu.Unlock()
+			return vaultMount{}, nil
+		}
+		return vaultMount{}, err
+	}
+
+	prefix := strings.Trim(res.Get("data.path").String(), "/") + "/"
+	mount := vaultMount{kv: res.Get("data.type").String() == "kv"}
+	if mount.kv {
+		mount.version = 1
+		if version, err := strconv.Atoi(res.Get("data.options.version").String()); err == nil {
+			mount.version = version
+		}
+	}
+
+	v.mu.Lock()
+	v.mounts[prefix] = mount
+	v.mu.Unlock()
+
+	return mount, nil
+}
+
+// mountPrefix returns the longest known mount that secretPath lies in, or "".
+func (v *Vault) mountPrefix(secretPath string) string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	longest := ""
+	for prefix := range v.mounts {
+		if strings.HasPrefix(secretPath+"/", prefix) && len(prefix) > len(longest) {
+			longest = prefix
+		}
+	}
+	return longest
+}
+
+// path joins secretPath to the configured prefix.
+func (v *Vault) path(secretPath string) string {
+	return strings.Trim(path.Join(v.prefix, secretPath), "/")
+}
+
+// clientToken returns the current token, logging in if there is none.
+func (v *Vault) clientToken(ctx context.Context) (string, error) {
+	v.mu.Lock()
+	token := v.token
+	v.mu.Unlock()
+	if token != nil {
+		return token.Token, nil
+	}
+
+	v.authMu.Lock()
+	defer v.authMu.Unlock()
+
+	// Another caller may have logged in meanwhile
+	v.mu.Lock()
+	token = v.token
+	v.mu.Unlock()
+	if token != nil {
+		return token.Token, nil
+	}
+
+	login, err := v.auth(ctx, v)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to log in to vault")
+	}
+
+	v.mu.Lock()
+	v.token = &vaultToken{VaultLogin: *login, issued: time.Now()}
+	v.mu.Unlock()
+
+	return login.Token, nil
+}
+
+// login authenticates against the auth method mounted at auth/<mount>.
+func (v *Vault) login(ctx context.Context, mount string, body map[string]string) (*VaultLogin, error) {
+	res, err := v.request(ctx, http.MethodPost, fmt.Sprintf("auth/%s/login", strings.Trim(mount, "/")), body, false)
+	if err != nil {
+		return nil, err
+	}
+
+	token := res.Get("auth.client_token").String()
+	if token == "" {
+		return nil, errors.New("vault login returned no client token")
+	}
+	return &VaultLogin{
+		Token:     token,
+		TTL:       time.Duration(res.Get("auth.lease_duration").Int()) * time.Second,
+		Renewable: res.Get("auth.renewable").Bool(),
+	}, nil
+}
+
+// request calls the Vault HTTP API at /v1/<apiPath>, authenticated with the client token if auth is set.
+func (v *Vault) request(ctx context.Context, method, apiPath string, body any, auth bool) (gjson.Result, error) {
+	token := ""
+	if auth {
+		var err error
+		if token, err = v.clientToken(ctx); err != nil {
+			return gjson.Result{}, err
+		}
+	}
+	return v.requestWithToken(ctx, method, apiPath, body, token)
+}
+
+// requestWithToken calls the Vault HTTP API with the given token, or none if it is empty.
+func (v *Vault) requestWithToken(ctx context.Context, method, apiPath string, body any, token string) (gjson.Result, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return gjson.Result{}, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/v1/%s", v.address, apiPath), reader)
+	if err != nil {
+		return gjson.Result{}, errors.Wrap(err, "failed to create vault request")
+	}
+	req.Header.Set("X-Vault-Request", "true")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return gjson.Result{}, errors.Wrap(err, "vault request failed")
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return gjson.Result{}, errors.Wrap(err, "failed to read vault response")
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		messages := gjson.GetBytes(raw, "errors").Array()
+		parts := make([]string, len(messages))
+		for i, m := range messages {
+			parts[i] = m.String()
+		}
+		return gjson.Result{}, NewSourceError("vault:"+apiPath, NewHTTPError(resp.StatusCode, strings.Join(parts, "; ")))
+	}
+
+	return gjson.ParseBytes(raw), nil
+}
@@ -0,0 +1,114 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeVault serves a KV v2 engine at "secret/" holding "secret/morgan" for the token "root".
+func fakeVault(t *testing.T, reads *atomic.Int32) *httptest.Server {
+	t.Helper()
+	reply := func(w http.ResponseWriter, body any) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(body)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+			reply(w, map[string]any{"errors": []string{"permission denied"}})
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/auth/token/lookup-self":
+			reply(w, map[string]any{"data": map[string]any{"ttl": 0, "renewable": false}})
+		case "/v1/sys/internal/ui/mounts/secret/morgan":
+			reply(w, map[string]any{"data": map[string]any{"path": "secret/", "type": "kv", "options": map[string]any{"version": "2"}}})
+		case "/v1/secret/data/morgan":
+			reads.Add(1)
+			reply(w, map[string]any{"data": map[string]any{"data": map[string]any{
+				"password": "s3cret",
+				"db":       map[string]any{"port": 5432},
+			}}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			reply(w, map[string]any{"errors": []string{}})
+		}
+	}))
+}
+
+func TestVault_Resolver(t *testing.T) {
+	var reads atomic.Int32
+	server := fakeVault(t, &reads)
+	defer server.Close()
+
+	resolve := NewVault(server.URL, VaultTokenAuth("root")).Resolver()
+	ctx := context.Background()
+
+	cases := []struct {
+		raw  string
+		want string
+	}{
+		{"vault://secret/morgan#password", "s3cret"},
+		{"vault://secret/morgan#db.port", "5432"},
+		{"vault://secret/morgan#db", `{"port":5432}`},
+	}
+	for _, tc := range cases {
+		got, err := resolve(ctx, tc.raw)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.raw, err)
+		}
+		if got != tc.want {
+			t.Errorf("%s: expected %q, got %q", tc.raw, tc.want, got)
+		}
+	}
+	if reads.Load() != 1 {
+		t.Errorf("expected the secret to be read once and cached, got %d reads", reads.Load())
+	}
+
+	if _, err := resolve(ctx, "vault://secret/morgan#missing"); err == nil {
+		t.Error("expected a missing field to fail")
+	}
+	if _, err := resolve(ctx, "env://HOME"); err != ErrInvalidResolver {
+		t.Errorf("expected ErrInvalidResolver for another scheme, got %v", err)
+	}
+}
+
+func TestVault_ForbiddenToken(t *testing.T) {
+	var reads atomic.Int32
+	server := fakeVault(t, &reads)
+	defer server.Close()
+
+	resolve := NewVault(server.URL, VaultTokenAuth("wrong"), WithVaultKV("secret", 2)).Resolver()
+	if _, err := resolve(context.Background(), "vault://secret/morgan#password"); err == nil {
+		t.Error("expected a forbidden read to fail")
+	}
+}
+
+func TestVaultResolver(t *testing.T) {
+	var reads atomic.Int32
+	server := fakeVault(t, &reads)
+	defer server.Close()
+
+	type appConfig struct {
+		Password string `config:"password"`
+	}
+	var cfg appConfig
+	err := ReadInConfig(context.Background(), &cfg,
+		WithSources(Source{name: "test", source: func(context.Context) (map[string]any, error) {
+			return map[string]any{"password": "vault://morgan#password"}, nil
+		}}),
+		WithResolvers(VaultResolver(server.URL, "root", "secret", "")),
+	)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if cfg.Password != "s3cret" {
+		t.Errorf("expected the vault value, got %q", cfg.Password)
+	}
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchFile reports changes of the file at path. The directory is watched rather than the
+// file, so editors replacing the file and Kubernetes ConfigMap symlink swaps ("..data") are seen.
+func watchFile(path string) WatchFunc {
+	return func(ctx context.Context, changed func()) error {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return err
+		}
+		defer watcher.Close()
+
+		target := filepath.Clean(path)
+		if err := watcher.Add(filepath.Dir(target)); err != nil {
+			return err
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return nil
+				}
+				if event.Has(fsnotify.Chmod) && !event.Has(fsnotify.Write) {
+					continue
+				}
+				if filepath.Clean(event.Name) == target || filepath.Base(event.Name) == "..data" {
+					changed()
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+}
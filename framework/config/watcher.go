@@ -0,0 +1,169 @@
+package config
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultWatchDebounce groups bursts of change notifications (e.g. an editor writing a file
+// in several steps) into a single reload.
+const DefaultWatchDebounce = 500 * time.Millisecond
+
+// Watcher keeps an up-to-date snapshot of a configuration struct T.
+// Every reload decodes into a new T, so a snapshot returned by Get is never mutated;
+// a reload that fails keeps the previous snapshot.
+//
+// Example:
+//
+//	w, err := config.NewWatcher[AppConfig](ctx, config.WithSources(config.FileSource("config.json")))
+//	config.OnChange(w, func(c *AppConfig) string { return c.LogLevel }, logger.SetLevel)
+//	go w.Run(ctx)
+type Watcher[T any] struct {
+	opts     []ConfigOption
+	debounce time.Duration
+	current  atomic.Pointer[T]
+
+	reloadMu sync.Mutex
+
+	subsMu sync.Mutex
+	subs   map[int]func(old, new *T)
+	nextId int
+}
+
+// NewWatcher loads the configuration once and returns a Watcher holding it.
+// Call Run to reload it when a watchable source changes.
+func NewWatcher[T any](ctx context.Context, opts ...ConfigOption) (*Watcher[T], error) {
+	w := &Watcher[T]{
+		opts:     opts,
+		debounce: DefaultWatchDebounce,
+		subs:     make(map[int]func(old, new *T)),
+	}
+	if err := w.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Get returns the current snapshot. It is safe for concurrent use.
+func (w *Watcher[T]) Get() *T {
+	return w.current.Load()
+}
+
+// Subscribe calls fn after every reload that changed the configuration, with the previous
+// and the new snapshot. Subscribers run sequentially on the reloading goroutine.
+// The returned function removes the subscription.
+func (w *Watcher[T]) Subscribe(fn func(old, new *T)) (unsubscribe func()) {
+	w.subsMu.Lock()
+	defer w.subsMu.Unlock()
+
+	id := w.nextId
+	w.nextId++
+	w.subs[id] = fn
+
+	return func() {
+		w.subsMu.Lock()
+		defer w.subsMu.Unlock()
+		delete(w.subs, id)
+	}
+}
+
+// OnChange calls fn with the value picked by selector whenever a reload changes that value.
+//
+// Example:
+//
+//	config.OnChange(w, func(c *AppConfig) string { return c.LogLevel }, logger.SetLevel)
+func OnChange[T, V any](w *Watcher[T], selector func(*T) V, fn func(V)) (unsubscribe func()) {
+	return w.Subscribe(func(old, new *T) {
+		next := selector(new)
+		if !reflect.DeepEqual(selector(old), next) {
+			fn(next)
+		}
+	})
+}
+
+// Reload reads every source again and swaps in the new snapshot if it decoded successfully.
+// Subscribers are notified when the snapshot differs from the previous one.
+func (w *Watcher[T]) Reload(ctx context.Context) error {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	next := new(T)
+	if err := ReadInConfig(ctx, next, w.opts...); err != nil {
+		return err
+	}
+
+	old := w.current.Swap(next)
+	if old == nil || reflect.DeepEqual(old, next) {
+		return nil
+	}
+
+	w.subsMu.Lock()
+	subs := make([]func(old, new *T), 0, len(w.subs))
+	for _, fn := range w.subs {
+		subs = append(subs, fn)
+	}
+	w.subsMu.Unlock()
+
+	for _, fn := range subs {
+		fn(old, next)
+	}
+
+	return nil
+}
+
+// Run watches every watchable source and reloads the configuration when one changes.
+// It blocks until ctx is cancelled. Sources that cannot be watched are only read on reload.
+func (w *Watcher[T]) Run(ctx context.Context) {
+	var cfg LoaderConfig
+	for _, opt := range w.opts {
+		opt(&cfg)
+	}
+
+	changes := make(chan string, 1)
+	for _, source := range cfg.sources {
+		if !source.Watchable() {
+			continue
+		}
+
+		go func() {
+			notify := func() {
+				select {
+				case changes <- source.name:
+				default:
+				}
+			}
+			if err := source.watch(ctx, notify); err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Str("source", source.name).Msg("Stopped watching configuration source")
+			}
+		}()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case name := <-changes:
+			// Let the burst settle, then reload once
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(w.debounce):
+			}
+			select {
+			case <-changes:
+			default:
+			}
+
+			if err := w.Reload(ctx); err != nil {
+				log.Error().Err(err).Str("source", name).Msg("Failed to reload configuration, keeping previous snapshot")
+				continue
+			}
+			log.Info().Str("source", name).Msg("Configuration reloaded")
+		}
+	}
+}
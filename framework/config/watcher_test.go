@@ -0,0 +1,150 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type watchedConfig struct {
+	LogLevel string `config:"log_level"`
+	Origins  string `config:"origins"`
+}
+
+// testSource is a watchable source whose values and changes are driven by the test.
+type testSource struct {
+	mu      sync.Mutex
+	values  map[string]any
+	err     error
+	changed chan struct{}
+}
+
+func newTestSource(values map[string]any) *testSource {
+	return &testSource{values: values, changed: make(chan struct{}, 1)}
+}
+
+// set replaces the values and notifies the watcher.
+func (s *testSource) set(values map[string]any, err error) {
+	s.mu.Lock()
+	s.values, s.err = values, err
+	s.mu.Unlock()
+	s.changed <- struct{}{}
+}
+
+func (s *testSource) source() Source {
+	return Source{
+		name: "test",
+		source: func(context.Context) (map[string]any, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return s.values, s.err
+		},
+		watch: func(ctx context.Context, changed func()) error {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-s.changed:
+					changed()
+				}
+			}
+		},
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	source := newTestSource(map[string]any{"log_level": "info", "origins": "https://a.test"})
+	w, err := NewWatcher[watchedConfig](ctx, WithSources(source.source()))
+	if err != nil {
+		t.Fatalf("NewWatcher() error = %v", err)
+	}
+	w.debounce = time.Millisecond
+
+	reloads := make(chan *watchedConfig, 10)
+	w.Subscribe(func(_, new *watchedConfig) { reloads <- new })
+	levels := make(chan string, 10)
+	OnChange(w, func(c *watchedConfig) string { return c.LogLevel }, func(level string) { levels <- level })
+
+	go w.Run(ctx)
+
+	expectReload := func(t *testing.T) *watchedConfig {
+		t.Helper()
+		select {
+		case c := <-reloads:
+			return c
+		case <-time.After(time.Second):
+			t.Fatal("expected a reload")
+			return nil
+		}
+	}
+	expectNone := func(t *testing.T) {
+		t.Helper()
+		select {
+		case c := <-reloads:
+			t.Fatalf("unexpected reload with %+v", c)
+		case level := <-levels:
+			t.Fatalf("unexpected OnChange with %q", level)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	first := w.Get()
+	if first.LogLevel != "info" {
+		t.Fatalf("Get().LogLevel = %q, want info", first.LogLevel)
+	}
+
+	t.Run("ReloadOnChange", func(t *testing.T) {
+		source.set(map[string]any{"log_level": "debug", "origins": "https://a.test"}, nil)
+
+		if got := expectReload(t); got.LogLevel != "debug" {
+			t.Errorf("reloaded LogLevel = %q, want debug", got.LogLevel)
+		}
+		if got := <-levels; got != "debug" {
+			t.Errorf("OnChange got %q, want debug", got)
+		}
+		if w.Get().LogLevel != "debug" {
+			t.Errorf("Get().LogLevel = %q, want debug", w.Get().LogLevel)
+		}
+		if first.LogLevel != "info" {
+			t.Error("previous snapshot was mutated by the reload")
+		}
+	})
+
+	t.Run("UnchangedValue", func(t *testing.T) {
+		before := w.Get()
+		source.set(map[string]any{"log_level": "debug", "origins": "https://a.test"}, nil)
+
+		expectNone(t)
+		if w.Get().LogLevel != before.LogLevel {
+			t.Errorf("Get() = %+v, want %+v", w.Get(), before)
+		}
+	})
+
+	t.Run("OtherValueChanged", func(t *testing.T) {
+		source.set(map[string]any{"log_level": "debug", "origins": "https://b.test"}, nil)
+
+		if got := expectReload(t); got.Origins != "https://b.test" {
+			t.Errorf("reloaded Origins = %q, want https://b.test", got.Origins)
+		}
+		select {
+		case level := <-levels:
+			t.Errorf("OnChange called with %q although the log level is unchanged", level)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("FailedReloadKeepsSnapshot", func(t *testing.T) {
+		before := w.Get()
+		source.set(nil, errors.New("unreachable"))
+
+		expectNone(t)
+		if w.Get() != before {
+			t.Error("a failed reload replaced the snapshot")
+		}
+	})
+}
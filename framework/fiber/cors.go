@@ -0,0 +1,53 @@
+package fiber
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+)
+
+// CORS is a CORS middleware whose allowed origins can be changed while the server runs,
+// e.g. from a config.Watcher subscription.
+type CORS struct {
+	handler atomic.Pointer[fiber.Handler]
+}
+
+// NewCORS creates the CORS middleware with the configured allowed origins.
+func NewCORS(cfg *Config) (*CORS, error) {
+	c := &CORS{}
+	if err := c.SetAllowedOrigins(cfg.CORSAllowedOrigins); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SetAllowedOrigins replaces the comma-separated list of allowed origins.
+// An empty list disables CORS handling. An invalid list is rejected and the
+// previous origins stay in effect.
+func (c *CORS) SetAllowedOrigins(origins string) (err error) {
+	var handler fiber.Handler
+	if origins == "" {
+		handler = func(ctx *fiber.Ctx) error { return ctx.Next() }
+	} else {
+		// cors.New panics on an invalid origin
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("fiber: invalid CORS allowed origins %q: %v", origins, r)
+			}
+		}()
+		handler = cors.New(cors.Config{
+			AllowOrigins: origins,
+		})
+	}
+	c.handler.Store(&handler)
+	return nil
+}
+
+// Handler returns the middleware to register on the app.
+func (c *CORS) Handler() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		return (*c.handler.Load())(ctx)
+	}
+}
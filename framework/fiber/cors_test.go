@@ -0,0 +1,65 @@
+package fiber
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestCORS_SetAllowedOrigins(t *testing.T) {
+	c, err := NewCORS(&Config{CORSAllowedOrigins: "https://a.test"})
+	if err != nil {
+		t.Fatalf("NewCORS() error = %v", err)
+	}
+	app := fiber.New()
+	app.Use(c.Handler())
+	app.Get("/", func(ctx *fiber.Ctx) error { return ctx.SendStatus(fiber.StatusOK) })
+
+	// allowed returns the Access-Control-Allow-Origin answered to a request from origin.
+	allowed := func(t *testing.T, origin string) string {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Origin", origin)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("GET from %s: %v", origin, err)
+		}
+		return resp.Header.Get("Access-Control-Allow-Origin")
+	}
+
+	if got := allowed(t, "https://a.test"); got != "https://a.test" {
+		t.Errorf("origin a allowed = %q, want https://a.test", got)
+	}
+
+	t.Run("Swap", func(t *testing.T) {
+		if err := c.SetAllowedOrigins("https://b.test"); err != nil {
+			t.Fatalf("SetAllowedOrigins() error = %v", err)
+		}
+		if got := allowed(t, "https://a.test"); got != "" {
+			t.Errorf("origin a allowed = %q after the swap, want none", got)
+		}
+		if got := allowed(t, "https://b.test"); got != "https://b.test" {
+			t.Errorf("origin b allowed = %q, want https://b.test", got)
+		}
+	})
+
+	t.Run("InvalidKeepsPrevious", func(t *testing.T) {
+		if err := c.SetAllowedOrigins("not an origin"); err == nil {
+			t.Fatal("SetAllowedOrigins() error = nil, want an invalid origin error")
+		}
+		if got := allowed(t, "https://b.test"); got != "https://b.test" {
+			t.Errorf("origin b allowed = %q after a rejected update, want https://b.test", got)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		if err := c.SetAllowedOrigins(""); err != nil {
+			t.Fatalf("SetAllowedOrigins() error = %v", err)
+		}
+		if got := allowed(t, "https://b.test"); got != "" {
+			t.Errorf("origin b allowed = %q with CORS disabled, want none", got)
+		}
+	})
+}
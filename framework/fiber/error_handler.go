@@ -1,41 +1,27 @@
 package fiber
 
 import (
-	"net/http"
-	"time"
+	"fmt"
+	"runtime/debug"
 
 	"github.com/gofiber/fiber/v2"
-	libErrors "github.com/siakup/morgan-be/libraries/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/siakup/morgan-be/libraries/responses"
 )
 
+// CustomErrorHandler renders every error returned by handlers and middleware, including
+// recovered panics, with responses.HandleError so the API has a single error envelope.
 func CustomErrorHandler(c *fiber.Ctx, err error) error {
-	code := fiber.StatusInternalServerError
-	message := "Internal Server Error"
-
-	if e, ok := err.(*libErrors.AppError); ok {
-		code = e.Code
-		message = e.Message
-	} else if e, ok := err.(*fiber.Error); ok {
-		code = e.Code
-		message = e.Message
-	} else if err != nil {
-		if message == "Internal Server Error" && err.Error() != "" {
-		}
-	}
-
-	errorShort := http.StatusText(code)
-	if errorShort == "" {
-		errorShort = "Unknown Error"
-	}
-
-	if message == "" {
-		message = errorShort
-	}
+	return responses.HandleError(c, err)
+}
 
-	return c.Status(code).JSON(fiber.Map{
-		"status":    code,
-		"error":     errorShort,
-		"message":   message,
-		"timestamp": time.Now().Format(time.RFC3339),
-	})
+// logPanic logs a recovered panic with its stack trace; the client only gets a generic 500.
+func logPanic(c *fiber.Ctx, e interface{}) {
+	log.Error().
+		Str("request_id", responses.RequestID(c)).
+		Str("trace_id", responses.TraceID(c)).
+		Str("method", c.Method()).
+		Str("path", c.Path()).
+		Str("stack", string(debug.Stack())).
+		Msg(fmt.Sprintf("panic: %v", e))
 }
@@ -0,0 +1,53 @@
+package fiber
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// The instruments record through the global MeterProvider and are no-ops until one is installed.
+var (
+	meter = otel.Meter("github.com/siakup/morgan-be/framework/fiber")
+
+	requestDuration, _ = meter.Float64Histogram(
+		"http.server.request.duration",
+		metric.WithDescription("Duration of HTTP requests, by method, route template and status code."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10),
+	)
+	activeRequests, _ = meter.Int64UpDownCounter(
+		"http.server.active_requests",
+		metric.WithDescription("HTTP requests being served."),
+		metric.WithUnit("{request}"),
+	)
+)
+
+// Metrics records the rate, errors and duration of requests. Requests are labelled with the
+// route template ("/v1/tickets/:id") rather than the path, so the number of series stays bounded;
+// requests no route matched carry the prefix of the last middleware they went through.
+func Metrics(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	method := attribute.String("http.request.method", c.Method())
+
+	activeRequests.Add(ctx, 1, metric.WithAttributes(method))
+	defer activeRequests.Add(ctx, -1, metric.WithAttributes(method))
+
+	start := time.Now()
+	if err := c.Next(); err != nil {
+		// Render the error now so the recorded status is the one sent
+		if err := c.App().ErrorHandler(c, err); err != nil {
+			return err
+		}
+	}
+
+	requestDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		method,
+		attribute.String("http.route", c.Route().Path),
+		attribute.Int("http.response.status_code", c.Response().StatusCode()),
+	))
+	return nil
+}
@@ -0,0 +1,129 @@
+package fiber
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// metricsApp returns an app built by NewFiber with a v1 tickets router, recording into a
+// MeterProvider installed as the global one.
+func metricsApp(t *testing.T) (*fiber.App, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	cfg := &Config{}
+	cors, err := NewCORS(cfg)
+	if err != nil {
+		t.Fatalf("NewCORS() error = %v", err)
+	}
+	app := NewFiber(cfg, cors)
+
+	table := NewRouteTable(RouteTableParams{App: app, Routers: []Router{{
+		Name:     "tickets",
+		Version:  V1,
+		BasePath: "/tickets",
+		Register: func(router fiber.Router) {
+			router.Get("/tickets/:id", func(c *fiber.Ctx) error {
+				if c.Params("id") == "missing" {
+					return fiber.ErrNotFound
+				}
+				return c.SendString(c.Params("id"))
+			})
+		},
+	}}})
+	if err := table.Mount(); err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+
+	return app, reader
+}
+
+// requestCount returns how many requests the duration histogram recorded for route and status.
+func requestCount(t *testing.T, reader *sdkmetric.ManualReader, route string, status int) uint64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	set := attribute.NewSet(
+		attribute.String("http.request.method", http.MethodGet),
+		attribute.String("http.route", route),
+		attribute.Int("http.response.status_code", status),
+	)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			hist, ok := m.Data.(metricdata.Histogram[float64])
+			if m.Name != "http.server.request.duration" || !ok {
+				continue
+			}
+			for _, dp := range hist.DataPoints {
+				if dp.Attributes.Equals(&set) {
+					return dp.Count
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func TestMetrics(t *testing.T) {
+	app, reader := metricsApp(t)
+
+	get := func(t *testing.T, path string, want int) {
+		t.Helper()
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, path, nil))
+		if err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		if resp.StatusCode != want {
+			t.Fatalf("GET %s = %d, want %d", path, resp.StatusCode, want)
+		}
+	}
+
+	t.Run("RouteTemplate", func(t *testing.T) {
+		get(t, "/v1/tickets/1", http.StatusOK)
+		get(t, "/v1/tickets/2", http.StatusOK)
+
+		if got := requestCount(t, reader, "/v1/tickets/:id", http.StatusOK); got != 2 {
+			t.Errorf("requests{http.route=/v1/tickets/:id,status=200} = %d, want 2", got)
+		}
+		if got := requestCount(t, reader, "/v1/tickets/1", http.StatusOK); got != 0 {
+			t.Errorf("requests{http.route=/v1/tickets/1} = %d, want 0: the path must not be a label", got)
+		}
+	})
+
+	t.Run("HandlerError", func(t *testing.T) {
+		get(t, "/v1/tickets/missing", http.StatusNotFound)
+
+		if got := requestCount(t, reader, "/v1/tickets/:id", http.StatusNotFound); got != 1 {
+			t.Errorf("requests{http.route=/v1/tickets/:id,status=404} = %d, want 1", got)
+		}
+	})
+
+	t.Run("UnmatchedRoute", func(t *testing.T) {
+		get(t, "/v1/unknown/1", http.StatusNotFound)
+		get(t, "/wp-admin/setup.php", http.StatusNotFound)
+
+		// Unmatched paths share the label of the global middleware instead of adding series
+		if got := requestCount(t, reader, "/", http.StatusNotFound); got != 2 {
+			t.Errorf("requests{http.route=/,status=404} = %d, want 2", got)
+		}
+		for _, path := range []string{"/v1/unknown/1", "/wp-admin/setup.php"} {
+			if got := requestCount(t, reader, path, http.StatusNotFound); got != 0 {
+				t.Errorf("requests{http.route=%s} = %d, want 0", path, got)
+			}
+		}
+	})
+}
@@ -11,7 +11,6 @@ import (
 	otelfiber "github.com/gofiber/contrib/otelfiber/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/compress"
-	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/helmet"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
@@ -22,7 +21,9 @@ import (
 
 var Module = fx.Module("fiber",
 	fx.Provide(
+		NewCORS,
 		NewFiber,
+		NewRouteTable,
 	),
 	fx.Invoke(
 		StartFiber,
@@ -32,17 +33,24 @@ var Module = fx.Module("fiber",
 type Config struct {
 	AppName string `config:"app_name"`
 
-	Port int `config:"port"`
+	Port int `config:"port" validate:"min=0,max=65535"`
 
-	ReadTimeout time.Duration `config:"read_wait_timeout"`
+	ReadTimeout time.Duration `config:"read_wait_timeout" default:"10s"`
 
-	WriteTimeout time.Duration `config:"write_wait_timeout"`
+	WriteTimeout time.Duration `config:"write_wait_timeout" default:"10s"`
 
-	BodyLimit int `config:"body_limit"`
+	BodyLimit int `config:"body_limit" default:"4194304"`
 
 	CORSAllowedOrigins string `config:"cors_allowed_origins"`
 
 	EnableCompress bool `config:"enable_compress"`
+
+	// ProxyHeader is the header holding the client IP behind a proxy, e.g. "X-Forwarded-For".
+	// It is only read from requests sent by TrustedProxies.
+	ProxyHeader string `config:"proxy_header"`
+
+	// TrustedProxies lists the proxy IPs and CIDR ranges whose ProxyHeader is believed.
+	TrustedProxies []string `config:"trusted_proxies"`
 }
 
 // NewFiber creates a new Fiber application with production defaults.
@@ -50,7 +58,7 @@ type Config struct {
 // - Custom JSON encoder/decoder (goccy/go-json)
 // - Timeouts and body limits
 // - Middleware: RequestID, Logger (Zerolog), Recover, Helmet, CORS, Compress.
-func NewFiber(cfg *Config) *fiber.App {
+func NewFiber(cfg *Config, cors *CORS) *fiber.App {
 	// Defaults
 	readTimeout := cfg.ReadTimeout
 	if readTimeout == 0 {
@@ -73,13 +81,20 @@ func NewFiber(cfg *Config) *fiber.App {
 		JSONEncoder:  json.Marshal,
 		JSONDecoder:  json.Unmarshal,
 		ErrorHandler: CustomErrorHandler,
+		ProxyHeader:  cfg.ProxyHeader,
+		// Any client can send the proxy header, so it only counts when a trusted proxy set it
+		EnableTrustedProxyCheck: cfg.ProxyHeader != "",
+		TrustedProxies:          cfg.TrustedProxies,
+		EnableIPValidation:      cfg.ProxyHeader != "",
 	})
 
 	// Middleware Stack
 	app.Use(requestid.New())
 
-	// Add OpenTelemetry middleware
-	app.Use(otelfiber.Middleware())
+	// Add OpenTelemetry middleware; Metrics records the RED metrics instead of otelfiber,
+	// whose attributes include the Host header
+	app.Use(otelfiber.Middleware(otelfiber.WithoutMetrics(true)))
+	app.Use(Metrics)
 
 	app.Use(logger.New(logger.Config{
 		Format:     "${time} | ${status} | ${latency} | ${method} | ${path} | ${error}\n",
@@ -89,14 +104,14 @@ func NewFiber(cfg *Config) *fiber.App {
 		Output: log.Logger,
 	}))
 
-	app.Use(recover.New())
+	app.Use(recover.New(recover.Config{
+		EnableStackTrace:  true,
+		StackTraceHandler: logPanic,
+	}))
 	app.Use(helmet.New())
 
-	if cfg.CORSAllowedOrigins != "" {
-		app.Use(cors.New(cors.Config{
-			AllowOrigins: cfg.CORSAllowedOrigins,
-		}))
-	}
+	// Allowed origins can change at runtime, so the middleware is always installed
+	app.Use(cors.Handler())
 
 	if cfg.EnableCompress {
 		app.Use(compress.New())
@@ -105,11 +120,6 @@ func NewFiber(cfg *Config) *fiber.App {
 	return app
 }
 
-// Router is the interface that modules must implement to register their routes.
-type Router interface {
-	RegisterRoutes(app *fiber.App)
-}
-
 // StartFiberParams holds the dependencies for starting the Fiber server.
 type StartFiberParams struct {
 	fx.In
@@ -117,20 +127,21 @@ type StartFiberParams struct {
 	Lifecycle fx.Lifecycle
 	App       *fiber.App
 	Config    *Config
-	Routers   []Router `group:"routers"`
+	Routes    *RouteTable
 }
 
 // StartFiber registers start and stop hooks for the Fiber application.
-// It accepts a list of Routers (via Fx group "routers") and registers them.
-// It starts the server in a goroutine on startup and shuts it down gracefully on stop.
+// On start it mounts the routers contributed through the Fx group "routers", after every
+// middleware registered by invokes, then starts the server in a goroutine.
+// It shuts the server down gracefully on stop.
 func StartFiber(params StartFiberParams) {
-	// Register all provided routers
-	for _, router := range params.Routers {
-		router.RegisterRoutes(params.App)
-	}
-
 	params.Lifecycle.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
+			if err := params.Routes.Mount(); err != nil {
+				return err
+			}
+			log.Info().Int("routes", len(params.Routes.Routes())).Msg("routes mounted")
+
 			go func() {
 				// Don't block OnStart with Listen
 				if err := params.App.Listen(fmt.Sprintf(":%d", params.Config.Port)); err != nil {
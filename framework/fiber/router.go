@@ -0,0 +1,205 @@
+package fiber
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/fx"
+)
+
+// API versions routers are mounted under, e.g. V1 serves "/tickets" at "/v1/tickets".
+const (
+	V1 = "v1"
+	V2 = "v2"
+)
+
+var versionPattern = regexp.MustCompile(`^v[0-9]+$`)
+
+// Router contributes routes to the app through the Fx "routers" group.
+type Router struct {
+	// Name identifies the router in the route table, e.g. the module name.
+	Name string
+	// Version is the API version prefix (V1, V2, ...). Empty mounts the routes at the root.
+	Version string
+	// BasePath is the path prefix owned by the router, e.g. "/tickets".
+	BasePath string
+	// Middleware runs, in order, before every route under Version and BasePath.
+	// Without a BasePath it applies to everything registered after it under Version.
+	Middleware []fiber.Handler
+	// Register mounts the routes on a router already prefixed with Version.
+	Register func(router fiber.Router)
+}
+
+// Prefix returns the path owned by the router, e.g. "/v1/tickets".
+func (r Router) Prefix() string {
+	if r.Version == "" {
+		return r.BasePath
+	}
+	return "/" + r.Version + r.BasePath
+}
+
+// Route is an entry of the RouteTable.
+type Route struct {
+	Method  string
+	Path    string
+	Router  string
+	Version string
+}
+
+// RouteTable mounts the routers of the "routers" group and records the routes each one registers.
+type RouteTable struct {
+	app     *fiber.App
+	routers []Router
+
+	once   sync.Once
+	routes []Route
+	err    error
+}
+
+// RouteTableParams holds the dependencies of the RouteTable.
+type RouteTableParams struct {
+	fx.In
+
+	App     *fiber.App
+	Routers []Router `group:"routers"`
+}
+
+// NewRouteTable creates a RouteTable for the contributed routers. Nothing is mounted until Mount.
+func NewRouteTable(params RouteTableParams) *RouteTable {
+	return &RouteTable{app: params.App, routers: params.Routers}
+}
+
+// Mount validates the routers and registers them on the app, ordered by version and base path so
+// that the routes do not depend on the order modules were provided in. It runs only once; later
+// calls return the result of the first.
+func (t *RouteTable) Mount() error {
+	t.once.Do(func() {
+		t.err = t.mount()
+	})
+	return t.err
+}
+
+func (t *RouteTable) mount() error {
+	routers := append([]Router(nil), t.routers...)
+	if err := validateRouters(routers); err != nil {
+		return err
+	}
+	sort.SliceStable(routers, func(i, j int) bool {
+		if routers[i].Version != routers[j].Version {
+			return routers[i].Version < routers[j].Version
+		}
+		return routers[i].BasePath < routers[j].BasePath
+	})
+
+	for _, r := range routers {
+		before := routeKeys(t.app.GetRoutes(true))
+
+		var group fiber.Router = t.app
+		if r.Version != "" {
+			group = t.app.Group("/" + r.Version)
+		}
+		if len(r.Middleware) > 0 {
+			args := make([]any, 0, len(r.Middleware)+1)
+			if r.BasePath != "" {
+				args = append(args, r.BasePath)
+			}
+			for _, mw := range r.Middleware {
+				args = append(args, mw)
+			}
+			group.Use(args...)
+		}
+		r.Register(group)
+
+		t.routes = append(t.routes, newRoutes(r, t.app.GetRoutes(true), before)...)
+	}
+
+	sort.SliceStable(t.routes, func(i, j int) bool {
+		if t.routes[i].Path != t.routes[j].Path {
+			return t.routes[i].Path < t.routes[j].Path
+		}
+		return t.routes[i].Method < t.routes[j].Method
+	})
+	return nil
+}
+
+// validateRouters checks every router can be registered and no two own the same prefix.
+func validateRouters(routers []Router) error {
+	prefixes := make(map[string]string, len(routers))
+	for _, r := range routers {
+		if r.Register == nil {
+			return fmt.Errorf("fiber: router %q has no route registration", r.Name)
+		}
+		if r.Version != "" && !versionPattern.MatchString(r.Version) {
+			return fmt.Errorf("fiber: router %q has invalid version %q, expected v1, v2, ...", r.Name, r.Version)
+		}
+		if r.BasePath == "" {
+			continue
+		}
+		if r.BasePath[0] != '/' {
+			return fmt.Errorf("fiber: router %q base path %q must start with /", r.Name, r.BasePath)
+		}
+		if other, ok := prefixes[r.Prefix()]; ok {
+			return fmt.Errorf("fiber: routers %q and %q both own %s", other, r.Name, r.Prefix())
+		}
+		prefixes[r.Prefix()] = r.Name
+	}
+	return nil
+}
+
+func routeKeys(routes []fiber.Route) map[string]struct{} {
+	keys := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		keys[route.Method+" "+route.Path] = struct{}{}
+	}
+	return keys
+}
+
+// newRoutes returns the routes r added to the app, leaving out the HEAD routes Fiber adds for GET.
+func newRoutes(r Router, routes []fiber.Route, before map[string]struct{}) []Route {
+	added := make(map[string]struct{})
+	var result []Route
+	for _, route := range routes {
+		key := route.Method + " " + route.Path
+		if _, ok := before[key]; ok {
+			continue
+		}
+		if _, ok := added[key]; ok {
+			continue
+		}
+		added[key] = struct{}{}
+		result = append(result, Route{Method: route.Method, Path: route.Path, Router: r.Name, Version: r.Version})
+	}
+
+	filtered := result[:0]
+	for _, route := range result {
+		if _, ok := added[fiber.MethodGet+" "+route.Path]; route.Method == fiber.MethodHead && ok {
+			continue
+		}
+		filtered = append(filtered, route)
+	}
+	return filtered
+}
+
+// Routes returns the mounted routes sorted by path and method.
+func (t *RouteTable) Routes() []Route {
+	return t.routes
+}
+
+// Print writes the mounted routes as an aligned table.
+func (t *RouteTable) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "METHOD\tPATH\tROUTER\tVERSION")
+	for _, route := range t.routes {
+		version := route.Version
+		if version == "" {
+			version = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", route.Method, route.Path, route.Router, version)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,200 @@
+package fiber
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ticketsRouter registers GET and POST /tickets answering with the version it was mounted under.
+func ticketsRouter(name, version string, middleware ...fiber.Handler) Router {
+	return Router{
+		Name:       name,
+		Version:    version,
+		BasePath:   "/tickets",
+		Middleware: middleware,
+		Register: func(router fiber.Router) {
+			group := router.Group("/tickets")
+			group.Get("/", func(c *fiber.Ctx) error { return c.SendString(version) })
+			group.Post("/", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusCreated) })
+		},
+	}
+}
+
+func TestRouter_Prefix(t *testing.T) {
+	tests := []struct {
+		router Router
+		want   string
+	}{
+		{router: Router{Version: V1, BasePath: "/tickets"}, want: "/v1/tickets"},
+		{router: Router{Version: V2}, want: "/v2"},
+		{router: Router{BasePath: "/metrics"}, want: "/metrics"},
+		{router: Router{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.router.Prefix(); got != tt.want {
+			t.Errorf("Prefix() of %+v = %q, want %q", tt.router, got, tt.want)
+		}
+	}
+}
+
+func TestRouteTable_Mount(t *testing.T) {
+	app := fiber.New()
+	tagged := func(c *fiber.Ctx) error {
+		c.Set("X-Router", "tickets-v2")
+		return c.Next()
+	}
+	table := NewRouteTable(RouteTableParams{
+		App: app,
+		Routers: []Router{
+			ticketsRouter("tickets-v2", V2, tagged),
+			{
+				Name: "metrics",
+				Register: func(router fiber.Router) {
+					router.Get("/metrics", func(c *fiber.Ctx) error { return c.SendString("ok") })
+				},
+			},
+			ticketsRouter("tickets", V1),
+		},
+	})
+
+	if err := table.Mount(); err != nil {
+		t.Fatalf("Mount() error = %v", err)
+	}
+
+	t.Run("VersionPrefixes", func(t *testing.T) {
+		for path, want := range map[string]string{"/v1/tickets": V1, "/v2/tickets": V2, "/metrics": "ok"} {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, path, nil))
+			if err != nil {
+				t.Fatalf("GET %s: %v", path, err)
+			}
+			body := new(bytes.Buffer)
+			_, _ = body.ReadFrom(resp.Body)
+			if resp.StatusCode != http.StatusOK || body.String() != want {
+				t.Errorf("GET %s = %d %q, want 200 %q", path, resp.StatusCode, body.String(), want)
+			}
+		}
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/tickets", nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("GET /tickets = %d, want 404 without a version prefix", resp.StatusCode)
+		}
+	})
+
+	t.Run("MiddlewareScopedToPrefix", func(t *testing.T) {
+		for path, want := range map[string]string{"/v2/tickets": "tickets-v2", "/v1/tickets": "", "/metrics": ""} {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, path, nil))
+			if err != nil {
+				t.Fatalf("GET %s: %v", path, err)
+			}
+			if got := resp.Header.Get("X-Router"); got != want {
+				t.Errorf("GET %s X-Router = %q, want %q", path, got, want)
+			}
+		}
+	})
+
+	t.Run("Routes", func(t *testing.T) {
+		want := []Route{
+			{Method: fiber.MethodGet, Path: "/metrics", Router: "metrics"},
+			{Method: fiber.MethodGet, Path: "/v1/tickets/", Router: "tickets", Version: V1},
+			{Method: fiber.MethodPost, Path: "/v1/tickets/", Router: "tickets", Version: V1},
+			{Method: fiber.MethodGet, Path: "/v2/tickets/", Router: "tickets-v2", Version: V2},
+			{Method: fiber.MethodPost, Path: "/v2/tickets/", Router: "tickets-v2", Version: V2},
+		}
+		if got := table.Routes(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Routes() = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("MountOnce", func(t *testing.T) {
+		if err := table.Mount(); err != nil {
+			t.Fatalf("second Mount() error = %v", err)
+		}
+		if got := len(table.Routes()); got != 5 {
+			t.Errorf("len(Routes()) after second Mount = %d, want 5", got)
+		}
+	})
+
+	t.Run("Print", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := table.Print(&out); err != nil {
+			t.Fatalf("Print() error = %v", err)
+		}
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		if len(lines) != 6 {
+			t.Fatalf("Print() wrote %d lines, want header and 5 routes:\n%s", len(lines), out.String())
+		}
+		if fields := strings.Fields(lines[0]); !reflect.DeepEqual(fields, []string{"METHOD", "PATH", "ROUTER", "VERSION"}) {
+			t.Errorf("header = %q", lines[0])
+		}
+		if fields := strings.Fields(lines[1]); !reflect.DeepEqual(fields, []string{"GET", "/metrics", "metrics", "-"}) {
+			t.Errorf("unversioned route = %q, want version shown as -", lines[1])
+		}
+	})
+}
+
+func TestRouteTable_MountInvalid(t *testing.T) {
+	noop := func(fiber.Router) {}
+	tests := []struct {
+		name    string
+		routers []Router
+		want    string
+	}{
+		{
+			name:    "NoRegister",
+			routers: []Router{{Name: "tickets", Version: V1, BasePath: "/tickets"}},
+			want:    `router "tickets" has no route registration`,
+		},
+		{
+			name:    "InvalidVersion",
+			routers: []Router{{Name: "tickets", Version: "1", BasePath: "/tickets", Register: noop}},
+			want:    `router "tickets" has invalid version "1"`,
+		},
+		{
+			name:    "RelativeBasePath",
+			routers: []Router{{Name: "tickets", Version: V1, BasePath: "tickets", Register: noop}},
+			want:    `router "tickets" base path "tickets" must start with /`,
+		},
+		{
+			name: "DuplicatePrefix",
+			routers: []Router{
+				{Name: "tickets", Version: V1, BasePath: "/tickets", Register: noop},
+				{Name: "helpdesk", Version: V1, BasePath: "/tickets", Register: noop},
+			},
+			want: `routers "tickets" and "helpdesk" both own /v1/tickets`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := NewRouteTable(RouteTableParams{App: fiber.New(), Routers: tt.routers})
+
+			err := table.Mount()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Mount() error = %v, want %q", err, tt.want)
+			}
+			if len(table.Routes()) != 0 {
+				t.Errorf("Routes() = %+v, want none after a failed Mount", table.Routes())
+			}
+		})
+	}
+
+	t.Run("SameBasePathOtherVersion", func(t *testing.T) {
+		table := NewRouteTable(RouteTableParams{App: fiber.New(), Routers: []Router{
+			{Name: "tickets", Version: V1, BasePath: "/tickets", Register: noop},
+			{Name: "tickets-v2", Version: V2, BasePath: "/tickets", Register: noop},
+		}})
+		if err := table.Mount(); err != nil {
+			t.Errorf("Mount() error = %v, want nil", err)
+		}
+	})
+}
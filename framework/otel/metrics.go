@@ -0,0 +1,96 @@
+package otel
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	fiberfx "github.com/siakup/morgan-be/framework/fiber"
+	"go.opentelemetry.io/otel"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.uber.org/fx"
+)
+
+// DefaultMetricsPath is the path Prometheus scrapes when Config.MetricsPath is empty.
+const DefaultMetricsPath = "/metrics"
+
+// Metrics is the MeterProvider of the application and the handler exposing it to Prometheus.
+type Metrics struct {
+	Provider *sdkmetric.MeterProvider
+	Handler  http.Handler
+}
+
+// NewMetrics creates a MeterProvider exported to Prometheus, along with the Go runtime and
+// process collectors. It returns nil when metrics are disabled; instruments created from the
+// global MeterProvider then stay no-ops.
+func NewMetrics(params Params) (*Metrics, error) {
+	if params.Config.MetricsExporter != "prometheus" {
+		return nil, nil
+	}
+
+	res, err := newResource(context.Background(), params.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	exporter, err := otelprom.New(otelprom.WithRegisterer(registry))
+	if err != nil {
+		return nil, err
+	}
+
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(exporter),
+		sdkmetric.WithResource(res),
+	)
+
+	params.Lifecycle.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return mp.Shutdown(ctx)
+		},
+	})
+
+	return &Metrics{
+		Provider: mp,
+		Handler:  promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry}),
+	}, nil
+}
+
+// RegisterGlobalMeter registers the global meter provider. Instruments created before, e.g. in
+// package variables, record through it from then on.
+func RegisterGlobalMeter(m *Metrics) {
+	if m == nil {
+		return
+	}
+	otel.SetMeterProvider(m.Provider)
+}
+
+// MetricsRouter serves the metrics at Config.MetricsPath through the Fiber "routers" group.
+func MetricsRouter(m *Metrics, cfg *Config) []fiberfx.Router {
+	if m == nil {
+		return nil
+	}
+
+	path := cfg.MetricsPath
+	if path == "" {
+		path = DefaultMetricsPath
+	}
+
+	handler := adaptor.HTTPHandler(m.Handler)
+	return []fiberfx.Router{{
+		Name: "metrics",
+		Register: func(router fiber.Router) {
+			router.Get(path, handler)
+		},
+	}}
+}
@@ -18,9 +18,15 @@ import (
 var Module = fx.Module("otel",
 	fx.Provide(
 		NewTracerProvider,
+		NewMetrics,
+		fx.Annotate(
+			MetricsRouter,
+			fx.ResultTags(`group:"routers,flatten"`),
+		),
 	),
 	fx.Invoke(
 		RegisterGlobalTracer,
+		RegisterGlobalMeter,
 	),
 )
 
@@ -31,13 +37,20 @@ type Config struct {
 
 	// Exporter is the type of exporter to use.
 	// Values: "otlp-grpc", "stdout", "noop" (default)
-	Exporter string `config:"exporter"`
+	Exporter string `config:"exporter" validate:"omitempty,oneof=otlp-grpc stdout noop"`
 
 	// Endpoint is the endpoint for the exporter (e.g. "localhost:4317").
 	Endpoint string `config:"endpoint"`
 
 	// Insecure disables TLS for the exporter.
 	Insecure bool `config:"insecure"`
+
+	// MetricsExporter is the type of exporter to use for metrics.
+	// Values: "prometheus", "noop" (default)
+	MetricsExporter string `config:"metrics_exporter" validate:"omitempty,oneof=prometheus noop"`
+
+	// MetricsPath is the path Prometheus scrapes, "/metrics" by default.
+	MetricsPath string `config:"metrics_path"`
 }
 
 // Params holds dependencies for creating the TracerProvider.
@@ -52,11 +65,7 @@ type Params struct {
 func NewTracerProvider(params Params) (*sdktrace.TracerProvider, error) {
 	ctx := context.Background()
 
-	res, err := resource.New(ctx,
-		resource.WithAttributes(
-			semconv.ServiceName(params.Config.ServiceName),
-		),
-	)
+	res, err := newResource(ctx, params.Config)
 	if err != nil {
 		return nil, err
 	}
@@ -112,6 +121,15 @@ func NewTracerProvider(params Params) (*sdktrace.TracerProvider, error) {
 	return tp, nil
 }
 
+// newResource describes the service in the telemetry it exports.
+func newResource(ctx context.Context, cfg *Config) (*resource.Resource, error) {
+	return resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceName(cfg.ServiceName),
+		),
+	)
+}
+
 // RegisterGlobalTracer registers the global tracer provider and propagator.
 func RegisterGlobalTracer(tp *sdktrace.TracerProvider) {
 	if tp == nil {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
+	"github.com/siakup/morgan-be/framework/config"
 	"go.uber.org/fx"
 )
 
@@ -23,14 +24,14 @@ var Module = fx.Module("redis",
 type Config struct {
 	// Address is a single Redis address (e.g., "localhost:6379").
 	// Deprecated: Use Addresses for new applications to support clustering/failover.
-	Address string `config:"redis_address"`
+	Address string `config:"redis_address" validate:"required_without=Addresses"`
 
 	// Addresses is a comma-separated list of Redis addresses (e.g., "host1:6379,host2:6379").
 	// Use this for Sentinel or Cluster configurations.
 	Addresses string `config:"redis_addresses"`
 
 	// Password for Redis authentication.
-	Password string `config:"redis_password"`
+	Password config.Secret `config:"redis_password"`
 
 	// DB is the database number to select (typically 0).
 	DB int `config:"redis_db"`
@@ -63,7 +64,7 @@ func NewRedis(lc fx.Lifecycle, cfg *Config) (redis.UniversalClient, error) {
 	// based on the number of addresses and options provided.
 	rdb := redis.NewUniversalClient(&redis.UniversalOptions{
 		Addrs:    addrs,
-		Password: cfg.Password,
+		Password: cfg.Password.Value(),
 		DB:       cfg.DB,
 	})
 
@@ -74,6 +75,11 @@ func NewRedis(lc fx.Lifecycle, cfg *Config) (redis.UniversalClient, error) {
 				return err
 			}
 
+			// Export pool usage, hits and misses through the global MeterProvider
+			if err := redisotel.InstrumentMetrics(rdb); err != nil {
+				return err
+			}
+
 			// Fail startup if we can't ping Redis
 			return rdb.Ping(ctx).Err()
 		},
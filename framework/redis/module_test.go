@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/fx/fxtest"
+)
+
+func TestNewRedis_PoolMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	lc := fxtest.NewLifecycle(t)
+	rdb, err := NewRedis(lc, &Config{Address: miniredis.RunT(t).Addr()})
+	if err != nil {
+		t.Fatalf("NewRedis() error = %v", err)
+	}
+	lc.RequireStart()
+	defer lc.RequireStop()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	// value sums the data points of the pool metric name whose state attribute is state
+	value := func(name, state string) int64 {
+		var total int64
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				sum, ok := m.Data.(metricdata.Sum[int64])
+				if m.Name != name || !ok {
+					continue
+				}
+				for _, dp := range sum.DataPoints {
+					if v, _ := dp.Attributes.Value("state"); v.AsString() == state {
+						total += dp.Value
+					}
+				}
+			}
+		}
+		return total
+	}
+
+	if got, want := value("db.client.connections.max", ""), int64(rdb.(*redis.Client).Options().PoolSize); got != want {
+		t.Errorf("db.client.connections.max = %d, want the pool size %d", got, want)
+	}
+	// The startup ping leaves its connection idle in the pool
+	if got := value("db.client.connections.usage", "idle"); got != 1 {
+		t.Errorf("db.client.connections.usage{state=idle} = %d, want 1", got)
+	}
+	if got := value("db.client.connections.usage", "used"); got != 0 {
+		t.Errorf("db.client.connections.usage{state=used} = %d, want 0", got)
+	}
+}
@@ -0,0 +1,125 @@
+// Package audit defines the contract used by usecases to record mutations for the audit trail.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Actions recorded in the audit trail.
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionAssign  = "assign"
+	ActionReorder = "reorder"
+)
+
+// Entry describes a single mutation. Before is nil for creations and After is nil for deletions.
+// Actor, institution and trace ID are taken from the context by the Recorder.
+type Entry struct {
+	Entity   string
+	EntityId string
+	Action   string
+	Before   any
+	After    any
+}
+
+// Recorder persists audit entries. Usecases record inside the transaction of the mutation
+// (postgres.Transactor.WithinTx), so the entry commits or rolls back with the change;
+// an error fails the mutation.
+type Recorder interface {
+	Record(ctx context.Context, entry Entry) error
+}
+
+// Change is the before and after value of a single field.
+type Change struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// Snapshot converts a value into a flat map keyed by its `object` tag, falling back to the
+// `json` tag and then the field name. Values are normalized through JSON so snapshots of
+// different types compare equal when they serialize equally. nil yields nil.
+func Snapshot(v any) map[string]any {
+	if v == nil {
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	result := make(map[string]any)
+	switch rv.Kind() {
+	case reflect.Struct:
+		rt := rv.Type()
+		for i := 0; i < rt.NumField(); i++ {
+			field := rt.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := fieldName(field)
+			if name == "-" {
+				continue
+			}
+			result[name] = normalize(rv.Field(i).Interface())
+		}
+	case reflect.Map:
+		iter := rv.MapRange()
+		for iter.Next() {
+			result[iter.Key().String()] = normalize(iter.Value().Interface())
+		}
+	default:
+		result["value"] = normalize(rv.Interface())
+	}
+
+	return result
+}
+
+// Diff returns the fields whose value differs between two snapshots.
+func Diff(before, after map[string]any) map[string]Change {
+	diff := make(map[string]Change)
+	for key, from := range before {
+		to, ok := after[key]
+		if !ok || !reflect.DeepEqual(from, to) {
+			diff[key] = Change{From: from, To: to}
+		}
+	}
+	for key, to := range after {
+		if _, ok := before[key]; !ok {
+			diff[key] = Change{From: nil, To: to}
+		}
+	}
+	return diff
+}
+
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"object", "json"} {
+		if value, ok := field.Tag.Lookup(tag); ok {
+			if name, _, _ := strings.Cut(value, ","); name != "" {
+				return name
+			}
+		}
+	}
+	return field.Name
+}
+
+func normalize(v any) any {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	var out any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil
+	}
+	return out
+}
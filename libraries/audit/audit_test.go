@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"reflect"
+	"testing"
+)
+
+type role struct {
+	Id          string   `object:"id"`
+	Name        string   `object:"name"`
+	Permissions []string `object:"permissions"`
+	Secret      string   `object:"-"`
+	internal    string
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Run("Struct", func(t *testing.T) {
+		snap := Snapshot(&role{Id: "r1", Name: "Admin", Permissions: []string{"a"}, Secret: "s", internal: "x"})
+
+		want := map[string]any{
+			"id":          "r1",
+			"name":        "Admin",
+			"permissions": []any{"a"},
+		}
+		if !reflect.DeepEqual(snap, want) {
+			t.Errorf("Snapshot() = %v, want %v", snap, want)
+		}
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		var r *role
+		if Snapshot(nil) != nil || Snapshot(r) != nil {
+			t.Error("expected nil snapshot for nil values")
+		}
+	})
+}
+
+func TestDiff(t *testing.T) {
+	before := Snapshot(role{Id: "r1", Name: "Admin", Permissions: []string{"a"}})
+	after := Snapshot(role{Id: "r1", Name: "Administrator", Permissions: []string{"a", "b"}})
+
+	diff := Diff(before, after)
+
+	if len(diff) != 2 {
+		t.Fatalf("expected 2 changes, got %d: %v", len(diff), diff)
+	}
+	if want := (Change{From: "Admin", To: "Administrator"}); !reflect.DeepEqual(diff["name"], want) {
+		t.Errorf("name change = %v, want %v", diff["name"], want)
+	}
+	if _, ok := diff["id"]; ok {
+		t.Error("unchanged field id should not be in the diff")
+	}
+
+	created := Diff(nil, map[string]any{"id": "r1"})
+	if want := (Change{From: nil, To: "r1"}); !reflect.DeepEqual(created["id"], want) {
+		t.Errorf("created id change = %v, want %v", created["id"], want)
+	}
+}
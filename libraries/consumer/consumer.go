@@ -2,7 +2,10 @@ package consumer
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/google/uuid"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/rs/zerolog/log"
 	"github.com/siakup/morgan-be/framework/bunnymq"
@@ -12,14 +15,21 @@ import (
 // It returns an error if processing fails.
 type Handler func(ctx context.Context, msg amqp.Delivery) error
 
+// Config holds the defaults applied to every registered consumer. Zero values keep the
+// built-in defaults; options on a Registration override them.
 type Config struct {
-	AutoAck          bool       `config:"auto_ack"`
-	Exclusive        bool       `config:"exclusive"`
-	NoLocal          bool       `config:"no_local"`
-	Args             amqp.Table `config:"args"`
-	QosPrefetchCount int        `config:"qos_prefetch_count"`
-	QosPrefetchSize  int        `config:"qos_prefetch_size"`
-	QosGlobal        bool       `config:"qos_global"`
+	AutoAck          bool            `config:"auto_ack"`
+	Exclusive        bool            `config:"exclusive"`
+	NoLocal          bool            `config:"no_local"`
+	Args             amqp.Table      `config:"args"`
+	QosPrefetchCount int             `config:"qos_prefetch_count"`
+	QosPrefetchSize  int             `config:"qos_prefetch_size"`
+	QosGlobal        bool            `config:"qos_global"`
+	MaxAttempts      int             `config:"max_attempts"`
+	RetryDelays      []time.Duration `config:"retry_delays"`
+	DeadLetter       *bool           `config:"dead_letter"`
+	Workers          int             `config:"workers"`
+	DrainTimeout     time.Duration   `config:"drain_timeout"`
 }
 
 // Consumer handles consuming messages from a RabbitMQ queue with auto-reconnect logic.
@@ -43,8 +53,20 @@ type options struct {
 	QosPrefetchCount int
 	QosPrefetchSize  int
 	QosGlobal        bool
+	MaxAttempts      int
+	RetryDelays      []time.Duration
+	DeadLetter       bool
+	Workers          int
+	Key              KeyFunc
+	DrainTimeout     time.Duration
 }
 
+// DefaultDrainTimeout bounds how long a stopping consumer waits for in-flight handlers.
+const DefaultDrainTimeout = 10 * time.Second
+
+// reconnectDelay is the pause between attempts to re-establish a failed consumer channel.
+const reconnectDelay = time.Second
+
 // defaultOptions returns the default options.
 func defaultOptions() options {
 	return options{
@@ -55,6 +77,12 @@ func defaultOptions() options {
 		QosPrefetchCount: 1,
 		QosPrefetchSize:  0,
 		QosGlobal:        false,
+		MaxAttempts:      DefaultMaxAttempts,
+		RetryDelays:      DefaultRetryDelays,
+		DeadLetter:       true,
+		Workers:          1,
+		Key:              nil,
+		DrainTimeout:     DefaultDrainTimeout,
 	}
 }
 
@@ -98,8 +126,90 @@ func WithQos(prefetchCount, prefetchSize int, global bool) Option {
 	}
 }
 
+// WithRetry sets how many times a message is attempted (first delivery included)
+// and the delays between attempts. A failed message is parked in a TTL retry queue
+// per delay and dead-lettered back to the consumed queue once the delay expires.
+// maxAttempts of 1 disables retries.
+func WithRetry(maxAttempts int, delays ...time.Duration) Option {
+	return func(o *options) {
+		o.MaxAttempts = maxAttempts
+		if len(delays) > 0 {
+			o.RetryDelays = delays
+		}
+	}
+}
+
+// WithDeadLetter sets whether exhausted and non-retryable messages are published to
+// DeadLetterQueue(queue). When disabled they are rejected without requeue, which drops
+// them unless the queue has its own dead-letter exchange.
+func WithDeadLetter(enabled bool) Option {
+	return func(o *options) {
+		o.DeadLetter = enabled
+	}
+}
+
+// WithWorkers sets how many deliveries are handled concurrently. The prefetch count is
+// raised to at least n so every worker can hold a delivery.
+func WithWorkers(n int) Option {
+	return func(o *options) {
+		o.Workers = n
+	}
+}
+
+// WithOrderingKey keeps deliveries sharing a key in order: each key is pinned to one worker,
+// so they are handled one at a time while other keys proceed in parallel.
+// A retried message goes back through its retry queue, so ordering is not kept across retries.
+func WithOrderingKey(key KeyFunc) Option {
+	return func(o *options) {
+		o.Key = key
+	}
+}
+
+// WithDrainTimeout sets how long Consume waits for in-flight handlers once its context is cancelled.
+// Handlers still running afterwards see their context cancelled and their deliveries are redelivered.
+func WithDrainTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.DrainTimeout = d
+	}
+}
+
+// FromConfig converts the configured values into options, leaving unset fields at their defaults.
+func FromConfig(cfg Config) []Option {
+	var opts []Option
+	if cfg.AutoAck {
+		opts = append(opts, WithAutoAck(true))
+	}
+	if cfg.Exclusive {
+		opts = append(opts, WithExclusive(true))
+	}
+	if cfg.NoLocal {
+		opts = append(opts, WithNoLocal(true))
+	}
+	if cfg.Args != nil {
+		opts = append(opts, WithArgs(cfg.Args))
+	}
+	if cfg.QosPrefetchCount > 0 {
+		opts = append(opts, WithQos(cfg.QosPrefetchCount, cfg.QosPrefetchSize, cfg.QosGlobal))
+	}
+	if cfg.MaxAttempts > 0 {
+		opts = append(opts, WithRetry(cfg.MaxAttempts, cfg.RetryDelays...))
+	} else if len(cfg.RetryDelays) > 0 {
+		opts = append(opts, WithRetry(DefaultMaxAttempts, cfg.RetryDelays...))
+	}
+	if cfg.DeadLetter != nil {
+		opts = append(opts, WithDeadLetter(*cfg.DeadLetter))
+	}
+	if cfg.Workers > 0 {
+		opts = append(opts, WithWorkers(cfg.Workers))
+	}
+	if cfg.DrainTimeout > 0 {
+		opts = append(opts, WithDrainTimeout(cfg.DrainTimeout))
+	}
+	return opts
+}
+
 // Consume begins consuming messages from the specified queue.
-// It blocks until the context is cancelled.
+// It blocks until the context is cancelled and in-flight handlers have drained.
 // It handles channel recreation and connection recovery automatically.
 func (c *Consumer) Consume(ctx context.Context, queueName string, handler Handler, opts ...Option) {
 	// Apply options
@@ -107,6 +217,12 @@ func (c *Consumer) Consume(ctx context.Context, queueName string, handler Handle
 	for _, opt := range opts {
 		opt(&cfg)
 	}
+	if cfg.Workers < 1 {
+		cfg.Workers = 1
+	}
+	if cfg.QosPrefetchCount < cfg.Workers {
+		cfg.QosPrefetchCount = cfg.Workers
+	}
 
 	for {
 		select {
@@ -116,13 +232,19 @@ func (c *Consumer) Consume(ctx context.Context, queueName string, handler Handle
 			// Attempt to consume
 			if err := c.consume(ctx, queueName, handler, cfg); err != nil {
 				log.Error().Err(err).Str("queue", queueName).Msg("Consumer encountered an error")
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(reconnectDelay):
+				}
 			}
 		}
 	}
 }
 
-// consume establishes a channel and consumes messages.
-// It returns when the channel is closed or context is cancelled.
+// consume establishes a channel and consumes messages through a worker pool.
+// It returns when the channel is closed or context is cancelled, after draining the pool.
 func (c *Consumer) consume(ctx context.Context, queueName string, handler Handler, cfg options) error {
 	conn := c.rmq.Connection()
 	if conn == nil || conn.IsClosed() {
@@ -140,9 +262,21 @@ func (c *Consumer) consume(ctx context.Context, queueName string, handler Handle
 		return err
 	}
 
+	if !cfg.AutoAck {
+		if err := declareRetryTopology(ch, queueName, cfg); err != nil {
+			return err
+		}
+		// Retries and dead letters are published on this channel and must be confirmed
+		// before the original delivery is acked.
+		if err := ch.Confirm(false); err != nil {
+			return err
+		}
+	}
+
+	tag := queueName + "-" + uuid.NewString()
 	msgs, err := ch.Consume(
 		queueName,     // queue
-		"",            // consumer
+		tag,           // consumer
 		cfg.AutoAck,   // auto-ack
 		cfg.Exclusive, // exclusive
 		cfg.NoLocal,   // no-local
@@ -153,31 +287,145 @@ func (c *Consumer) consume(ctx context.Context, queueName string, handler Handle
 		return err
 	}
 
-	log.Info().Str("queue", queueName).Msg("Consumer started")
+	log.Info().Str("queue", queueName).Int("workers", cfg.Workers).Msg("Consumer started")
+
+	// Handlers outlive ctx so in-flight deliveries can finish while draining.
+	handlerCtx, cancelHandlers := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelHandlers()
+
+	workers := newPool(cfg.Workers, cfg.Key, func(d amqp.Delivery) {
+		c.handle(handlerCtx, ch, queueName, d, handler, cfg)
+	})
+	drain := func() {
+		workers.close()
+		if !workers.wait(cfg.DrainTimeout) {
+			log.Warn().Str("queue", queueName).Dur("timeout", cfg.DrainTimeout).Msg("Consumer drain timed out, abandoning in-flight messages")
+			cancelHandlers()
+		}
+	}
 
 	for {
 		select {
 		case <-ctx.Done():
+			// Stop new deliveries first; prefetched ones are requeued when the channel closes.
+			_ = ch.Cancel(tag, false)
+			drain()
+			log.Info().Str("queue", queueName).Msg("Consumer stopped")
 			return nil
 		case d, ok := <-msgs:
 			if !ok {
 				log.Warn().Str("queue", queueName).Msg("Delivery channel closed")
+				drain()
 				return amqp.ErrClosed
 			}
 
-			// Process the message
-			if err := handler(ctx, d); err != nil {
-				log.Error().Err(err).Str("msg_id", d.MessageId).Msg("Failed to process message, nacking...")
-				// Requeue the message if processing failed and AutoAck is false
-				if !cfg.AutoAck {
-					_ = d.Nack(false, true)
-				}
-			} else {
-				// Ack if success and AutoAck is false
-				if !cfg.AutoAck {
-					_ = d.Ack(false)
-				}
+			if !workers.dispatch(ctx, d) && !cfg.AutoAck {
+				_ = d.Nack(false, true)
 			}
 		}
 	}
 }
+
+// handle runs the handler for one delivery within its consumer span and settles it.
+func (c *Consumer) handle(ctx context.Context, ch *amqp.Channel, queueName string, d amqp.Delivery, handler Handler, cfg options) {
+	ctx, span := startSpan(ctx, queueName, d)
+	ctx = handlerContext(ctx, queueName, d)
+
+	// Process the message
+	err := handler(ctx, d)
+	defer endSpan(span, err)
+	if cfg.AutoAck {
+		return
+	}
+	if err != nil {
+		c.fail(ctx, ch, queueName, d, err, cfg)
+		return
+	}
+	_ = d.Ack(false)
+	record(ctx, queueName, outcomeAcked)
+}
+
+// fail settles a delivery whose handler returned err: it is parked in a retry queue,
+// dead-lettered, or rejected depending on its attempts and the error.
+// If the retry or dead letter cannot be published the delivery is requeued instead.
+func (c *Consumer) fail(ctx context.Context, ch *amqp.Channel, queueName string, d amqp.Delivery, err error, cfg options) {
+	attempt := attempts(d.Headers) + 1
+	logger := log.With().Err(err).Str("queue", queueName).Str("msg_id", d.MessageId).Int("attempt", attempt).Logger()
+
+	o := decide(err, attempt, cfg)
+	var target string
+	switch o {
+	case outcomeRetried:
+		target = RetryQueue(queueName, retryDelay(cfg.RetryDelays, attempt))
+	case outcomeDeadLettered:
+		target = DeadLetterQueue(queueName)
+	default:
+		logger.Error().Msg("Failed to process message, rejecting...")
+		_ = d.Nack(false, false)
+		record(ctx, queueName, outcomeRejected)
+		return
+	}
+
+	if perr := publishConfirmed(ctx, ch, target, republishing(d, queueName, attempt, err)); perr != nil {
+		logger.Error().AnErr("publish_error", perr).Str("target", target).Msg("Failed to process message and to park it, requeueing...")
+		_ = d.Nack(false, true)
+		record(ctx, queueName, outcomeRequeued)
+		return
+	}
+
+	if o == outcomeRetried {
+		logger.Warn().Str("target", target).Msg("Failed to process message, retrying later...")
+	} else {
+		logger.Error().Str("target", target).Msg("Failed to process message, dead-lettering...")
+	}
+	_ = d.Ack(false)
+	record(ctx, queueName, o)
+}
+
+var errNacked = errors.New("consumer: retry or dead letter nacked by broker")
+
+// publishConfirmed publishes msg to queue through the default exchange and waits for the broker confirm.
+func publishConfirmed(ctx context.Context, ch *amqp.Channel, queue string, msg amqp.Publishing) error {
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx, "", queue, true, false, msg)
+	if err != nil {
+		return err
+	}
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !acked {
+		return errNacked
+	}
+	return nil
+}
+
+// declareRetryTopology declares one TTL retry queue per distinct delay, each dead-lettering
+// back to queueName through the default exchange, and the dead-letter queue.
+func declareRetryTopology(ch *amqp.Channel, queueName string, cfg options) error {
+	if cfg.MaxAttempts > 1 {
+		seen := map[time.Duration]bool{}
+		for _, delay := range cfg.RetryDelays {
+			if seen[delay] {
+				continue
+			}
+			seen[delay] = true
+
+			if _, err := ch.QueueDeclare(RetryQueue(queueName, delay), true, false, false, false, amqp.Table{
+				"x-message-ttl":             delay.Milliseconds(),
+				"x-dead-letter-exchange":    "",
+				"x-dead-letter-routing-key": queueName,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if cfg.DeadLetter {
+		if _, err := ch.QueueDeclare(DeadLetterQueue(queueName), true, false, false, false, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
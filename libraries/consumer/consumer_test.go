@@ -0,0 +1,55 @@
+package consumer
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFromConfig(t *testing.T) {
+	apply := func(opts []Option) options {
+		o := defaultOptions()
+		for _, opt := range opts {
+			opt(&o)
+		}
+		return o
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		if opts := FromConfig(Config{}); len(opts) != 0 {
+			t.Errorf("expected no options, got %d", len(opts))
+		}
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		disabled := false
+		o := apply(FromConfig(Config{
+			QosPrefetchCount: 10,
+			MaxAttempts:      3,
+			RetryDelays:      []time.Duration{time.Second},
+			DeadLetter:       &disabled,
+			Workers:          4,
+			DrainTimeout:     time.Minute,
+		}))
+		if o.QosPrefetchCount != 10 || o.MaxAttempts != 3 || o.DeadLetter || o.Workers != 4 || o.DrainTimeout != time.Minute {
+			t.Errorf("expected the configured values, got %+v", o)
+		}
+		if !reflect.DeepEqual(o.RetryDelays, []time.Duration{time.Second}) {
+			t.Errorf("expected the configured delays, got %v", o.RetryDelays)
+		}
+	})
+
+	t.Run("DelaysOnly", func(t *testing.T) {
+		o := apply(FromConfig(Config{RetryDelays: []time.Duration{time.Second}}))
+		if o.MaxAttempts != DefaultMaxAttempts || len(o.RetryDelays) != 1 {
+			t.Errorf("expected the default attempts with the configured delays, got %+v", o)
+		}
+	})
+
+	t.Run("RegistrationOverrides", func(t *testing.T) {
+		o := apply(append(FromConfig(Config{Workers: 4}), WithWorkers(2)))
+		if o.Workers != 2 {
+			t.Errorf("expected the registration option to win, got %d", o.Workers)
+		}
+	})
+}
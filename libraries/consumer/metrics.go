@@ -0,0 +1,27 @@
+package consumer
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// messages counts handled deliveries by queue and outcome.
+// It records through the global MeterProvider and is a no-op until one is installed.
+var messages, _ = otel.Meter("github.com/siakup/morgan-be/libraries/consumer").Int64Counter(
+	"messaging.consumer.messages",
+	metric.WithDescription("Deliveries handled by the consumer, by outcome (acked, requeued, retried, dead_lettered, rejected)."),
+	metric.WithUnit("{message}"),
+)
+
+// record counts the outcome and tags the delivery's span with it.
+func record(ctx context.Context, queue string, o outcome) {
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("messaging.outcome", string(o)))
+	messages.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("messaging.destination.name", queue),
+		attribute.String("outcome", string(o)),
+	))
+}
@@ -0,0 +1,86 @@
+package consumer
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/fx"
+)
+
+// Group is the Fx value group consumer registrations are provided into.
+const Group = `group:"consumers"`
+
+// Registration binds a handler to a queue. Feature modules contribute them with Provide.
+type Registration struct {
+	Queue   string
+	Handler Handler
+	Options []Option
+}
+
+// Provide annotates a constructor returning Registration so that it joins the "consumers" group.
+func Provide(constructor any) fx.Option {
+	return fx.Provide(fx.Annotate(constructor, fx.ResultTags(Group)))
+}
+
+// StartParams holds the dependencies for starting the registered consumers.
+type StartParams struct {
+	fx.In
+
+	Lifecycle     fx.Lifecycle
+	Consumer      *Consumer
+	Config        *Config        `optional:"true"`
+	Registrations []Registration `group:"consumers"`
+}
+
+// Module provides the Consumer and runs every registered consumer for the lifetime of the app.
+// A *Config in the graph sets the defaults of every registration.
+// On stop, consumers stop receiving and drain in-flight handlers before the connection closes.
+// It requires bunnymq.Module.
+var Module = fx.Options(
+	fx.Provide(New),
+	fx.Invoke(start),
+)
+
+func start(params StartParams) {
+	if len(params.Registrations) == 0 {
+		return
+	}
+
+	var defaults []Option
+	if params.Config != nil {
+		defaults = FromConfig(*params.Config)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+
+	params.Lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			for _, r := range params.Registrations {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					opts := append(append([]Option{}, defaults...), r.Options...)
+					params.Consumer.Consume(ctx, r.Queue, r.Handler, opts...)
+				}()
+			}
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package consumer
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// KeyFunc extracts the ordering key of a delivery. Deliveries with the same key are
+// handled one at a time in the order they were received.
+type KeyFunc func(d amqp.Delivery) string
+
+// ByHeader returns a KeyFunc reading the ordering key from the named header.
+// Deliveries without the header share the empty key.
+func ByHeader(name string) KeyFunc {
+	return func(d amqp.Delivery) string {
+		if v, ok := d.Headers[name].(string); ok {
+			return v
+		}
+		return ""
+	}
+}
+
+// pool runs a fixed number of workers over the deliveries of one channel.
+// Without a KeyFunc all workers share one queue; with one, each key is pinned to a worker.
+type pool struct {
+	queues []chan amqp.Delivery
+	key    KeyFunc
+	wg     sync.WaitGroup
+}
+
+func newPool(workers int, key KeyFunc, handle func(amqp.Delivery)) *pool {
+	if workers < 1 {
+		workers = 1
+	}
+
+	p := &pool{key: key}
+	if key == nil {
+		p.queues = []chan amqp.Delivery{make(chan amqp.Delivery)}
+	} else {
+		p.queues = make([]chan amqp.Delivery, workers)
+		for i := range p.queues {
+			p.queues[i] = make(chan amqp.Delivery)
+		}
+	}
+
+	for i := 0; i < workers; i++ {
+		q := p.queues[i%len(p.queues)]
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			for d := range q {
+				handle(d)
+			}
+		}()
+	}
+
+	return p
+}
+
+// dispatch hands d to a worker, blocking while the target worker is busy.
+// It returns false if ctx is cancelled before a worker accepted the delivery.
+func (p *pool) dispatch(ctx context.Context, d amqp.Delivery) bool {
+	q := p.queues[0]
+	if len(p.queues) > 1 {
+		q = p.queues[shard(p.key(d), len(p.queues))]
+	}
+
+	select {
+	case q <- d:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// close stops accepting deliveries; workers exit after finishing their current one.
+func (p *pool) close() {
+	for _, q := range p.queues {
+		close(q)
+	}
+}
+
+// wait blocks until every worker has exited or timeout elapses, reporting whether they all exited.
+func (p *pool) wait(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+func shard(key string, n int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(n))
+}
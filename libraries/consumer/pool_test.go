@@ -0,0 +1,100 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+func TestPool_OrderingKey(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[string][]int{}
+
+	p := newPool(4, ByHeader("key"), func(d amqp.Delivery) {
+		// Yield so deliveries of other keys interleave.
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		key := d.Headers["key"].(string)
+		seen[key] = append(seen[key], int(d.DeliveryTag))
+	})
+
+	for i := 0; i < 40; i++ {
+		d := amqp.Delivery{
+			Headers:     amqp.Table{"key": fmt.Sprintf("k%d", i%5)},
+			DeliveryTag: uint64(i),
+		}
+		if !p.dispatch(context.Background(), d) {
+			t.Fatal("expected dispatch to succeed")
+		}
+	}
+	p.close()
+	if !p.wait(time.Second) {
+		t.Fatal("expected workers to drain")
+	}
+
+	for key, tags := range seen {
+		if len(tags) != 8 {
+			t.Errorf("key %s: expected 8 deliveries, got %d", key, len(tags))
+		}
+		for i := 1; i < len(tags); i++ {
+			if tags[i] < tags[i-1] {
+				t.Errorf("key %s: deliveries out of order: %v", key, tags)
+				break
+			}
+		}
+	}
+}
+
+func TestPool_Concurrency(t *testing.T) {
+	release := make(chan struct{})
+	var running sync.WaitGroup
+	running.Add(3)
+
+	p := newPool(3, nil, func(amqp.Delivery) {
+		running.Done()
+		<-release
+	})
+	for i := 0; i < 3; i++ {
+		p.dispatch(context.Background(), amqp.Delivery{})
+	}
+
+	// All three handlers must be running at once for this to return.
+	running.Wait()
+	close(release)
+	p.close()
+	if !p.wait(time.Second) {
+		t.Fatal("expected workers to drain")
+	}
+}
+
+func TestPool_DrainTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	p := newPool(1, nil, func(amqp.Delivery) { <-release })
+	p.dispatch(context.Background(), amqp.Delivery{})
+	p.close()
+
+	if p.wait(10 * time.Millisecond) {
+		t.Error("expected wait to time out while a handler is in flight")
+	}
+}
+
+func TestPool_DispatchCancelled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	p := newPool(1, nil, func(amqp.Delivery) { <-release })
+	p.dispatch(context.Background(), amqp.Delivery{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if p.dispatch(ctx, amqp.Delivery{}) {
+		t.Error("expected dispatch to give up once the context is cancelled")
+	}
+}
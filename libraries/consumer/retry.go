@@ -0,0 +1,152 @@
+package consumer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Headers used to track delivery attempts across retry queues.
+const (
+	HeaderAttempts           = "x-attempts"
+	HeaderLastError          = "x-last-error"
+	HeaderFailedAt           = "x-failed-at"
+	HeaderOriginalQueue      = "x-original-queue"
+	HeaderOriginalExchange   = "x-original-exchange"
+	HeaderOriginalRoutingKey = "x-original-routing-key"
+)
+
+// DefaultMaxAttempts is the number of deliveries (first attempt included) before a message is dead-lettered.
+const DefaultMaxAttempts = 5
+
+// DefaultRetryDelays are the delays applied to the first, second, ... retry; the last one is reused.
+var DefaultRetryDelays = []time.Duration{time.Second, 10 * time.Second, time.Minute}
+
+// RetryQueue returns the name of the delay queue holding retries of queue for delay.
+// Messages expire from it after delay and are dead-lettered back to queue.
+func RetryQueue(queue string, delay time.Duration) string {
+	return fmt.Sprintf("%s.retry.%s", queue, delay)
+}
+
+// DeadLetterQueue returns the name of the queue receiving exhausted or non-retryable messages of queue.
+func DeadLetterQueue(queue string) string {
+	return queue + ".dlq"
+}
+
+// nonRetryableError marks an error that must not be retried.
+type nonRetryableError struct {
+	err error
+}
+
+func (e *nonRetryableError) Error() string { return e.err.Error() }
+
+func (e *nonRetryableError) Unwrap() error { return e.err }
+
+// NonRetryable wraps err so the consumer dead-letters the message immediately instead of retrying it.
+// Use it for poison messages, e.g. payloads that cannot be decoded.
+func NonRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &nonRetryableError{err: err}
+}
+
+// IsNonRetryable reports whether err, or any error it wraps, was marked with NonRetryable.
+func IsNonRetryable(err error) bool {
+	var target *nonRetryableError
+	return errors.As(err, &target)
+}
+
+// outcome is what the consumer does with a delivery after the handler returns.
+type outcome string
+
+const (
+	outcomeAcked        outcome = "acked"
+	outcomeRequeued     outcome = "requeued"
+	outcomeRetried      outcome = "retried"
+	outcomeDeadLettered outcome = "dead_lettered"
+	outcomeRejected     outcome = "rejected"
+)
+
+// decide returns the outcome of a failed delivery on its attempt-th attempt.
+func decide(err error, attempt int, cfg options) outcome {
+	if IsNonRetryable(err) || attempt >= cfg.MaxAttempts {
+		if cfg.DeadLetter {
+			return outcomeDeadLettered
+		}
+		return outcomeRejected
+	}
+	return outcomeRetried
+}
+
+// attempts returns how many times the delivery has already been attempted.
+func attempts(headers amqp.Table) int {
+	switch v := headers[HeaderAttempts].(type) {
+	case int:
+		return v
+	case int8:
+		return int(v)
+	case int16:
+		return int(v)
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case uint8:
+		return int(v)
+	case uint16:
+		return int(v)
+	case uint32:
+		return int(v)
+	default:
+		return 0
+	}
+}
+
+// retryDelay returns the delay applied after the attempt-th failed attempt.
+func retryDelay(delays []time.Duration, attempt int) time.Duration {
+	if len(delays) == 0 {
+		return 0
+	}
+	if attempt < 1 {
+		attempt = 1
+	}
+	if attempt > len(delays) {
+		return delays[len(delays)-1]
+	}
+	return delays[attempt-1]
+}
+
+// republishing copies d for publishing to a retry or dead-letter queue, recording the attempt and error in its headers.
+func republishing(d amqp.Delivery, queue string, attempt int, cause error) amqp.Publishing {
+	headers := amqp.Table{}
+	for k, v := range d.Headers {
+		headers[k] = v
+	}
+	headers[HeaderAttempts] = int32(attempt)
+	headers[HeaderLastError] = cause.Error()
+	headers[HeaderFailedAt] = time.Now().UTC().Format(time.RFC3339)
+	if _, ok := headers[HeaderOriginalQueue]; !ok {
+		headers[HeaderOriginalQueue] = queue
+		headers[HeaderOriginalExchange] = d.Exchange
+		headers[HeaderOriginalRoutingKey] = d.RoutingKey
+	}
+
+	return amqp.Publishing{
+		Headers:         headers,
+		ContentType:     d.ContentType,
+		ContentEncoding: d.ContentEncoding,
+		DeliveryMode:    amqp.Persistent,
+		Priority:        d.Priority,
+		CorrelationId:   d.CorrelationId,
+		ReplyTo:         d.ReplyTo,
+		MessageId:       d.MessageId,
+		Timestamp:       d.Timestamp,
+		Type:            d.Type,
+		UserId:          d.UserId,
+		AppId:           d.AppId,
+		Body:            d.Body,
+	}
+}
@@ -0,0 +1,140 @@
+package consumer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+func TestNonRetryable(t *testing.T) {
+	base := errors.New("bad payload")
+
+	t.Run("Wrapped", func(t *testing.T) {
+		err := fmt.Errorf("decode: %w", NonRetryable(base))
+		if !IsNonRetryable(err) {
+			t.Error("expected wrapped error to be non-retryable")
+		}
+		if !errors.Is(err, base) {
+			t.Error("expected the original error to stay reachable")
+		}
+	})
+
+	t.Run("Plain", func(t *testing.T) {
+		if IsNonRetryable(base) {
+			t.Error("expected plain error to be retryable")
+		}
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		if NonRetryable(nil) != nil {
+			t.Error("expected nil")
+		}
+	})
+}
+
+func TestAttempts(t *testing.T) {
+	cases := []struct {
+		name    string
+		headers amqp.Table
+		want    int
+	}{
+		{"Missing", nil, 0},
+		{"Int32", amqp.Table{HeaderAttempts: int32(2)}, 2},
+		{"Int64", amqp.Table{HeaderAttempts: int64(3)}, 3},
+		{"Int", amqp.Table{HeaderAttempts: 4}, 4},
+		{"Wrong Type", amqp.Table{HeaderAttempts: "5"}, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := attempts(tc.headers); got != tc.want {
+				t.Errorf("expected %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	delays := []time.Duration{time.Second, 10 * time.Second}
+
+	if got := retryDelay(delays, 1); got != time.Second {
+		t.Errorf("expected 1s, got %s", got)
+	}
+	if got := retryDelay(delays, 2); got != 10*time.Second {
+		t.Errorf("expected 10s, got %s", got)
+	}
+	if got := retryDelay(delays, 7); got != 10*time.Second {
+		t.Errorf("expected last delay to be reused, got %s", got)
+	}
+	if got := retryDelay(nil, 1); got != 0 {
+		t.Errorf("expected 0, got %s", got)
+	}
+}
+
+func TestDecide(t *testing.T) {
+	cfg := defaultOptions()
+	cfg.MaxAttempts = 3
+	failure := errors.New("timeout")
+
+	if got := decide(failure, 1, cfg); got != outcomeRetried {
+		t.Errorf("expected retried, got %s", got)
+	}
+	if got := decide(failure, 3, cfg); got != outcomeDeadLettered {
+		t.Errorf("expected dead_lettered after max attempts, got %s", got)
+	}
+	if got := decide(NonRetryable(failure), 1, cfg); got != outcomeDeadLettered {
+		t.Errorf("expected non-retryable error to be dead-lettered, got %s", got)
+	}
+
+	cfg.DeadLetter = false
+	if got := decide(failure, 3, cfg); got != outcomeRejected {
+		t.Errorf("expected rejected without dead-letter queue, got %s", got)
+	}
+}
+
+func TestRepublishing(t *testing.T) {
+	d := amqp.Delivery{
+		Headers:    amqp.Table{"trace": "abc"},
+		Exchange:   "morgan.events",
+		RoutingKey: "role.created",
+		MessageId:  "msg-1",
+		Body:       []byte("{}"),
+	}
+
+	msg := republishing(d, "iam", 2, errors.New("boom"))
+	if msg.Headers[HeaderAttempts] != int32(2) {
+		t.Errorf("expected attempts 2, got %v", msg.Headers[HeaderAttempts])
+	}
+	if msg.Headers[HeaderLastError] != "boom" {
+		t.Errorf("expected last error 'boom', got %v", msg.Headers[HeaderLastError])
+	}
+	if msg.Headers[HeaderOriginalRoutingKey] != "role.created" || msg.Headers[HeaderOriginalQueue] != "iam" {
+		t.Errorf("expected original routing to be recorded, got %v", msg.Headers)
+	}
+	if msg.Headers["trace"] != "abc" || msg.MessageId != "msg-1" {
+		t.Error("expected original headers and properties to be kept")
+	}
+	if _, ok := d.Headers[HeaderAttempts]; ok {
+		t.Error("expected delivery headers to be left untouched")
+	}
+
+	// A retried message already carries its origin; it must not be overwritten by the retry queue hop.
+	d.Headers = msg.Headers
+	d.Exchange, d.RoutingKey = "", "iam"
+	again := republishing(d, "iam", 3, errors.New("boom"))
+	if again.Headers[HeaderOriginalRoutingKey] != "role.created" {
+		t.Errorf("expected original routing key to be kept, got %v", again.Headers[HeaderOriginalRoutingKey])
+	}
+}
+
+func TestQueueNames(t *testing.T) {
+	if got := RetryQueue("iam", 10*time.Second); got != "iam.retry.10s" {
+		t.Errorf("unexpected retry queue %q", got)
+	}
+	if got := DeadLetterQueue("iam"); got != "iam.dlq" {
+		t.Errorf("unexpected dead-letter queue %q", got)
+	}
+}
@@ -0,0 +1,63 @@
+package consumer
+
+import (
+	"context"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/rs/zerolog/log"
+	"github.com/siakup/morgan-be/libraries/helper"
+	"github.com/siakup/morgan-be/libraries/publisher"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/siakup/morgan-be/libraries/consumer")
+
+// startSpan starts the consumer span of a delivery, continuing the trace context found in its headers.
+func startSpan(ctx context.Context, queueName string, d amqp.Delivery) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, publisher.HeaderCarrier(d.Headers))
+
+	return tracer.Start(ctx, "process "+queueName,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemRabbitMQ,
+			semconv.MessagingOperationTypeProcess,
+			semconv.MessagingOperationName("process"),
+			semconv.MessagingDestinationName(queueName),
+			semconv.MessagingRabbitMQDestinationRoutingKey(d.RoutingKey),
+			semconv.MessagingMessageID(d.MessageId),
+			semconv.MessagingMessageConversationID(d.CorrelationId),
+			semconv.MessagingMessageBodySize(len(d.Body)),
+			semconv.MessagingRabbitMQMessageDeliveryTag(int(d.DeliveryTag)),
+		),
+	)
+}
+
+// handlerContext attaches the trace ID and a zerolog logger carrying it, the queue and the
+// message ID to ctx, so handlers log and audit like HTTP requests do. The trace ID is the
+// OpenTelemetry trace of the span, or the correlation ID when no trace is available.
+func handlerContext(ctx context.Context, queueName string, d amqp.Delivery) context.Context {
+	traceId := d.CorrelationId
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		traceId = sc.TraceID().String()
+	}
+
+	logger := log.With().Str("queue", queueName).Str("msg_id", d.MessageId)
+	if traceId != "" {
+		logger = logger.Str("trace_id", traceId)
+		ctx = helper.WithTraceID(ctx, traceId)
+	}
+
+	l := logger.Logger()
+	return l.WithContext(ctx)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
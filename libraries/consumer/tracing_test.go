@@ -0,0 +1,74 @@
+package consumer
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/helper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestStartSpan_ContinuesPublisherTrace(t *testing.T) {
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(previous)
+
+	d := amqp.Delivery{
+		Headers:   amqp.Table{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		MessageId: "msg-1",
+	}
+	ctx, span := startSpan(context.Background(), "iam", d)
+	defer span.End()
+
+	if got := trace.SpanContextFromContext(ctx).TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("expected the publisher trace to be continued, got %s", got)
+	}
+}
+
+func TestHandlerContext(t *testing.T) {
+	t.Run("Trace From Span", func(t *testing.T) {
+		traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceId,
+			SpanID:  spanId,
+		}))
+
+		ctx = handlerContext(ctx, "iam", amqp.Delivery{MessageId: "msg-1", CorrelationId: "corr-1"})
+
+		if got := helper.GetTraceID(ctx); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+			t.Errorf("expected trace id from span, got %s", got)
+		}
+		assertLogged(t, ctx, `"trace_id":"4bf92f3577b34da6a3ce929d0e0e4736"`, `"queue":"iam"`, `"msg_id":"msg-1"`)
+	})
+
+	t.Run("Trace From Correlation Id", func(t *testing.T) {
+		ctx := handlerContext(context.Background(), "iam", amqp.Delivery{MessageId: "msg-1", CorrelationId: "corr-1"})
+
+		if got := helper.GetTraceID(ctx); got != "corr-1" {
+			t.Errorf("expected trace id from correlation id, got %s", got)
+		}
+		assertLogged(t, ctx, `"trace_id":"corr-1"`)
+	})
+}
+
+// assertLogged logs through the context logger and checks the output contains every field.
+func assertLogged(t *testing.T, ctx context.Context, fields ...string) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	logger := zerolog.Ctx(ctx).Output(&buf)
+	logger.Info().Msg("handled")
+
+	for _, field := range fields {
+		if !strings.Contains(buf.String(), field) {
+			t.Errorf("expected log to contain %s, got %s", field, buf.String())
+		}
+	}
+}
@@ -3,6 +3,7 @@ package errors
 import (
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // ErrorType defines the type of error for categorization.
@@ -13,15 +14,26 @@ const (
 	ErrorTypeNotFound     ErrorType = "NOT_FOUND"
 	ErrorTypeSystem       ErrorType = "SYSTEM_ERROR"
 	ErrorTypeUnauthorized ErrorType = "UNAUTHORIZED_ERROR"
+	ErrorTypeForbidden    ErrorType = "FORBIDDEN_ERROR"
 	ErrorTypeConflict     ErrorType = "CONFLICT_ERROR"
+	ErrorTypeRateLimited  ErrorType = "RATE_LIMITED"
 )
 
 // AppError represents a standardized application error.
 type AppError struct {
-	Type    ErrorType `json:"type"`
-	Message string    `json:"message"`
-	Code    int       `json:"code"`
-	Err     error     `json:"-"`
+	Type    ErrorType        `json:"type"`
+	Message string           `json:"message"`
+	Code    int              `json:"code"`
+	Fields  []FieldViolation `json:"fields,omitempty"`
+	Err     error            `json:"-"`
+}
+
+// FieldViolation describes why one field of a request was rejected.
+// Field is the JSON path of the field (e.g. "assignee.id") and Rule the failed validation rule.
+type FieldViolation struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
 }
 
 func (e *AppError) Error() string {
@@ -35,6 +47,12 @@ func (e *AppError) Unwrap() error {
 	return e.Err
 }
 
+// WithFields attaches field violations to the error and returns it.
+func (e *AppError) WithFields(fields ...FieldViolation) *AppError {
+	e.Fields = append(e.Fields, fields...)
+	return e
+}
+
 // New creates a new AppError.
 func New(errType ErrorType, code int, message string, err error) *AppError {
 	return &AppError{
@@ -65,15 +83,53 @@ func Unauthorized(message string) *AppError {
 	return New(ErrorTypeUnauthorized, http.StatusUnauthorized, message, nil)
 }
 
+// Forbidden creates a new forbidden error (HTTP 403).
+func Forbidden(message string) *AppError {
+	return New(ErrorTypeForbidden, http.StatusForbidden, message, nil)
+}
+
 // Conflict creates a new conflict error (HTTP 409).
 func Conflict(message string) *AppError {
 	return New(ErrorTypeConflict, http.StatusConflict, message, nil)
 }
 
+// TooManyRequests creates a new rate limit error (HTTP 429).
+func TooManyRequests(message string) *AppError {
+	return New(ErrorTypeRateLimited, http.StatusTooManyRequests, message, nil)
+}
+
 // Wrap adds context to an existing error explicitly, maintaining the original error code if possible.
 func Wrap(err error, message string) *AppError {
 	if appErr, ok := err.(*AppError); ok {
-		return New(appErr.Type, appErr.Code, fmt.Sprintf("%s: %s", message, appErr.Message), appErr.Err)
+		return New(appErr.Type, appErr.Code, fmt.Sprintf("%s: %s", message, appErr.Message), appErr.Err).WithFields(appErr.Fields...)
 	}
 	return New(ErrorTypeSystem, http.StatusInternalServerError, message, err)
 }
+
+// FromStatus creates an AppError for an HTTP status code, typed after the status.
+// Statuses without an ErrorType use their status text, e.g. "METHOD_NOT_ALLOWED".
+func FromStatus(code int, message string) *AppError {
+	return New(TypeOf(code), code, message, nil)
+}
+
+// TypeOf returns the ErrorType of an HTTP status code.
+func TypeOf(code int) ErrorType {
+	switch {
+	case code == http.StatusBadRequest, code == http.StatusUnprocessableEntity:
+		return ErrorTypeValidation
+	case code == http.StatusUnauthorized:
+		return ErrorTypeUnauthorized
+	case code == http.StatusForbidden:
+		return ErrorTypeForbidden
+	case code == http.StatusNotFound:
+		return ErrorTypeNotFound
+	case code == http.StatusConflict:
+		return ErrorTypeConflict
+	case code == http.StatusTooManyRequests:
+		return ErrorTypeRateLimited
+	case code >= http.StatusInternalServerError || http.StatusText(code) == "":
+		return ErrorTypeSystem
+	default:
+		return ErrorType(strings.ToUpper(strings.ReplaceAll(http.StatusText(code), " ", "_")))
+	}
+}
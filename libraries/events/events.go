@@ -0,0 +1,130 @@
+// Package events defines the versioned JSON envelope and the typed domain events published by Morgan.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/siakup/morgan-be/libraries/helper"
+	"github.com/siakup/morgan-be/libraries/publisher"
+)
+
+const (
+	// Exchange is the topic exchange domain events are published to. The routing key is the event type.
+	Exchange = "morgan.events"
+
+	// Source identifies this service as the producer of an event.
+	Source = "morgan"
+
+	// ContentType of the published body.
+	ContentType = "application/json"
+)
+
+// Payload is implemented by every typed event body.
+// Type is the routing key (e.g. "role.created"); Version is bumped on breaking changes of the payload.
+// Key names the aggregate (e.g. "role:<id>"); events with the same key are delivered in order.
+type Payload interface {
+	Type() string
+	Version() int
+	Key() string
+}
+
+// Envelope is the JSON document published for every event.
+type Envelope[T Payload] struct {
+	Id            string    `json:"id"`
+	Type          string    `json:"type"`
+	Version       int       `json:"version"`
+	Source        string    `json:"source"`
+	OccurredAt    time.Time `json:"occurred_at"`
+	InstitutionId string    `json:"institution_id"`
+	ActorId       string    `json:"actor_id,omitempty"`
+	TraceId       string    `json:"trace_id,omitempty"`
+	Data          T         `json:"data"`
+}
+
+// Event is a typed domain event ready to be published. It implements publisher.PropertiesEvent.
+type Event[T Payload] struct {
+	Envelope[T]
+	body []byte
+}
+
+var _ publisher.PropertiesEvent = (*Event[RoleCreated])(nil)
+
+// New wraps the payload in an envelope. Actor and trace ID are read from the context.
+func New[T Payload](ctx context.Context, institutionId string, data T) (*Event[T], error) {
+	envelope := Envelope[T]{
+		Id:            uuid.NewString(),
+		Type:          data.Type(),
+		Version:       data.Version(),
+		Source:        Source,
+		OccurredAt:    time.Now().UTC(),
+		InstitutionId: institutionId,
+		ActorId:       helper.GetActor(ctx).UserId,
+		TraceId:       helper.GetTraceID(ctx),
+		Data:          data,
+	}
+
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s event: %w", envelope.Type, err)
+	}
+
+	return &Event[T]{Envelope: envelope, body: body}, nil
+}
+
+// Decode parses a published body into a typed event.
+// It fails when the type or version does not match T, so consumers never read a payload they do not understand.
+func Decode[T Payload](body []byte) (*Event[T], error) {
+	var envelope Envelope[T]
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("unmarshal event: %w", err)
+	}
+
+	var zero T
+	if envelope.Type != zero.Type() {
+		return nil, fmt.Errorf("unexpected event type %q, want %q", envelope.Type, zero.Type())
+	}
+	if envelope.Version != zero.Version() {
+		return nil, fmt.Errorf("unsupported %s event version %d, want %d", envelope.Type, envelope.Version, zero.Version())
+	}
+
+	return &Event[T]{Envelope: envelope, body: body}, nil
+}
+
+func (e *Event[T]) Exchange() string    { return Exchange }
+func (e *Event[T]) Topic() string       { return e.Type }
+func (e *Event[T]) MessageId() string   { return e.Id }
+func (e *Event[T]) ContentType() string { return ContentType }
+func (e *Event[T]) Body() []byte        { return e.body }
+
+// Properties correlates the message with the request that produced it. Domain events are
+// mandatory: a confirmed publish fails instead of silently dropping an event no queue is bound to.
+func (e *Event[T]) Properties() publisher.Properties {
+	return publisher.Properties{
+		CorrelationId: e.TraceId,
+		Timestamp:     e.OccurredAt,
+		Mandatory:     true,
+	}
+}
+
+// Key returns the aggregate key of the payload. It is used by the outbox to order delivery.
+func (e *Event[T]) Key() string { return e.Data.Key() }
+
+// Publisher publishes events. *publisher.Publisher implements it.
+type Publisher interface {
+	Publish(ctx context.Context, event publisher.Event) error
+}
+
+// Emit wraps the payload and publishes it. Usecases call it inside the transaction of the
+// mutation with the outbox Writer, so an error rolls the mutation back.
+func Emit[T Payload](ctx context.Context, p Publisher, institutionId string, data T) error {
+	event, err := New(ctx, institutionId, data)
+	if err != nil {
+		return err
+	}
+
+	return p.Publish(ctx, event)
+}
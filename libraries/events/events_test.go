@@ -0,0 +1,126 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/siakup/morgan-be/libraries/helper"
+	"github.com/siakup/morgan-be/libraries/publisher"
+)
+
+type fakePublisher struct {
+	events []publisher.Event
+	err    error
+}
+
+func (f *fakePublisher) Publish(ctx context.Context, event publisher.Event) error {
+	f.events = append(f.events, event)
+	return f.err
+}
+
+func TestNew(t *testing.T) {
+	ctx := helper.WithActor(helper.WithTraceID(context.Background(), "trace-1"), helper.Actor{UserId: "u1", InstitutionId: "i1"})
+
+	event, err := New(ctx, "i1", UserStatusChanged{UserId: "u2", From: "active", To: "suspended"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if event.Exchange() != Exchange || event.Topic() != TypeUserStatusChanged || event.ContentType() != ContentType {
+		t.Errorf("unexpected routing: %s %s %s", event.Exchange(), event.Topic(), event.ContentType())
+	}
+	if event.MessageId() == "" || event.MessageId() != event.Id {
+		t.Errorf("expected message id to be the envelope id, got %q", event.MessageId())
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(event.Body(), &body); err != nil {
+		t.Fatalf("body is not JSON: %v", err)
+	}
+	for key, want := range map[string]any{
+		"type":           TypeUserStatusChanged,
+		"version":        float64(1),
+		"source":         Source,
+		"institution_id": "i1",
+		"actor_id":       "u1",
+		"trace_id":       "trace-1",
+	} {
+		if body[key] != want {
+			t.Errorf("expected %s %v, got %v", key, want, body[key])
+		}
+	}
+	if data, _ := body["data"].(map[string]any); data["to"] != "suspended" {
+		t.Errorf("unexpected data: %v", body["data"])
+	}
+
+	if props := event.Properties(); !props.Mandatory || props.CorrelationId != "trace-1" || !props.Timestamp.Equal(event.OccurredAt) {
+		t.Errorf("expected mandatory properties correlated with the trace, got %+v", props)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	event, err := New(context.Background(), "i1", RoleDeleted{RoleId: "r1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("Roundtrip", func(t *testing.T) {
+		decoded, err := Decode[RoleDeleted](event.Body())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if decoded.Data.RoleId != "r1" || decoded.Id != event.Id {
+			t.Errorf("unexpected decoded event: %+v", decoded.Envelope)
+		}
+	})
+
+	t.Run("WrongType", func(t *testing.T) {
+		if _, err := Decode[RoleCreated](event.Body()); err == nil {
+			t.Error("expected type mismatch error")
+		}
+	})
+
+	t.Run("WrongVersion", func(t *testing.T) {
+		body := []byte(`{"type":"role.deleted","version":2,"data":{"role_id":"r1"}}`)
+		if _, err := Decode[RoleDeleted](body); err == nil {
+			t.Error("expected version mismatch error")
+		}
+	})
+}
+
+func TestEmit(t *testing.T) {
+	t.Run("Publishes", func(t *testing.T) {
+		p := &fakePublisher{}
+		if err := Emit(context.Background(), p, "i1", RoleCreated{RoleId: "r1"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(p.events) != 1 || p.events[0].Topic() != TypeRoleCreated {
+			t.Fatalf("expected one role.created event, got %v", p.events)
+		}
+		if keyed, ok := p.events[0].(interface{ Key() string }); !ok || keyed.Key() != "role:r1" {
+			t.Errorf("expected aggregate key role:r1")
+		}
+	})
+
+	t.Run("ReturnsPublishError", func(t *testing.T) {
+		p := &fakePublisher{err: errors.New("outbox unavailable")}
+		if err := Emit(context.Background(), p, "i1", RoleCreated{RoleId: "r1"}); err == nil {
+			t.Fatal("expected publish error to be returned")
+		}
+	})
+}
+
+func TestPermissionDiff(t *testing.T) {
+	added, removed := PermissionDiff([]string{"a", "b"}, []string{"b", "c"})
+
+	if !reflect.DeepEqual(added, []string{"c"}) {
+		t.Errorf("expected added [c], got %v", added)
+	}
+	if !reflect.DeepEqual(removed, []string{"a"}) {
+		t.Errorf("expected removed [a], got %v", removed)
+	}
+}
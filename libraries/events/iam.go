@@ -0,0 +1,101 @@
+package events
+
+// Event types of the IAM domain.
+const (
+	TypeRoleCreated            = "role.created"
+	TypeRoleUpdated            = "role.updated"
+	TypeRolePermissionsChanged = "role.permissions_changed"
+	TypeRoleDeleted            = "role.deleted"
+	TypeUserStatusChanged      = "user.status_changed"
+	TypeUserRoleAssigned       = "user.role_assigned"
+)
+
+// RoleCreated is published when a role is created.
+type RoleCreated struct {
+	RoleId      string   `json:"role_id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	IsActive    bool     `json:"is_active"`
+	Permissions []string `json:"permissions"`
+}
+
+func (RoleCreated) Type() string  { return TypeRoleCreated }
+func (RoleCreated) Version() int  { return 1 }
+func (e RoleCreated) Key() string { return "role:" + e.RoleId }
+
+// RoleUpdated is published when the attributes of a role change.
+type RoleUpdated struct {
+	RoleId      string `json:"role_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsActive    bool   `json:"is_active"`
+}
+
+func (RoleUpdated) Type() string  { return TypeRoleUpdated }
+func (RoleUpdated) Version() int  { return 1 }
+func (e RoleUpdated) Key() string { return "role:" + e.RoleId }
+
+// RolePermissionsChanged is published when permissions are granted to or revoked from a role.
+type RolePermissionsChanged struct {
+	RoleId      string   `json:"role_id"`
+	Added       []string `json:"added"`
+	Removed     []string `json:"removed"`
+	Permissions []string `json:"permissions"`
+}
+
+func (RolePermissionsChanged) Type() string  { return TypeRolePermissionsChanged }
+func (RolePermissionsChanged) Version() int  { return 1 }
+func (e RolePermissionsChanged) Key() string { return "role:" + e.RoleId }
+
+// RoleDeleted is published when a role is deleted.
+type RoleDeleted struct {
+	RoleId string `json:"role_id"`
+}
+
+func (RoleDeleted) Type() string  { return TypeRoleDeleted }
+func (RoleDeleted) Version() int  { return 1 }
+func (e RoleDeleted) Key() string { return "role:" + e.RoleId }
+
+// UserStatusChanged is published when a user is activated, deactivated or suspended.
+type UserStatusChanged struct {
+	UserId string `json:"user_id"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+func (UserStatusChanged) Type() string  { return TypeUserStatusChanged }
+func (UserStatusChanged) Version() int  { return 1 }
+func (e UserStatusChanged) Key() string { return "user:" + e.UserId }
+
+// UserRoleAssigned is published when a role is assigned to a user.
+type UserRoleAssigned struct {
+	UserRoleId string `json:"user_role_id"`
+	UserId     string `json:"user_id"`
+	RoleId     string `json:"role_id"`
+	GroupId    string `json:"group_id"`
+}
+
+func (UserRoleAssigned) Type() string  { return TypeUserRoleAssigned }
+func (UserRoleAssigned) Version() int  { return 1 }
+func (e UserRoleAssigned) Key() string { return "user:" + e.UserId }
+
+// PermissionDiff returns the codes present only in after (added) and only in before (removed).
+func PermissionDiff(before, after []string) (added, removed []string) {
+	previous := make(map[string]struct{}, len(before))
+	for _, code := range before {
+		previous[code] = struct{}{}
+	}
+	current := make(map[string]struct{}, len(after))
+	for _, code := range after {
+		current[code] = struct{}{}
+		if _, ok := previous[code]; !ok {
+			added = append(added, code)
+		}
+	}
+	for _, code := range before {
+		if _, ok := current[code]; !ok {
+			removed = append(removed, code)
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,26 @@
+package events
+
+import (
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/siakup/morgan-be/framework/bunnymq"
+	"go.uber.org/fx"
+)
+
+// Topology declares the durable topic exchange events are published to.
+var Topology = bunnymq.Topology{
+	Exchanges: []bunnymq.Exchange{
+		{Name: Exchange, Kind: amqp.ExchangeTopic, Durable: true},
+	},
+}
+
+// Module declares the events exchange, again after every reconnect. It requires bunnymq.Module.
+// The Publisher itself is provided by outbox.Module.
+var Module = fx.Options(
+	fx.Invoke(declareTopology),
+)
+
+// declareTopology registers Topology with the connection manager.
+// Without a RabbitMQ connection nothing is declared and publishing fails until one is configured.
+func declareTopology(rmq *bunnymq.RabbitMQ) error {
+	return rmq.Declare(Topology)
+}
@@ -24,3 +24,27 @@ func GetTraceID(ctx context.Context) string {
 func WithTraceID(ctx context.Context, traceID string) context.Context {
 	return context.WithValue(ctx, traceIDKey, traceID)
 }
+
+type actorContextKey struct{}
+
+var actorKey = actorContextKey{}
+
+// Actor identifies the authenticated user performing a request.
+type Actor struct {
+	UserId        string
+	InstitutionId string
+}
+
+// WithActor returns a new context carrying the authenticated actor.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// GetActor retrieves the authenticated actor from the context.
+// The zero Actor is returned for unauthenticated or background contexts.
+func GetActor(ctx context.Context) Actor {
+	if val, ok := ctx.Value(actorKey).(Actor); ok {
+		return val
+	}
+	return Actor{}
+}
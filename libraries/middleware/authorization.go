@@ -11,7 +11,10 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
+	libErrors "github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/helper"
 	"github.com/siakup/morgan-be/libraries/idp"
+	"github.com/siakup/morgan-be/libraries/responses"
 )
 
 const (
@@ -47,14 +50,15 @@ func (a *AuthorizationMiddleware) Authenticate(scopes ...string) fiber.Handler {
 		logger := zerolog.Ctx(ctx).With().Str("component", "middleware.auth").Logger()
 
 		if authKey == "" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"message": "Unauthorized: Missing Token"})
+			return responses.HandleError(c, libErrors.Unauthorized("Unauthorized: Missing Token"))
 		}
 
 		auth, err := a.findFromCache(ctx, authKey)
+		recordCacheLookup(ctx, cacheSession, err)
 		if err != nil {
 			if !errors.Is(err, redis.Nil) {
 				logger.Error().Err(err).Msg("failed to get auth from cache")
-				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"message": "Unauthorized"})
+				return responses.HandleError(c, libErrors.Unauthorized("Unauthorized"))
 			}
 
 			auth, err = a.findFromDB(ctx, authKey)
@@ -62,12 +66,12 @@ func (a *AuthorizationMiddleware) Authenticate(scopes ...string) fiber.Handler {
 				if !errors.Is(err, pgx.ErrNoRows) {
 					logger.Error().Err(err).Msg("failed to get auth from db")
 				}
-				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"message": "Unauthorized"})
+				return responses.HandleError(c, libErrors.Unauthorized("Unauthorized"))
 			}
 
 			if auth.ExpiresAt.Before(time.Now()) {
 				logger.Info().Msg("token expired")
-				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"message": "Unauthorized"})
+				return responses.HandleError(c, libErrors.Unauthorized("Unauthorized"))
 			}
 
 			if err = a.putOnCache(ctx, auth); nil != err {
@@ -75,10 +79,22 @@ func (a *AuthorizationMiddleware) Authenticate(scopes ...string) fiber.Handler {
 			}
 		}
 
+		if feature, ok := c.Locals(XFeatureKey).(string); ok && feature != "" {
+			enabled, err := a.hasFeature(ctx, auth.InstitutionId, feature)
+			if err != nil {
+				logger.Error().Err(err).Str("feature", feature).Msg("failed to get institution features")
+				return responses.HandleError(c, libErrors.InternalServerError("Internal Server Error"))
+			}
+			if !enabled {
+				logger.Warn().Str("feature", feature).Str("institution_id", auth.InstitutionId).Msg("feature not enabled for institution")
+				return responses.HandleError(c, libErrors.Forbidden("Forbidden: Feature Not Enabled"))
+			}
+		}
+
 		for _, scope := range scopes {
 			if !slices.Contains(auth.Permissions(), scope) {
 				logger.Warn().Str("required_scope", scope).Str("user_id", auth.UserId).Msg("insufficient permissions")
-				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"message": "Unauthorized: Insufficient Permissions"})
+				return responses.HandleError(c, libErrors.Unauthorized("Unauthorized: Insufficient Permissions"))
 			}
 		}
 
@@ -87,6 +103,7 @@ func (a *AuthorizationMiddleware) Authenticate(scopes ...string) fiber.Handler {
 		c.Locals(XExternalSubject, auth.ExternalSubject)
 		c.Locals(XInstitutionId, auth.InstitutionId)
 		c.Locals(XGroupKey, auth.Groups())
+		c.SetUserContext(helper.WithActor(ctx, helper.Actor{UserId: auth.UserId, InstitutionId: auth.InstitutionId}))
 
 		return c.Next()
 	}
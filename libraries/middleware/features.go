@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	PrefixInstitutionFeatures = "auth:institution:features:"
+	XFeatureKey               = "X-Feature"
+
+	institutionFeaturesTTL = 5 * time.Minute
+)
+
+// Feature marks every request under a module's base path with the module name.
+// Authenticate rejects the request with 403 when the caller's institution does not
+// list the module in auth.institutions.features.
+func Feature(name string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Locals(XFeatureKey, name)
+		return c.Next()
+	}
+}
+
+// hasFeature reports whether the institution has the feature enabled.
+// Features are cached in Redis for a short period to avoid a query per request.
+func (a *AuthorizationMiddleware) hasFeature(ctx context.Context, institutionId string, feature string) (bool, error) {
+	features, err := a.findFeaturesFromCache(ctx, institutionId)
+	recordCacheLookup(ctx, cacheFeatures, err)
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			return false, err
+		}
+
+		features, err = a.findFeaturesFromDB(ctx, institutionId)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		if data, err := json.Marshal(features); err == nil {
+			a.cache.Set(ctx, PrefixInstitutionFeatures+institutionId, data, institutionFeaturesTTL)
+		}
+	}
+
+	return slices.Contains(features, feature), nil
+}
+
+func (a *AuthorizationMiddleware) findFeaturesFromCache(ctx context.Context, institutionId string) ([]string, error) {
+	data, err := a.cache.Get(ctx, PrefixInstitutionFeatures+institutionId).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var features []string
+	if err := json.Unmarshal(data, &features); err != nil {
+		return nil, err
+	}
+
+	return features, nil
+}
+
+func (a *AuthorizationMiddleware) findFeaturesFromDB(ctx context.Context, institutionId string) ([]string, error) {
+	const query = `
+		select coalesce(features, '[]'::jsonb)
+		from auth.institutions
+		where id=@institution_id and is_active
+		limit 1
+	`
+
+	var features []string
+	if err := a.db.QueryRow(ctx, query, pgx.NamedArgs{"institution_id": institutionId}).Scan(&features); err != nil {
+		return nil, err
+	}
+
+	return features, nil
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Caches looked up by AuthorizationMiddleware.
+const (
+	cacheSession  = "session"
+	cacheFeatures = "features"
+)
+
+// cacheLookups counts the Redis lookups of AuthorizationMiddleware by cache and result.
+// It records through the global MeterProvider and is a no-op until one is installed.
+var cacheLookups, _ = otel.Meter("github.com/siakup/morgan-be/libraries/middleware").Int64Counter(
+	"auth.cache.lookups",
+	metric.WithDescription("Session and institution feature lookups in the Redis cache, by result (hit, miss, error)."),
+	metric.WithUnit("{lookup}"),
+)
+
+// recordCacheLookup counts a lookup of cache that ended with err.
+func recordCacheLookup(ctx context.Context, cache string, err error) {
+	result := "hit"
+	switch {
+	case errors.Is(err, redis.Nil):
+		result = "miss"
+	case err != nil:
+		result = "error"
+	}
+
+	cacheLookups.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("cache", cache),
+		attribute.String("result", result),
+	))
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var (
+	readerOnce sync.Once
+	reader     *sdkmetric.ManualReader
+)
+
+// collectCounter installs a global MeterProvider on first use and returns the value of the
+// counter name for attrs, 0 when nothing was recorded.
+func collectCounter(t *testing.T, name string, attrs ...attribute.KeyValue) int64 {
+	t.Helper()
+
+	readerOnce.Do(func() {
+		reader = sdkmetric.NewManualReader()
+		otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	})
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	set := attribute.NewSet(attrs...)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if m.Name != name || !ok {
+				continue
+			}
+			for _, dp := range sum.DataPoints {
+				if dp.Attributes.Equals(&set) {
+					return dp.Value
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func TestRecordCacheLookup(t *testing.T) {
+	tests := []struct {
+		name   string
+		cache  string
+		err    error
+		result string
+	}{
+		{name: "Hit", cache: cacheSession, result: "hit"},
+		{name: "Miss", cache: cacheSession, err: redis.Nil, result: "miss"},
+		{name: "WrappedMiss", cache: cacheFeatures, err: errors.Join(errors.New("lookup"), redis.Nil), result: "miss"},
+		{name: "Error", cache: cacheFeatures, err: errors.New("connection refused"), result: "error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := []attribute.KeyValue{
+				attribute.String("cache", tt.cache),
+				attribute.String("result", tt.result),
+			}
+			before := collectCounter(t, "auth.cache.lookups", attrs...)
+
+			recordCacheLookup(context.Background(), tt.cache, tt.err)
+
+			if got := collectCounter(t, "auth.cache.lookups", attrs...); got != before+1 {
+				t.Errorf("auth.cache.lookups{cache=%s,result=%s} = %d, want %d", tt.cache, tt.result, got, before+1)
+			}
+		})
+	}
+}
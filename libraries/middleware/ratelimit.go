@@ -0,0 +1,190 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	libErrors "github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"go.uber.org/fx"
+)
+
+const (
+	PrefixRateLimit = "ratelimit:"
+
+	// RateLimitByIP counts requests per client IP.
+	RateLimitByIP = "ip"
+	// RateLimitByUser counts requests per authenticated user, falling back to the IP
+	// for requests that have not been through Authenticate.
+	RateLimitByUser = "user"
+
+	// RateLimitGlobal names the rule applied to every request.
+	RateLimitGlobal = "global"
+)
+
+// RateLimitRule allows Limit requests per sliding Window for each IP or user.
+type RateLimitRule struct {
+	Limit  int           `config:"limit"`
+	Window time.Duration `config:"window"`
+	// By is RateLimitByIP (default) or RateLimitByUser.
+	By string `config:"by"`
+}
+
+// RateLimitConfig overrides the rate limits defined in code.
+type RateLimitConfig struct {
+	// RateLimitDisabled turns every limit off, e.g. for load tests.
+	RateLimitDisabled bool `config:"rate_limit_disabled"`
+	// RateLimits sets rules by name: a module name for its route group, RateLimitGlobal for
+	// every request, or the name passed to Limit. A rule with a zero limit removes the limit.
+	RateLimits map[string]RateLimitRule `config:"rate_limits"`
+}
+
+// RateLimitModule provides the RateLimiter and applies the global rule, if configured.
+var RateLimitModule = fx.Module("ratelimit",
+	fx.Provide(NewRateLimiter),
+	fx.Invoke(RegisterGlobalRateLimit),
+)
+
+// slidingWindow keeps the timestamps of the requests of the last window in a sorted set and
+// admits a request while fewer than limit are recorded. It returns whether the request is
+// allowed, the requests counted and the milliseconds until the oldest one leaves the window.
+// The Redis clock is used so that every replica agrees on the window.
+var slidingWindow = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local count = redis.call('ZCARD', KEYS[1])
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', KEYS[1], now, ARGV[3])
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', KEYS[1], window)
+
+local reset = window
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+return {allowed, count, reset}
+`)
+
+// RateLimiter limits requests with a sliding window shared by every replica through Redis.
+type RateLimiter struct {
+	cache redis.UniversalClient
+	cfg   *RateLimitConfig
+}
+
+// RateLimiterParams holds the dependencies of the RateLimiter.
+type RateLimiterParams struct {
+	fx.In
+
+	Cache  redis.UniversalClient
+	Config *RateLimitConfig `optional:"true"`
+}
+
+// NewRateLimiter creates a RateLimiter storing its windows in cache.
+func NewRateLimiter(params RateLimiterParams) *RateLimiter {
+	cfg := params.Config
+	if cfg == nil {
+		cfg = &RateLimitConfig{}
+	}
+	return &RateLimiter{cache: params.Cache, cfg: cfg}
+}
+
+// RegisterGlobalRateLimit limits every request registered after it with the RateLimitGlobal rule.
+func RegisterGlobalRateLimit(app *fiber.App, limiter *RateLimiter) {
+	if rule := limiter.Rule(RateLimitGlobal, RateLimitRule{}); rule.Limit > 0 {
+		app.Use(limiter.Limit(RateLimitGlobal, rule))
+	}
+}
+
+// Rule returns the effective rule for name: the configured rule if any, otherwise rule.
+// Rules without a limit (or when rate limiting is disabled) have a zero Limit.
+func (l *RateLimiter) Rule(name string, rule RateLimitRule) RateLimitRule {
+	if l.cfg.RateLimitDisabled {
+		return RateLimitRule{}
+	}
+	if configured, ok := l.cfg.RateLimits[name]; ok {
+		rule = configured
+	}
+	if rule.Window <= 0 {
+		rule.Limit = 0
+	}
+	return rule
+}
+
+// Limit rejects requests beyond the effective rule for name with 429 Too Many Requests.
+// Every response carries the RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers
+// (seconds until a request is freed), and rejected ones a Retry-After header.
+//
+// Requests are let through when Redis is unavailable, so an outage of the cache does not take
+// the API down with it.
+func (l *RateLimiter) Limit(name string, rule RateLimitRule) fiber.Handler {
+	rule = l.Rule(name, rule)
+	if rule.Limit <= 0 {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	window := rule.Window.Milliseconds()
+	policy := fmt.Sprintf("%d;w=%d", rule.Limit, int(math.Ceil(rule.Window.Seconds())))
+
+	return func(c *fiber.Ctx) error {
+		key := PrefixRateLimit + name + ":" + rateLimitSubject(c, rule.By)
+
+		ctx := c.UserContext()
+		allowed, count, reset, err := l.take(ctx, key, rule.Limit, window)
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Str("component", "middleware.ratelimit").Str("rule", name).Msg("rate limit unavailable, request allowed")
+			return c.Next()
+		}
+
+		resetSeconds := strconv.FormatInt(int64(math.Ceil(float64(reset)/1000)), 10)
+		c.Set("RateLimit-Policy", policy)
+		c.Set("RateLimit-Limit", strconv.Itoa(rule.Limit))
+		c.Set("RateLimit-Remaining", strconv.Itoa(max(rule.Limit-count, 0)))
+		c.Set("RateLimit-Reset", resetSeconds)
+
+		if !allowed {
+			c.Set(fiber.HeaderRetryAfter, resetSeconds)
+			return responses.HandleError(c, libErrors.TooManyRequests("Too many requests, please try again later"))
+		}
+		return c.Next()
+	}
+}
+
+// take records a request in the window at key.
+func (l *RateLimiter) take(ctx context.Context, key string, limit int, window int64) (bool, int, int64, error) {
+	res, err := slidingWindow.Run(ctx, l.cache, []string{key}, limit, window, uuid.NewString()).Int64Slice()
+	if err != nil {
+		return false, 0, 0, err
+	}
+	if len(res) != 3 {
+		return false, 0, 0, fmt.Errorf("unexpected rate limit result %v", res)
+	}
+	return res[0] == 1, int(res[1]), res[2], nil
+}
+
+// rateLimitSubject identifies who a request is counted against. The IP comes from the proxy header
+// only when a trusted proxy sent the request (see fiber.Config.TrustedProxies).
+func rateLimitSubject(c *fiber.Ctx, by string) string {
+	if by == RateLimitByUser {
+		if userId, ok := c.Locals(XUserIdKey).(string); ok && userId != "" {
+			return "user:" + userId
+		}
+	}
+	return "ip:" + c.IP()
+}
@@ -0,0 +1,210 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/siakup/morgan-be/libraries/responses"
+)
+
+func newTestLimiter(t *testing.T, cfg *RateLimitConfig) (*RateLimiter, *miniredis.Miniredis) {
+	t.Helper()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { client.Close() })
+
+	return NewRateLimiter(RateLimiterParams{Cache: client, Config: cfg}), server
+}
+
+func limitedApp(limiter *RateLimiter, rule RateLimitRule, config ...fiber.Config) *fiber.App {
+	app := fiber.New(config...)
+	app.Get("/", limiter.Limit("test", rule), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+	return app
+}
+
+func get(t *testing.T, app *fiber.App, header ...string) *http.Response {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	resp, err := app.Test(req, -1)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	return resp
+}
+
+func TestRateLimiter_SlidingWindow(t *testing.T) {
+	limiter, server := newTestLimiter(t, nil)
+	start := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	server.SetTime(start)
+	app := limitedApp(limiter, RateLimitRule{Limit: 2, Window: time.Minute})
+
+	for i := 0; i < 2; i++ {
+		if resp := get(t, app); resp.StatusCode != http.StatusOK {
+			t.Fatalf("request %d: expected 200, got %d", i+1, resp.StatusCode)
+		}
+	}
+	if resp := get(t, app); resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected the third request to be limited, got %d", resp.StatusCode)
+	}
+
+	// Still inside the window of the first requests
+	server.SetTime(start.Add(59 * time.Second))
+	if resp := get(t, app); resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected the window to still be full, got %d", resp.StatusCode)
+	}
+
+	server.SetTime(start.Add(time.Minute + time.Millisecond))
+	if resp := get(t, app); resp.StatusCode != http.StatusOK {
+		t.Errorf("expected the window to have slid, got %d", resp.StatusCode)
+	}
+}
+
+func TestRateLimiter_Headers(t *testing.T) {
+	limiter, server := newTestLimiter(t, nil)
+	server.SetTime(time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC))
+	app := limitedApp(limiter, RateLimitRule{Limit: 2, Window: 30 * time.Second})
+
+	resp := get(t, app)
+	want := map[string]string{
+		"RateLimit-Policy":    "2;w=30",
+		"RateLimit-Limit":     "2",
+		"RateLimit-Remaining": "1",
+		"RateLimit-Reset":     "30",
+	}
+	for header, value := range want {
+		if got := resp.Header.Get(header); got != value {
+			t.Errorf("%s: expected %q, got %q", header, value, got)
+		}
+	}
+	if resp.Header.Get(fiber.HeaderRetryAfter) != "" {
+		t.Error("expected no Retry-After on an allowed request")
+	}
+
+	get(t, app)
+	resp = get(t, app)
+	if resp.Header.Get("RateLimit-Remaining") != "0" || resp.Header.Get(fiber.HeaderRetryAfter) != "30" {
+		t.Errorf("expected no remaining requests and Retry-After 30, got %q and %q",
+			resp.Header.Get("RateLimit-Remaining"), resp.Header.Get(fiber.HeaderRetryAfter))
+	}
+}
+
+func TestRateLimiter_Envelope(t *testing.T) {
+	limiter, _ := newTestLimiter(t, nil)
+	app := limitedApp(limiter, RateLimitRule{Limit: 1, Window: time.Minute})
+
+	get(t, app)
+	resp := get(t, app)
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", resp.StatusCode)
+	}
+
+	var body responses.Response[any]
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("invalid body: %v", err)
+	}
+	if body.Success || body.Error == nil || body.Error.Code != "RATE_LIMITED" || body.Error.Message == "" {
+		t.Errorf("expected the error envelope, got %+v", body)
+	}
+}
+
+func TestRateLimiter_Subject(t *testing.T) {
+	t.Run("ByUser", func(t *testing.T) {
+		limiter, _ := newTestLimiter(t, nil)
+		app := fiber.New()
+		app.Get("/", func(c *fiber.Ctx) error {
+			c.Locals(XUserIdKey, c.Get("X-Test-User"))
+			return c.Next()
+		}, limiter.Limit("test", RateLimitRule{Limit: 1, Window: time.Minute, By: RateLimitByUser}), func(c *fiber.Ctx) error {
+			return c.SendString("ok")
+		})
+
+		if resp := get(t, app, "X-Test-User", "u1"); resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		if resp := get(t, app, "X-Test-User", "u2"); resp.StatusCode != http.StatusOK {
+			t.Errorf("expected another user to have its own window, got %d", resp.StatusCode)
+		}
+		if resp := get(t, app, "X-Test-User", "u1"); resp.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("expected the user to be limited, got %d", resp.StatusCode)
+		}
+	})
+
+	// app.Test sends requests from 0.0.0.0.
+	t.Run("UntrustedProxyHeaderIgnored", func(t *testing.T) {
+		limiter, _ := newTestLimiter(t, nil)
+		app := limitedApp(limiter, RateLimitRule{Limit: 1, Window: time.Minute}, fiber.Config{
+			ProxyHeader:             fiber.HeaderXForwardedFor,
+			EnableTrustedProxyCheck: true,
+			TrustedProxies:          []string{"10.0.0.1"},
+		})
+
+		get(t, app, fiber.HeaderXForwardedFor, "203.0.113.1")
+		if resp := get(t, app, fiber.HeaderXForwardedFor, "203.0.113.2"); resp.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("expected a spoofed header to share the peer's window, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("TrustedProxyHeader", func(t *testing.T) {
+		limiter, _ := newTestLimiter(t, nil)
+		app := limitedApp(limiter, RateLimitRule{Limit: 1, Window: time.Minute}, fiber.Config{
+			ProxyHeader:             fiber.HeaderXForwardedFor,
+			EnableTrustedProxyCheck: true,
+			TrustedProxies:          []string{"0.0.0.0"},
+		})
+
+		get(t, app, fiber.HeaderXForwardedFor, "203.0.113.1")
+		if resp := get(t, app, fiber.HeaderXForwardedFor, "203.0.113.2"); resp.StatusCode != http.StatusOK {
+			t.Errorf("expected each forwarded client to have its own window, got %d", resp.StatusCode)
+		}
+	})
+}
+
+func TestRateLimiter_RedisDown(t *testing.T) {
+	limiter, server := newTestLimiter(t, nil)
+	app := limitedApp(limiter, RateLimitRule{Limit: 1, Window: time.Minute})
+	server.Close()
+
+	for i := 0; i < 2; i++ {
+		if resp := get(t, app); resp.StatusCode != http.StatusOK {
+			t.Errorf("expected requests to be let through without Redis, got %d", resp.StatusCode)
+		}
+	}
+}
+
+func TestRateLimiter_Rule(t *testing.T) {
+	limiter, _ := newTestLimiter(t, &RateLimitConfig{RateLimits: map[string]RateLimitRule{
+		"roles": {Limit: 5, Window: time.Second},
+		"users": {Limit: 0, Window: time.Second},
+	}})
+	code := RateLimitRule{Limit: 100, Window: time.Minute}
+
+	cases := []struct {
+		name  string
+		limit int
+	}{
+		{"roles", 5},
+		{"users", 0},
+		{"tickets", 100},
+	}
+	for _, tc := range cases {
+		if got := limiter.Rule(tc.name, code).Limit; got != tc.limit {
+			t.Errorf("%s: expected limit %d, got %d", tc.name, tc.limit, got)
+		}
+	}
+
+	disabled, _ := newTestLimiter(t, &RateLimitConfig{RateLimitDisabled: true})
+	if disabled.Rule("roles", code).Limit != 0 {
+		t.Error("expected every rule to be off when disabled")
+	}
+}
@@ -0,0 +1,258 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/responses"
+)
+
+// Generate builds the document of the routes mounted on an app (app.GetRoutes(true)).
+//
+// Routes documented by an endpoint of groups get its summary, permissions, parameters, request
+// and response schemas. Routes nobody documented are still listed, tagged "undocumented", and
+// documented endpoints that are not mounted (disabled modules, stale docs) are left out, so the
+// document always matches what the server answers.
+func Generate(info Info, groups []Group, routes []fiber.Route) *Document {
+	g := newSchemas()
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			SecuritySchemes: map[string]*SecuritySchema{
+				SecurityScheme: {
+					Type:        "apiKey",
+					In:          "cookie",
+					Name:        "access_token",
+					Description: "Session token set by the login redirect. Scopes are permission codes.",
+				},
+			},
+		},
+	}
+
+	mounted := make(map[string]fiber.Route, len(routes))
+	for _, route := range routes {
+		if route.Method == fiber.MethodHead || route.Path == SpecPath || route.Path == DocsPath {
+			continue
+		}
+		mounted[route.Method+" "+pathTemplate(route.Path)] = route
+	}
+
+	sorted := append([]Group(nil), groups...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Tag < sorted[j].Tag })
+
+	documented := make(map[string]bool)
+	for _, group := range sorted {
+		tagged := false
+		for _, ep := range group.Endpoints {
+			path := ep.Path
+			if group.Version != "" {
+				path = "/" + group.Version + path
+			}
+			key := ep.Method + " " + pathTemplate(path)
+			if _, ok := mounted[key]; !ok || documented[key] {
+				continue
+			}
+			documented[key] = true
+
+			if !tagged {
+				doc.Tags = append(doc.Tags, Tag{Name: group.Tag})
+				tagged = true
+			}
+			addOperation(doc, ep.Method, path, g.operation(group.Tag, ep, path))
+		}
+	}
+
+	keys := make([]string, 0, len(mounted))
+	for key := range mounted {
+		if !documented[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		route := mounted[key]
+		ep := Endpoint{Method: route.Method, Path: route.Path, Public: true}
+		addOperation(doc, route.Method, route.Path, g.operation("undocumented", ep, route.Path))
+	}
+	if len(keys) > 0 {
+		doc.Tags = append(doc.Tags, Tag{Name: "undocumented"})
+	}
+
+	g.of(reflect.TypeOf(responses.Problem{}))
+	g.components["ErrorResponse"] = &Schema{
+		Type:     "object",
+		Required: []string{"success", "error"},
+		Properties: map[string]*Schema{
+			"success": {Type: "boolean", Enum: []any{false}},
+			"error":   g.of(reflect.TypeOf(responses.Error{})),
+		},
+	}
+	doc.Components.Schemas = g.components
+
+	return doc
+}
+
+func addOperation(doc *Document, method, path string, op *Operation) {
+	template := pathTemplate(path)
+	item, ok := doc.Paths[template]
+	if !ok {
+		item = PathItem{}
+		doc.Paths[template] = item
+	}
+	item[strings.ToLower(method)] = op
+}
+
+// operation describes ep, mounted at path.
+func (g *schemas) operation(tag string, ep Endpoint, path string) *Operation {
+	op := &Operation{
+		Tags:        []string{tag},
+		Summary:     ep.Summary,
+		Description: ep.Description,
+		OperationID: operationID(ep.Method, path),
+		Responses:   map[string]*Response{},
+	}
+
+	for _, name := range pathParams(path) {
+		op.Parameters = append(op.Parameters, &Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+	if ep.Query != nil {
+		t := reflect.TypeOf(ep.Query)
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		for _, f := range fields(t, "query") {
+			op.Parameters = append(op.Parameters, &Parameter{
+				Name:        f.name,
+				In:          "query",
+				Description: f.tag.Get("doc"),
+				Required:    f.required,
+				Schema:      f.schema(g),
+			})
+		}
+	}
+
+	if ep.Request != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{fiber.MIMEApplicationJSON: {Schema: g.of(reflect.TypeOf(ep.Request))}},
+		}
+	}
+
+	status := ep.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := &Response{Description: http.StatusText(status)}
+	if status != http.StatusNoContent && (status < 300 || status >= 400) {
+		success.Content = map[string]MediaType{fiber.MIMEApplicationJSON: {Schema: g.envelope(ep)}}
+	}
+	op.Responses[strconv.Itoa(status)] = success
+
+	if ep.Request != nil || ep.Query != nil {
+		op.Responses["400"] = errorResponse(http.StatusBadRequest)
+	}
+	if !ep.Public {
+		op.Responses["401"] = errorResponse(http.StatusUnauthorized)
+		op.Responses["403"] = errorResponse(http.StatusForbidden)
+
+		scopes := ep.Permissions
+		if scopes == nil {
+			scopes = []string{}
+		}
+		op.Security = []map[string][]string{{SecurityScheme: scopes}}
+		op.Permissions = ep.Permissions
+	}
+	if len(pathParams(path)) > 0 {
+		op.Responses["404"] = errorResponse(http.StatusNotFound)
+	}
+	op.Responses["default"] = &Response{
+		Description: "Error",
+		Content:     errorContent(),
+	}
+
+	return op
+}
+
+// envelope is the responses.Response schema carrying the data of ep.
+func (g *schemas) envelope(ep Endpoint) *Schema {
+	s := &Schema{
+		Type:     "object",
+		Required: []string{"success"},
+		Properties: map[string]*Schema{
+			"success": {Type: "boolean", Enum: []any{true}},
+			"message": {Type: "string"},
+		},
+	}
+	if ep.Response != nil {
+		s.Properties["data"] = g.of(reflect.TypeOf(ep.Response))
+	}
+	if ep.Paginated {
+		s.Properties["meta"] = g.of(reflect.TypeOf(responses.Meta{}))
+	}
+	return s
+}
+
+func errorResponse(status int) *Response {
+	return &Response{Description: http.StatusText(status), Content: errorContent()}
+}
+
+func errorContent() map[string]MediaType {
+	return map[string]MediaType{
+		fiber.MIMEApplicationJSON:    {Schema: &Schema{Ref: "#/components/schemas/ErrorResponse"}},
+		responses.ProblemContentType: {Schema: &Schema{Ref: "#/components/schemas/Problem"}},
+	}
+}
+
+// pathTemplate converts a Fiber path to an OpenAPI path template: "/roles/:id/" becomes "/roles/{id}".
+func pathTemplate(path string) string {
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case strings.HasPrefix(segment, ":"):
+			segments[i] = "{" + strings.TrimSuffix(segment[1:], "?") + "}"
+		case segment == "*" || segment == "+":
+			segments[i] = "{path}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// pathParams lists the parameters of a Fiber path in order.
+func pathParams(path string) []string {
+	var params []string
+	for _, segment := range strings.Split(pathTemplate(path), "/") {
+		if strings.HasPrefix(segment, "{") {
+			params = append(params, strings.Trim(segment, "{}"))
+		}
+	}
+	return params
+}
+
+// operationID names an operation after its method and path: GET /v1/roles/:id is getV1RolesById.
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, segment := range strings.Split(pathTemplate(path), "/") {
+		if strings.HasPrefix(segment, "{") {
+			b.WriteString("By")
+			segment = strings.Trim(segment, "{}")
+		}
+		for _, word := range strings.FieldsFunc(segment, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,76 @@
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/responses"
+)
+
+const (
+	// SpecPath serves the document as JSON.
+	SpecPath = "/openapi.json"
+	// DocsPath serves the docs UI, reading the document from SpecPath.
+	DocsPath = "/docs"
+)
+
+//go:embed docs.html
+var docsPage []byte
+
+// Spec generates the document of an app once its routes are mounted.
+type Spec struct {
+	app    *fiber.App
+	info   Info
+	groups []Group
+
+	once sync.Once
+	doc  *Document
+	json []byte
+	err  error
+}
+
+// NewSpec creates the Spec of app, documented by groups. Nothing is generated until it is needed,
+// so that every router is mounted by then.
+func NewSpec(app *fiber.App, info Info, groups ...Group) *Spec {
+	return &Spec{app: app, info: info, groups: groups}
+}
+
+func (s *Spec) generate() {
+	s.once.Do(func() {
+		s.doc = Generate(s.info, s.groups, s.app.GetRoutes(true))
+		s.json, s.err = json.MarshalIndent(s.doc, "", "  ")
+	})
+}
+
+// Document returns the generated document.
+func (s *Spec) Document() *Document {
+	s.generate()
+	return s.doc
+}
+
+// JSON returns the generated document as indented JSON.
+func (s *Spec) JSON() ([]byte, error) {
+	s.generate()
+	return s.json, s.err
+}
+
+// Register serves the document of spec at SpecPath and the docs UI at DocsPath.
+func Register(router fiber.Router, spec *Spec) {
+	router.Get(SpecPath, func(c *fiber.Ctx) error {
+		body, err := spec.JSON()
+		if err != nil {
+			return responses.HandleError(c, err)
+		}
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+		return c.Send(body)
+	})
+
+	router.Get(DocsPath, func(c *fiber.Ctx) error {
+		// The UI is loaded from a CDN, which the default Helmet policy would block
+		c.Set("Cross-Origin-Embedder-Policy", "unsafe-none")
+		c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+		return c.Send(docsPage)
+	})
+}
@@ -0,0 +1,18 @@
+package openapi
+
+import "github.com/gofiber/fiber/v2"
+
+// Mount registers endpoints on router in order, each behind authenticate(ep.Permissions...)
+// unless it is Public, followed by its Handlers. Registering the routes from the endpoints that
+// document them means every route is documented, with the permissions it actually checks.
+//
+// authenticate may be nil when every endpoint is public.
+func Mount(router fiber.Router, authenticate func(scopes ...string) fiber.Handler, endpoints []Endpoint) {
+	for _, ep := range endpoints {
+		handlers := ep.Handlers
+		if !ep.Public {
+			handlers = append([]fiber.Handler{authenticate(ep.Permissions...)}, handlers...)
+		}
+		router.Add(ep.Method, ep.Path, handlers...)
+	}
+}
@@ -0,0 +1,152 @@
+// Package openapi generates an OpenAPI 3.1 document from the routes mounted on a Fiber app and
+// the endpoints documented by the modules, and serves it with a docs UI.
+package openapi
+
+import "github.com/gofiber/fiber/v2"
+
+// Version is the OpenAPI version of the generated documents.
+const Version = "3.1.0"
+
+// SecurityScheme is the name of the session cookie scheme checked by middleware.Authenticate.
+const SecurityScheme = "cookieAuth"
+
+// Endpoint documents a route registered by a module's handler. Handlers registering their routes
+// with Mount document exactly what they mount.
+type Endpoint struct {
+	// Method and Path identify the route as the handler registers it, e.g. GET "/roles/:id".
+	Method string
+	Path   string
+
+	Summary     string
+	Description string
+
+	// Permissions are the scopes passed to Authenticate. Public endpoints are not authenticated.
+	Permissions []string
+	Public      bool
+
+	// Query is a struct describing the query parameters by their `query` tags.
+	Query any
+	// Request is the JSON body. In Query and Request, `validate` tags become schema constraints,
+	// `default` tags default values and `doc` tags descriptions.
+	Request any
+	// Response is the data of the responses.Response envelope; nil when there is none.
+	Response any
+	// Status is the success status, http.StatusOK by default.
+	Status int
+	// Paginated responses carry responses.Meta.
+	Paginated bool
+
+	// Handlers serve the route when it is registered with Mount, after the Authenticate check.
+	Handlers []fiber.Handler
+}
+
+// Group documents the endpoints of a module.
+type Group struct {
+	// Tag groups the operations in the docs, e.g. the module name.
+	Tag string
+	// Version is the API version the endpoints are mounted under, e.g. "v1".
+	Version string
+
+	Endpoints []Endpoint
+}
+
+// Document is an OpenAPI 3.1 document.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Tags       []Tag               `json:"tags,omitempty"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info describes the API.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Tag groups operations.
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// PathItem holds the operations of a path by lower-case method.
+type PathItem map[string]*Operation
+
+// Operation describes a single API operation on a path.
+type Operation struct {
+	Tags        []string              `json:"tags,omitempty"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	OperationID string                `json:"operationId"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+	// Permissions repeats the permission codes required by the operation.
+	Permissions []string `json:"x-permissions,omitempty"`
+}
+
+// Parameter is a path or query parameter.
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody describes the body of a request.
+type RequestBody struct {
+	Required bool                 `json:"required,omitempty"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response describes a response of an operation.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a request or response body.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the schemas and security schemes referenced by the operations.
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas,omitempty"`
+	SecuritySchemes map[string]*SecuritySchema `json:"securitySchemes,omitempty"`
+}
+
+// SecuritySchema describes how requests authenticate.
+type SecuritySchema struct {
+	Type        string `json:"type"`
+	In          string `json:"in,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Schema is a JSON Schema (draft 2020-12), as used by OpenAPI 3.1.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 any                `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Default              any                `json:"default,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	ExclusiveMinimum     *float64           `json:"exclusiveMinimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMaximum     *float64           `json:"exclusiveMaximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+}
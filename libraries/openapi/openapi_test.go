@@ -0,0 +1,227 @@
+package openapi
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type assignee struct {
+	Id string `json:"id" validate:"required,uuid"`
+}
+
+type createTicketRequest struct {
+	Title    string     `json:"title" validate:"required,min=3,max=200"`
+	Status   string     `json:"status" validate:"omitempty,oneof=open closed"`
+	Priority int        `json:"priority" validate:"gte=1,lte=5"`
+	Labels   []string   `json:"labels" validate:"max=10,dive,required,max=20"`
+	DueAt    *time.Time `json:"due_at"`
+	Assignee assignee   `json:"assignee"`
+	Internal string     `json:"-"`
+}
+
+type ticketResponse struct {
+	Id    string  `json:"id"`
+	Notes *string `json:"notes"`
+}
+
+type listQuery struct {
+	Page   int    `query:"page" default:"1" validate:"min=1"`
+	Search string `query:"search" doc:"Matches the title"`
+}
+
+func TestSchema_ValidatorTags(t *testing.T) {
+	g := newSchemas()
+	ref := g.of(reflect.TypeOf(createTicketRequest{}))
+	if ref.Ref != "#/components/schemas/createTicketRequest" {
+		t.Fatalf("expected a component reference, got %+v", ref)
+	}
+
+	s := g.components["createTicketRequest"]
+	if !reflect.DeepEqual(s.Required, []string{"title"}) {
+		t.Errorf("expected title to be required, got %v", s.Required)
+	}
+	if _, ok := s.Properties["Internal"]; ok {
+		t.Error("expected fields tagged json:\"-\" to be left out")
+	}
+
+	title := s.Properties["title"]
+	if *title.MinLength != 3 || *title.MaxLength != 200 {
+		t.Errorf("expected title length 3..200, got %v..%v", *title.MinLength, *title.MaxLength)
+	}
+	if status := s.Properties["status"]; !reflect.DeepEqual(status.Enum, []any{"open", "closed"}) {
+		t.Errorf("expected status enum, got %v", status.Enum)
+	}
+	if priority := s.Properties["priority"]; *priority.Minimum != 1 || *priority.Maximum != 5 {
+		t.Errorf("expected priority 1..5, got %+v", priority)
+	}
+
+	labels := s.Properties["labels"]
+	if *labels.MaxItems != 10 || *labels.Items.MaxLength != 20 {
+		t.Errorf("expected dive rules on the items, got %+v / %+v", labels, labels.Items)
+	}
+	if due := s.Properties["due_at"]; due.Format != "date-time" || !reflect.DeepEqual(due.Type, []string{"string", "null"}) {
+		t.Errorf("expected a nullable date-time, got %+v", due)
+	}
+	if id := g.components["assignee"].Properties["id"]; id.Format != "uuid" {
+		t.Errorf("expected uuid format on nested struct, got %+v", id)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	app := fiber.New()
+	handler := func(c *fiber.Ctx) error { return nil }
+	v1 := app.Group("/v1")
+	v1.Get("/tickets/", handler)
+	v1.Post("/tickets/", handler)
+	v1.Get("/tickets/:id", handler)
+	app.Get("/redirect/:institution_id", handler)
+	app.Get("/legacy", handler)
+
+	groups := []Group{
+		{Tag: "tickets", Version: "v1", Endpoints: []Endpoint{
+			{Method: fiber.MethodGet, Path: "/tickets", Summary: "List tickets", Permissions: []string{"tickets.view"}, Query: listQuery{}, Response: []ticketResponse{}, Paginated: true},
+			{Method: fiber.MethodPost, Path: "/tickets", Permissions: []string{"tickets.create"}, Request: createTicketRequest{}, Response: ticketResponse{}, Status: http.StatusCreated},
+			{Method: fiber.MethodGet, Path: "/tickets/:id", Permissions: []string{"tickets.view"}, Response: ticketResponse{}},
+			{Method: fiber.MethodDelete, Path: "/tickets/:id", Permissions: []string{"tickets.delete"}},
+		}},
+		{Tag: "redirect", Endpoints: []Endpoint{
+			{Method: fiber.MethodGet, Path: "/redirect/:institution_id", Public: true, Status: http.StatusFound},
+		}},
+	}
+
+	doc := Generate(Info{Title: "Test", Version: "1.0.0"}, groups, app.GetRoutes(true))
+	if doc.OpenAPI != Version {
+		t.Errorf("expected openapi %s, got %s", Version, doc.OpenAPI)
+	}
+
+	list := doc.Paths["/v1/tickets"]["get"]
+	if list == nil || list.Summary != "List tickets" || list.OperationID != "getV1Tickets" {
+		t.Fatalf("expected the documented list operation, got %+v", list)
+	}
+	if !reflect.DeepEqual(list.Security, []map[string][]string{{SecurityScheme: {"tickets.view"}}}) {
+		t.Errorf("expected the permission as scope, got %v", list.Security)
+	}
+	if len(list.Parameters) != 2 || list.Parameters[0].Schema.Default != int64(1) || list.Parameters[1].Description != "Matches the title" {
+		t.Errorf("expected page and search query parameters, got %+v", list.Parameters)
+	}
+	envelope := list.Responses["200"].Content[fiber.MIMEApplicationJSON].Schema
+	if envelope.Properties["data"].Items.Ref != "#/components/schemas/ticketResponse" || envelope.Properties["meta"] == nil {
+		t.Errorf("expected a paginated envelope of tickets, got %+v", envelope.Properties)
+	}
+
+	create := doc.Paths["/v1/tickets"]["post"]
+	if create.RequestBody == nil || create.Responses["201"] == nil || create.Responses["400"] == nil {
+		t.Errorf("expected a request body, 201 and 400, got %+v", create)
+	}
+	if byID := doc.Paths["/v1/tickets/{id}"]["get"]; byID.Parameters[0].Name != "id" || byID.Responses["404"] == nil {
+		t.Errorf("expected an id path parameter and 404, got %+v", byID)
+	}
+	if _, ok := doc.Paths["/v1/tickets/{id}"]["delete"]; ok {
+		t.Error("expected endpoints that are not mounted to be left out")
+	}
+
+	redirect := doc.Paths["/redirect/{institution_id}"]["get"]
+	if redirect.Security != nil || redirect.Responses["302"].Content != nil {
+		t.Errorf("expected a public redirect without body, got %+v", redirect)
+	}
+	if legacy := doc.Paths["/legacy"]["get"]; legacy == nil || legacy.Tags[0] != "undocumented" {
+		t.Errorf("expected undocumented routes to be listed, got %+v", legacy)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	app := fiber.New()
+	app.Get("/v1/tickets", func(c *fiber.Ctx) error { return nil })
+	spec := NewSpec(app, Info{Title: "Test", Version: "1.0.0"})
+	Register(app, spec)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, SpecPath, nil))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	var doc Document
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		t.Fatalf("invalid document: %v", err)
+	}
+	if _, ok := doc.Paths["/v1/tickets"]; !ok || len(doc.Paths) != 1 {
+		t.Errorf("expected only /v1/tickets, got %v", doc.Paths)
+	}
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, DocsPath, nil))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Header.Get(fiber.HeaderContentType) != fiber.MIMETextHTMLCharsetUTF8 {
+		t.Errorf("expected the docs page, got %d %s", resp.StatusCode, resp.Header.Get(fiber.HeaderContentType))
+	}
+}
+
+func TestMount(t *testing.T) {
+	// authenticate answers 401 and reports the scopes it was built with, like a session without them
+	var checked [][]string
+	authenticate := func(scopes ...string) fiber.Handler {
+		checked = append(checked, scopes)
+		return func(c *fiber.Ctx) error {
+			if c.Get("X-Scopes") != strings.Join(scopes, ",") {
+				return c.SendStatus(http.StatusUnauthorized)
+			}
+			return c.Next()
+		}
+	}
+	ok := func(c *fiber.Ctx) error { return c.SendString(c.Route().Path) }
+
+	app := fiber.New()
+	endpoints := []Endpoint{
+		{Method: fiber.MethodGet, Path: "/tickets/summary", Permissions: []string{"tickets.view"}, Handlers: []fiber.Handler{ok}},
+		{Method: fiber.MethodGet, Path: "/tickets/:id", Permissions: []string{"tickets.view"}, Handlers: []fiber.Handler{ok}},
+		{Method: fiber.MethodDelete, Path: "/tickets/:id", Permissions: []string{"tickets.delete", "tickets.edit"}, Handlers: []fiber.Handler{ok}},
+		{Method: fiber.MethodGet, Path: "/redirect/:institution_id", Public: true, Handlers: []fiber.Handler{ok}},
+	}
+	Mount(app.Group("/v1"), authenticate, endpoints)
+
+	if want := [][]string{{"tickets.view"}, {"tickets.view"}, {"tickets.delete", "tickets.edit"}}; !reflect.DeepEqual(checked, want) {
+		t.Errorf("expected Authenticate with the documented permissions of private endpoints, got %v", checked)
+	}
+
+	tests := []struct {
+		method, path, scopes string
+		status               int
+		body                 string
+	}{
+		{method: http.MethodGet, path: "/v1/tickets/summary", scopes: "tickets.view", status: http.StatusOK, body: "/v1/tickets/summary"},
+		{method: http.MethodGet, path: "/v1/tickets/1", scopes: "tickets.view", status: http.StatusOK, body: "/v1/tickets/:id"},
+		{method: http.MethodGet, path: "/v1/tickets/1", status: http.StatusUnauthorized},
+		{method: http.MethodDelete, path: "/v1/tickets/1", scopes: "tickets.view", status: http.StatusUnauthorized},
+		{method: http.MethodDelete, path: "/v1/tickets/1", scopes: "tickets.delete,tickets.edit", status: http.StatusOK, body: "/v1/tickets/:id"},
+		{method: http.MethodGet, path: "/v1/redirect/inst-1", status: http.StatusOK, body: "/v1/redirect/:institution_id"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		req.Header.Set("X-Scopes", tt.scopes)
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != tt.status || (tt.body != "" && string(body) != tt.body) {
+			t.Errorf("%s %s with %q: expected %d %q, got %d %q", tt.method, tt.path, tt.scopes, tt.status, tt.body, resp.StatusCode, body)
+		}
+	}
+
+	doc := Generate(Info{Title: "Test", Version: "1.0.0"}, []Group{{Tag: "tickets", Version: "v1", Endpoints: endpoints}}, app.GetRoutes(true))
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			if op.Tags[0] == "undocumented" {
+				t.Errorf("expected every mounted route to be documented, got %s %s undocumented", method, path)
+			}
+		}
+	}
+}
@@ -0,0 +1,343 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+	componentName = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+)
+
+// formats maps validator tags to schema formats.
+var formats = map[string]string{
+	"email":        "email",
+	"url":          "uri",
+	"http_url":     "uri",
+	"uri":          "uri",
+	"uuid":         "uuid",
+	"uuid4":        "uuid",
+	"uuid_rfc4122": "uuid",
+	"ipv4":         "ipv4",
+	"ipv6":         "ipv6",
+	"hostname":     "hostname",
+}
+
+// patterns maps validator tags to the equivalent regular expression.
+var patterns = map[string]string{
+	"alpha":    `^[a-zA-Z]+$`,
+	"alphanum": `^[a-zA-Z0-9]+$`,
+	"numeric":  `^[-+]?[0-9]+(\.[0-9]+)?$`,
+	"e164":     `^\+[1-9][0-9]{1,14}$`,
+	// password is registered by validation.RegisterDefaultValidators
+	"password": `^(?=.*[A-Z])(?=.*[a-z])(?=.*[0-9]).{8,}$`,
+}
+
+// schemas generates schemas from Go types, collecting named structs as components.
+type schemas struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemas() *schemas {
+	return &schemas{components: map[string]*Schema{}, names: map[reflect.Type]string{}}
+}
+
+// of returns the schema of t; named structs are referenced from the components.
+// Pointers to scalars are nullable.
+func (g *schemas) of(t reflect.Type) *Schema {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		nullable = true
+	}
+
+	s := g.schema(t)
+	if typ, ok := s.Type.(string); ok && nullable {
+		s.Type = []string{typ, "null"}
+	}
+	return s
+}
+
+func (g *schemas) schema(t reflect.Type) *Schema {
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		return &Schema{}
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.of(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.of(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.object(t, "json")
+		}
+		return g.ref(t)
+	default:
+		return &Schema{}
+	}
+}
+
+// ref registers the named struct t as a component and references it.
+func (g *schemas) ref(t reflect.Type) *Schema {
+	name, ok := g.names[t]
+	if !ok {
+		name = g.name(t)
+		g.names[t] = name
+		// Registered before being generated so that recursive types terminate
+		s := &Schema{}
+		g.components[name] = s
+		*s = *g.object(t, "json")
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// name is the type name, qualified by as much of its package path as needed to be unique.
+func (g *schemas) name(t reflect.Type) string {
+	name := componentName.ReplaceAllString(t.Name(), "_")
+	segments := strings.Split(t.PkgPath(), "/")
+	for i := len(segments) - 1; ; i-- {
+		if _, taken := g.components[name]; !taken || i < 0 {
+			return name
+		}
+		name = componentName.ReplaceAllString(segments[i], "_") + "." + name
+	}
+}
+
+// object describes the exported fields of struct t, named by the tag key ("json" or "query").
+func (g *schemas) object(t reflect.Type, key string) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, f := range fields(t, key) {
+		s.Properties[f.name] = f.schema(g)
+		if f.required {
+			s.Required = append(s.Required, f.name)
+		}
+	}
+	return s
+}
+
+// field is a struct field as it appears in JSON or in the query string.
+type field struct {
+	name     string
+	typ      reflect.Type
+	tag      reflect.StructTag
+	required bool
+}
+
+func (f field) schema(g *schemas) *Schema {
+	s := g.of(f.typ)
+	if def, ok := f.tag.Lookup("default"); ok && s.Ref == "" {
+		s.Default = parseValue(f.typ, def)
+	}
+	if description := f.tag.Get("doc"); description != "" && s.Ref == "" {
+		s.Description = description
+	}
+	applyRules(s, f.typ, f.tag.Get("validate"))
+	return s
+}
+
+// fields lists the fields of struct t, flattening embedded structs the way encoding/json does.
+func fields(t reflect.Type, key string) []field {
+	var result []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, _, _ := strings.Cut(sf.Tag.Get(key), ",")
+		if name == "-" {
+			continue
+		}
+
+		ft := sf.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			result = append(result, fields(ft, key)...)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		result = append(result, field{
+			name:     name,
+			typ:      sf.Type,
+			tag:      sf.Tag,
+			required: hasRule(sf.Tag.Get("validate"), "required"),
+		})
+	}
+	return result
+}
+
+func hasRule(tag, rule string) bool {
+	for _, r := range strings.Split(tag, ",") {
+		if r == "dive" {
+			return false
+		}
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// applyRules maps the validator rules of a field to schema constraints. Rules after dive apply
+// to the items of slices and the values of maps.
+func applyRules(s *Schema, t reflect.Type, tag string) {
+	if tag == "" {
+		return
+	}
+
+	for _, rule := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		if strings.Contains(rule, "|") {
+			continue
+		}
+
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if name == "dive" {
+			switch {
+			case s.Items != nil:
+				s = s.Items
+			case s.AdditionalProperties != nil:
+				s = s.AdditionalProperties
+			default:
+				return
+			}
+			t = t.Elem()
+			continue
+		}
+		if s.Ref == "" {
+			constrain(s, t, name, param)
+		}
+	}
+}
+
+func constrain(s *Schema, t reflect.Type, name, param string) {
+	if format, ok := formats[name]; ok {
+		s.Format = format
+		return
+	}
+	if pattern, ok := patterns[name]; ok {
+		s.Pattern = pattern
+		return
+	}
+
+	switch name {
+	case "oneof":
+		for _, v := range strings.Fields(param) {
+			s.Enum = append(s.Enum, parseValue(t, v))
+		}
+		return
+	case "datetime":
+		switch param {
+		case "2006-01-02":
+			s.Format = "date"
+		case time.RFC3339:
+			s.Format = "date-time"
+		}
+		return
+	}
+
+	n, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		setLength(&s.MinLength, &s.MaxLength, name, int(n))
+	case reflect.Slice, reflect.Array:
+		setLength(&s.MinItems, &s.MaxItems, name, int(n))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		switch name {
+		case "min", "gte":
+			s.Minimum = &n
+		case "max", "lte":
+			s.Maximum = &n
+		case "gt":
+			s.ExclusiveMinimum = &n
+		case "lt":
+			s.ExclusiveMaximum = &n
+		case "eq", "len":
+			s.Minimum, s.Maximum = &n, &n
+		}
+	}
+}
+
+func setLength(min, max **int, rule string, n int) {
+	switch rule {
+	case "min", "gte":
+		*min = &n
+	case "gt":
+		n++
+		*min = &n
+	case "max", "lte":
+		*max = &n
+	case "lt":
+		n--
+		*max = &n
+	case "len", "eq":
+		*min, *max = &n, &n
+	}
+}
+
+// parseValue converts a tag value to the JSON type of t, e.g. for enum and default values.
+func parseValue(t reflect.Type, value string) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n
+		}
+	case reflect.Float32, reflect.Float64:
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			return n
+		}
+	}
+	return value
+}
@@ -0,0 +1,25 @@
+package outbox
+
+import (
+	"github.com/siakup/morgan-be/libraries/events"
+	"github.com/siakup/morgan-be/libraries/publisher"
+	"go.uber.org/fx"
+)
+
+// Module provides the outbox Writer as events.Publisher and runs the Relay.
+// It requires the postgres and bunnymq modules and a *Config.
+var Module = fx.Options(
+	fx.Provide(
+		NewWriter,
+		fx.Annotate(
+			NewWriter,
+			fx.As(new(events.Publisher)),
+		),
+		fx.Annotate(
+			publisher.New,
+			fx.As(new(Confirmer)),
+		),
+		NewRelay,
+	),
+	fx.Invoke(startRelay),
+)
@@ -0,0 +1,146 @@
+// Package outbox implements the transactional outbox: events are written to outbox.messages in the
+// transaction of the domain change and a Relay publishes them to RabbitMQ afterwards.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/siakup/morgan-be/framework/postgres"
+	"github.com/siakup/morgan-be/libraries/publisher"
+)
+
+// Message is an event stored in the outbox.
+type Message struct {
+	Id            int64          `json:"id"`
+	AggregateKey  string         `json:"aggregate_key"`
+	Exchange      string         `json:"exchange"`
+	Topic         string         `json:"topic"`
+	MessageId     string         `json:"message_id"`
+	ContentType   string         `json:"content_type"`
+	Body          []byte         `json:"-"`
+	Headers       map[string]any `json:"headers"`
+	CorrelationId string         `json:"correlation_id"`
+	DeliveryMode  uint8          `json:"delivery_mode"`
+	ExpirationMs  int64          `json:"expiration_ms"`
+	Mandatory     bool           `json:"mandatory"`
+	OccurredAt    time.Time      `json:"occurred_at"`
+	Attempts      int            `json:"attempts"`
+	LastError     *string        `json:"last_error"`
+	NextAttemptAt time.Time      `json:"next_attempt_at"`
+	CreatedAt     time.Time      `json:"created_at"`
+	PublishedAt   *time.Time     `json:"published_at"`
+}
+
+// Event adapts the stored message to publisher.Event.
+func (m *Message) Event() publisher.Event {
+	return storedEvent{m}
+}
+
+type storedEvent struct {
+	m *Message
+}
+
+func (e storedEvent) Exchange() string    { return e.m.Exchange }
+func (e storedEvent) Topic() string       { return e.m.Topic }
+func (e storedEvent) MessageId() string   { return e.m.MessageId }
+func (e storedEvent) ContentType() string { return e.m.ContentType }
+func (e storedEvent) Body() []byte        { return e.m.Body }
+
+// Properties restores the properties captured when the message was written, so the message
+// is published as the usecase emitted it and the trace context of the request survives the outbox.
+func (e storedEvent) Properties() publisher.Properties {
+	return publisher.Properties{
+		Headers:       e.m.Headers,
+		DeliveryMode:  e.m.DeliveryMode,
+		Expiration:    time.Duration(e.m.ExpirationMs) * time.Millisecond,
+		CorrelationId: e.m.CorrelationId,
+		Timestamp:     e.m.OccurredAt,
+		Mandatory:     e.m.Mandatory,
+	}
+}
+
+// Keyed is implemented by events that must be delivered in order relative to each other.
+// Events sharing a key are published one at a time in the order they were written.
+type Keyed interface {
+	Key() string
+}
+
+// Writer stores events in the outbox. It implements events.Publisher so usecases publish through it unchanged.
+type Writer struct {
+	db *pgxpool.Pool
+}
+
+// NewWriter creates a new Writer.
+func NewWriter(db *pgxpool.Pool) *Writer {
+	return &Writer{db: db}
+}
+
+// queryLockAggregate serializes the writers of an aggregate until their transaction ends, so a
+// message gets its id only once the previous message of the aggregate is committed. Ids, and
+// therefore relay order, then follow commit order within an aggregate, which a sequence alone
+// does not guarantee: a transaction that draws a smaller id can commit after a larger one.
+var queryLockAggregate = `SELECT pg_advisory_xact_lock(hashtextextended(@aggregate_key, 0))`
+
+var queryInsert = `
+	INSERT INTO outbox.messages (
+		aggregate_key, exchange, topic, message_id, content_type, body, headers,
+		correlation_id, delivery_mode, expiration_ms, mandatory, occurred_at
+	) VALUES (
+		@aggregate_key, @exchange, @topic, @message_id, @content_type, @body, @headers,
+		@correlation_id, @delivery_mode, @expiration_ms, @mandatory, @occurred_at
+	)
+`
+
+// Publish writes the event to the outbox. Call it inside postgres.Transactor.WithinTx so the
+// message commits or rolls back together with the domain change; the transaction then holds the
+// lock of the event's aggregate until it ends, which keeps the messages of an aggregate in order.
+// Outside a transaction the lock is released as soon as it is taken and the order is not guaranteed.
+func (w *Writer) Publish(ctx context.Context, event publisher.Event) error {
+	key := event.MessageId()
+	if keyed, ok := event.(Keyed); ok && keyed.Key() != "" {
+		key = keyed.Key()
+	}
+
+	var props publisher.Properties
+	if e, ok := event.(publisher.PropertiesEvent); ok {
+		props = e.Properties()
+	}
+
+	// Headers and defaults are resolved now, while ctx still carries the request's trace
+	msg, mandatory := publisher.Publishing(ctx, event)
+	headers := map[string]any(msg.Headers)
+	if headers == nil {
+		headers = map[string]any{}
+	}
+	var expiration int64
+	if props.Expiration > 0 {
+		expiration = max(props.Expiration.Milliseconds(), 1)
+	}
+
+	conn := postgres.Conn(ctx, w.db)
+	if _, err := conn.Exec(ctx, queryLockAggregate, pgx.NamedArgs{"aggregate_key": key}); err != nil {
+		return fmt.Errorf("outbox: lock %s: %w", key, err)
+	}
+	if _, err := conn.Exec(ctx, queryInsert, pgx.NamedArgs{
+		"aggregate_key":  key,
+		"exchange":       event.Exchange(),
+		"topic":          event.Topic(),
+		"message_id":     event.MessageId(),
+		"content_type":   event.ContentType(),
+		"body":           event.Body(),
+		"headers":        headers,
+		"correlation_id": msg.CorrelationId,
+		"delivery_mode":  int16(msg.DeliveryMode),
+		"expiration_ms":  expiration,
+		"mandatory":      mandatory,
+		"occurred_at":    msg.Timestamp,
+	}); err != nil {
+		return fmt.Errorf("outbox: store %s: %w", event.Topic(), err)
+	}
+
+	return nil
+}
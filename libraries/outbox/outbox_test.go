@@ -0,0 +1,83 @@
+package outbox
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/siakup/morgan-be/libraries/publisher"
+)
+
+func TestBackoff(t *testing.T) {
+	base, max := time.Second, time.Minute
+
+	cases := map[int]time.Duration{
+		1:  time.Second,
+		2:  2 * time.Second,
+		3:  4 * time.Second,
+		6:  32 * time.Second,
+		7:  time.Minute,
+		50: time.Minute,
+	}
+	for attempts, want := range cases {
+		if got := backoff(attempts, base, max); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+}
+
+func TestConfigWithDefaults(t *testing.T) {
+	cfg := Config{BatchSize: 5}.WithDefaults()
+
+	if cfg.BatchSize != 5 {
+		t.Errorf("expected configured batch size to be kept, got %d", cfg.BatchSize)
+	}
+	if cfg.PollInterval != time.Second || cfg.MaxBackoff != 5*time.Minute || cfg.StuckAfter != 10 || cfg.ClaimTTL != 5*time.Minute {
+		t.Errorf("unexpected defaults: %+v", cfg)
+	}
+}
+
+func TestMessageEvent(t *testing.T) {
+	m := &Message{Exchange: "morgan.events", Topic: "role.created", MessageId: "m1", ContentType: "application/json", Body: []byte(`{}`)}
+	event := m.Event()
+
+	if event.Exchange() != m.Exchange || event.Topic() != m.Topic || event.MessageId() != m.MessageId || string(event.Body()) != "{}" {
+		t.Errorf("stored event does not mirror the message: %+v", event)
+	}
+}
+
+func TestMessageProperties(t *testing.T) {
+	occurred := time.Date(2026, 2, 18, 9, 0, 0, 0, time.UTC)
+	m := &Message{
+		Headers:       map[string]any{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		CorrelationId: "trace-1",
+		DeliveryMode:  2,
+		ExpirationMs:  1500,
+		Mandatory:     true,
+		OccurredAt:    occurred,
+		CreatedAt:     occurred.Add(time.Second),
+	}
+
+	event, ok := m.Event().(publisher.PropertiesEvent)
+	if !ok {
+		t.Fatal("expected the stored event to carry properties")
+	}
+
+	want := publisher.Properties{
+		Headers:       m.Headers,
+		DeliveryMode:  2,
+		Expiration:    1500 * time.Millisecond,
+		CorrelationId: "trace-1",
+		Timestamp:     occurred,
+		Mandatory:     true,
+	}
+	if got := event.Properties(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	msg, mandatory := publisher.Publishing(context.Background(), event)
+	if !mandatory || msg.CorrelationId != "trace-1" || msg.Expiration != "1500" || !msg.Timestamp.Equal(occurred) {
+		t.Errorf("expected the restored properties on the publishing, got %+v (mandatory %v)", msg, mandatory)
+	}
+}
@@ -0,0 +1,252 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"github.com/siakup/morgan-be/libraries/publisher"
+	"go.uber.org/fx"
+)
+
+// Config tunes the relay.
+type Config struct {
+	// BatchSize is the maximum number of messages claimed per poll.
+	BatchSize int `config:"outbox_batch_size"`
+	// PollInterval is the pause between polls once the outbox is drained.
+	PollInterval time.Duration `config:"outbox_poll_interval"`
+	// BaseBackoff is the delay after the first failure; it doubles with every further failure.
+	BaseBackoff time.Duration `config:"outbox_base_backoff"`
+	// MaxBackoff caps the delay between attempts.
+	MaxBackoff time.Duration `config:"outbox_max_backoff"`
+	// StuckAfter is the number of failures after which a message is reported as stuck.
+	StuckAfter int `config:"outbox_stuck_after"`
+	// PublishTimeout bounds the wait for a broker confirm.
+	PublishTimeout time.Duration `config:"outbox_publish_timeout"`
+	// ClaimTTL is how long claimed messages stay hidden from other relays. Messages of a relay
+	// that stops before marking them are published again once it expires.
+	ClaimTTL time.Duration `config:"outbox_claim_ttl"`
+}
+
+// WithDefaults fills unset fields.
+func (c Config) WithDefaults() Config {
+	if c.BatchSize <= 0 {
+		c.BatchSize = 100
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = time.Second
+	}
+	if c.BaseBackoff <= 0 {
+		c.BaseBackoff = time.Second
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = 5 * time.Minute
+	}
+	if c.StuckAfter <= 0 {
+		c.StuckAfter = 10
+	}
+	if c.PublishTimeout <= 0 {
+		c.PublishTimeout = 10 * time.Second
+	}
+	if c.ClaimTTL <= 0 {
+		c.ClaimTTL = 5 * time.Minute
+	}
+	return c
+}
+
+// Confirmer publishes an event and waits for the broker confirm. *publisher.Publisher implements it.
+type Confirmer interface {
+	PublishConfirmed(ctx context.Context, event publisher.Event) error
+}
+
+// Relay drains the outbox to RabbitMQ.
+type Relay struct {
+	db        *pgxpool.Pool
+	publisher Confirmer
+	cfg       Config
+}
+
+// NewRelay creates a new Relay.
+func NewRelay(db *pgxpool.Pool, publisher Confirmer, cfg *Config) *Relay {
+	return &Relay{db: db, publisher: publisher, cfg: cfg.WithDefaults()}
+}
+
+// Run drains the outbox until ctx is cancelled. It polls again immediately while batches come back full.
+func (r *Relay) Run(ctx context.Context) {
+	log.Info().Msg("Outbox relay started")
+
+	for {
+		n, err := r.Drain(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("Outbox relay failed to drain")
+		}
+
+		if err == nil && n >= r.cfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Outbox relay stopped")
+			return
+		case <-time.After(r.cfg.PollInterval):
+		}
+	}
+}
+
+// Drain publishes one batch of due messages and returns how many were attempted.
+//
+// The batch is claimed in a short transaction, published without holding it and then marked in a
+// second one: successful messages as published, failed ones rescheduled with exponential backoff.
+// A message whose mark is lost is published again, so consumers must tolerate duplicates.
+func (r *Relay) Drain(ctx context.Context) (int, error) {
+	messages, err := r.claim(ctx)
+	if err != nil || len(messages) == 0 {
+		return 0, err
+	}
+
+	results := make([]error, len(messages))
+	published := 0
+	for i, m := range messages {
+		if ctx.Err() != nil {
+			break
+		}
+		results[i] = r.publish(ctx, m)
+		published = i + 1
+	}
+
+	// Record the outcome even when stopping, so published messages are not sent again.
+	if err := r.mark(context.WithoutCancel(ctx), messages[:published], results); err != nil {
+		return 0, err
+	}
+	if err := r.release(context.WithoutCancel(ctx), messages[published:]); err != nil {
+		return 0, err
+	}
+
+	return published, nil
+}
+
+// claim locks the due messages and hides them from other relays for the claim TTL.
+func (r *Relay) claim(ctx context.Context) ([]*Message, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, queryDue, pgx.NamedArgs{"limit": r.cfg.BatchSize})
+	if err != nil {
+		return nil, err
+	}
+	messages, err := scanMessages(rows)
+	if err != nil || len(messages) == 0 {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, queryClaim, pgx.NamedArgs{
+		"ids":             messageIds(messages),
+		"next_attempt_at": time.Now().Add(r.cfg.ClaimTTL),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// mark records the publish result of every message in one transaction.
+func (r *Relay) mark(ctx context.Context, messages []*Message, results []error) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for i, m := range messages {
+		if err := results[i]; err != nil {
+			attempts := m.Attempts + 1
+			logger := log.Warn()
+			if attempts >= r.cfg.StuckAfter {
+				logger = log.Error()
+			}
+			logger.Err(err).
+				Int64("outbox_id", m.Id).
+				Str("aggregate_key", m.AggregateKey).
+				Str("topic", m.Topic).
+				Int("attempts", attempts).
+				Msg("failed to publish outbox message")
+
+			if _, err := tx.Exec(ctx, queryMarkFailed, pgx.NamedArgs{
+				"id":              m.Id,
+				"last_error":      err.Error(),
+				"next_attempt_at": time.Now().Add(backoff(attempts, r.cfg.BaseBackoff, r.cfg.MaxBackoff)),
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := tx.Exec(ctx, queryMarkPublished, pgx.NamedArgs{"id": m.Id}); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// release makes claimed messages that were not attempted due again right away.
+func (r *Relay) release(ctx context.Context, messages []*Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	_, err := r.db.Exec(ctx, queryRelease, pgx.NamedArgs{"ids": messageIds(messages)})
+	return err
+}
+
+func messageIds(messages []*Message) []int64 {
+	ids := make([]int64, len(messages))
+	for i, m := range messages {
+		ids[i] = m.Id
+	}
+	return ids
+}
+
+func (r *Relay) publish(ctx context.Context, m *Message) error {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.PublishTimeout)
+	defer cancel()
+
+	return r.publisher.PublishConfirmed(ctx, m.Event())
+}
+
+// startRelay runs the relay for the lifetime of the application.
+func startRelay(lc fx.Lifecycle, relay *Relay) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				relay.Run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
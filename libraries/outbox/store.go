@@ -0,0 +1,154 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const columns = `id, aggregate_key, exchange, topic, message_id, content_type, body, headers,
+	correlation_id, delivery_mode, expiration_ms, mandatory, occurred_at,
+	attempts, last_error, next_attempt_at, created_at, published_at`
+
+func scanMessages(rows pgx.Rows) ([]*Message, error) {
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (*Message, error) {
+		var m Message
+		err := row.Scan(&m.Id, &m.AggregateKey, &m.Exchange, &m.Topic, &m.MessageId, &m.ContentType, &m.Body, &m.Headers,
+			&m.CorrelationId, &m.DeliveryMode, &m.ExpirationMs, &m.Mandatory, &m.OccurredAt,
+			&m.Attempts, &m.LastError, &m.NextAttemptAt, &m.CreatedAt, &m.PublishedAt)
+		return &m, err
+	})
+}
+
+// queryDue selects the oldest pending message of every aggregate whose retry time has come.
+// Later messages of an aggregate stay invisible until its head is published, which keeps per-aggregate order.
+// Ordering by id is only sound because Writer.Publish locks the aggregate before drawing the id: a message
+// can never commit after a message of the same aggregate with a larger id.
+// SKIP LOCKED lets several relays claim batches concurrently without claiming a message twice.
+var queryDue = `
+	WITH heads AS (
+		SELECT DISTINCT ON (aggregate_key) id
+		FROM outbox.messages
+		WHERE published_at IS NULL
+		ORDER BY aggregate_key, id
+	)
+	SELECT ` + columns + `
+	FROM outbox.messages
+	WHERE id IN (SELECT id FROM heads)
+	AND next_attempt_at <= now()
+	ORDER BY id
+	LIMIT @limit
+	FOR UPDATE SKIP LOCKED
+`
+
+// queryClaim pushes the retry time of claimed messages past the claim TTL, which keeps them out of
+// queryDue for other relays while they are published outside the claiming transaction.
+var queryClaim = `
+	UPDATE outbox.messages
+	SET next_attempt_at = @next_attempt_at
+	WHERE id = ANY(@ids)
+`
+
+var queryRelease = `
+	UPDATE outbox.messages
+	SET next_attempt_at = now()
+	WHERE id = ANY(@ids) AND published_at IS NULL
+`
+
+var queryMarkPublished = `
+	UPDATE outbox.messages
+	SET published_at = now(), last_error = NULL
+	WHERE id = @id
+`
+
+var queryMarkFailed = `
+	UPDATE outbox.messages
+	SET attempts = attempts + 1, last_error = @last_error, next_attempt_at = @next_attempt_at
+	WHERE id = @id
+`
+
+// Filter selects messages for inspection.
+type Filter struct {
+	// Stuck limits the result to pending messages that failed at least StuckAfter times.
+	Stuck      bool
+	StuckAfter int
+	// Pending limits the result to unpublished messages.
+	Pending bool
+	Limit   int
+}
+
+// Store gives the outbox command read and replay access to outbox.messages.
+type Store struct {
+	db *pgxpool.Pool
+}
+
+// NewStore creates a new Store.
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+// List returns messages matching the filter, oldest first.
+func (s *Store) List(ctx context.Context, filter Filter) ([]*Message, error) {
+	query := `SELECT ` + columns + ` FROM outbox.messages WHERE 1=1`
+	args := pgx.NamedArgs{"limit": filter.Limit}
+
+	if filter.Pending || filter.Stuck {
+		query += ` AND published_at IS NULL`
+	}
+	if filter.Stuck {
+		query += ` AND attempts >= @stuck_after`
+		args["stuck_after"] = filter.StuckAfter
+	}
+	query += ` ORDER BY id LIMIT @limit`
+
+	rows, err := s.db.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMessages(rows)
+}
+
+var queryReplay = `
+	UPDATE outbox.messages
+	SET published_at = NULL, attempts = 0, last_error = NULL, next_attempt_at = now()
+	WHERE id = ANY(@ids)
+`
+
+var queryReplayStuck = `
+	UPDATE outbox.messages
+	SET attempts = 0, last_error = NULL, next_attempt_at = now()
+	WHERE published_at IS NULL AND attempts >= @stuck_after
+`
+
+// Replay schedules the given messages for immediate (re)publication, including already published ones.
+func (s *Store) Replay(ctx context.Context, ids []int64) (int64, error) {
+	tag, err := s.db.Exec(ctx, queryReplay, pgx.NamedArgs{"ids": ids})
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ReplayStuck resets the backoff of every stuck message so the relay retries it right away.
+func (s *Store) ReplayStuck(ctx context.Context, stuckAfter int) (int64, error) {
+	tag, err := s.db.Exec(ctx, queryReplayStuck, pgx.NamedArgs{"stuck_after": stuckAfter})
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// backoff returns the delay before the next attempt after the given number of failures.
+func backoff(attempts int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		return max
+	}
+	return delay
+}
@@ -0,0 +1,102 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/siakup/morgan-be/framework/bunnymq"
+	"github.com/siakup/morgan-be/framework/bunnymq/bunnymqtest"
+	"go.uber.org/fx/fxtest"
+)
+
+// newBrokerPublisher connects a Publisher to an in-memory broker where the morgan.events
+// exchange routes ticket.* to the tickets queue and nothing else.
+func newBrokerPublisher(t *testing.T) (*Publisher, *bunnymqtest.Server) {
+	t.Helper()
+
+	server := bunnymqtest.NewServer(t)
+	lc := fxtest.NewLifecycle(t)
+	rmq, err := bunnymq.NewRabbitMQ(lc, &bunnymq.Config{
+		URL: server.URL(),
+		Topology: bunnymq.Topology{
+			Exchanges: []bunnymq.Exchange{{Name: "morgan.events"}},
+			Queues:    []bunnymq.Queue{{Name: "tickets"}},
+			Bindings:  []bunnymq.Binding{{Queue: "tickets", Exchange: "morgan.events", RoutingKey: "ticket.*"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewRabbitMQ() error = %v", err)
+	}
+	lc.RequireStart()
+	t.Cleanup(lc.RequireStop)
+
+	return New(rmq, WithPoolSize(2)), server
+}
+
+type routedEvent struct {
+	testEvent
+	topic string
+}
+
+func (e routedEvent) Topic() string          { return e.topic }
+func (e routedEvent) Properties() Properties { return Properties{Mandatory: true} }
+
+func TestPublishConfirmed_Broker(t *testing.T) {
+	p, server := newBrokerPublisher(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("Routed", func(t *testing.T) {
+		if err := p.PublishConfirmed(ctx, routedEvent{testEvent{id: "1"}, "ticket.created"}); err != nil {
+			t.Fatalf("PublishConfirmed() error = %v", err)
+		}
+		if got := server.Messages("tickets"); got != 1 {
+			t.Errorf("tickets messages = %d, want 1", got)
+		}
+	})
+
+	t.Run("MandatoryUnbound", func(t *testing.T) {
+		// The broker returns the message right before acking it; every publish must see the return.
+		for i := 0; i < 50; i++ {
+			err := p.PublishConfirmed(ctx, routedEvent{testEvent{id: "same-id"}, "role.created"})
+			if !errors.Is(err, ErrUnroutable) {
+				t.Fatalf("publish %d: PublishConfirmed() error = %v, want ErrUnroutable", i, err)
+			}
+		}
+	})
+
+	t.Run("NotMandatory", func(t *testing.T) {
+		// testEvent.Topic is role.created, which matches no binding
+		if err := p.PublishConfirmed(ctx, testEvent{id: "2"}); err != nil {
+			t.Fatalf("PublishConfirmed() error = %v, want nil without mandatory", err)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		errs := make(chan error, 20)
+		for i := 0; i < cap(errs); i++ {
+			topic := "ticket.created"
+			if i%2 == 1 {
+				topic = "role.created"
+			}
+			go func() {
+				errs <- p.PublishConfirmed(ctx, routedEvent{testEvent{id: "same-id"}, topic})
+			}()
+		}
+
+		var unroutable int
+		for i := 0; i < cap(errs); i++ {
+			switch err := <-errs; {
+			case errors.Is(err, ErrUnroutable):
+				unroutable++
+			case err != nil:
+				t.Fatalf("PublishConfirmed() error = %v", err)
+			}
+		}
+		if unroutable != cap(errs)/2 {
+			t.Errorf("unroutable = %d, want %d", unroutable, cap(errs)/2)
+		}
+	})
+}
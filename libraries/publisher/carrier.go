@@ -0,0 +1,34 @@
+package publisher
+
+import (
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// HeaderCarrier adapts AMQP headers to an OpenTelemetry TextMapCarrier so trace context
+// can be injected on publish and extracted on consume.
+type HeaderCarrier amqp.Table
+
+var _ propagation.TextMapCarrier = HeaderCarrier(nil)
+
+// Get returns the string value of the header, or "" when missing or not a string.
+func (c HeaderCarrier) Get(key string) string {
+	if v, ok := c[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+// Set stores the header.
+func (c HeaderCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+// Keys lists the header names.
+func (c HeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,36 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// sent counts published messages by exchange and outcome.
+// It records through the global MeterProvider and is a no-op until one is installed.
+var sent, _ = otel.Meter("github.com/siakup/morgan-be/libraries/publisher").Int64Counter(
+	"messaging.client.sent.messages",
+	metric.WithDescription("Messages published, by outcome (sent, nacked, unroutable, failed)."),
+	metric.WithUnit("{message}"),
+)
+
+// record counts the outcome of publishing event.
+func record(ctx context.Context, event Event, err error) {
+	outcome := "sent"
+	switch {
+	case errors.Is(err, ErrNacked):
+		outcome = "nacked"
+	case errors.Is(err, ErrUnroutable):
+		outcome = "unroutable"
+	case err != nil:
+		outcome = "failed"
+	}
+
+	sent.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("messaging.destination.name", event.Exchange()),
+		attribute.String("outcome", outcome),
+	))
+}
@@ -0,0 +1,80 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var (
+	readerOnce sync.Once
+	reader     *sdkmetric.ManualReader
+)
+
+// collectCounter installs a global MeterProvider on first use and returns the value of the
+// counter name for attrs, 0 when nothing was recorded.
+func collectCounter(t *testing.T, name string, attrs ...attribute.KeyValue) int64 {
+	t.Helper()
+
+	readerOnce.Do(func() {
+		reader = sdkmetric.NewManualReader()
+		otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	})
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	set := attribute.NewSet(attrs...)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if m.Name != name || !ok {
+				continue
+			}
+			for _, dp := range sum.DataPoints {
+				if dp.Attributes.Equals(&set) {
+					return dp.Value
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func TestRecord(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		outcome string
+	}{
+		{name: "Sent", outcome: "sent"},
+		{name: "Nacked", err: ErrNacked, outcome: "nacked"},
+		{name: "Unroutable", err: fmt.Errorf("publish: %w", ErrUnroutable), outcome: "unroutable"},
+		{name: "Failed", err: errors.New("channel closed"), outcome: "failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := []attribute.KeyValue{
+				attribute.String("messaging.destination.name", "morgan.events"),
+				attribute.String("outcome", tt.outcome),
+			}
+			before := collectCounter(t, "messaging.client.sent.messages", attrs...)
+
+			record(context.Background(), testEvent{id: "1"}, tt.err)
+
+			if got := collectCounter(t, "messaging.client.sent.messages", attrs...); got != before+1 {
+				t.Errorf("messaging.client.sent.messages{outcome=%s} = %d, want %d", tt.outcome, got, before+1)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package publisher
+
+import (
+	"context"
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/rs/zerolog/log"
+	"github.com/siakup/morgan-be/framework/bunnymq"
+)
+
+// pool hands out confirm-mode channels, one publisher at a time per channel.
+// Channels are opened lazily up to the pool size and discarded once closed.
+type pool struct {
+	slots chan struct{}
+	idle  chan *channel
+}
+
+func newPool(size int) *pool {
+	if size < 1 {
+		size = 1
+	}
+	return &pool{
+		slots: make(chan struct{}, size),
+		idle:  make(chan *channel, size),
+	}
+}
+
+// acquire waits for a free slot and returns an idle channel, opening one if none is left.
+func (p *pool) acquire(ctx context.Context, rmq *bunnymq.RabbitMQ) (*channel, error) {
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	for {
+		select {
+		case c := <-p.idle:
+			if c.ch.IsClosed() {
+				continue
+			}
+			return c, nil
+		default:
+			c, err := openChannel(rmq)
+			if err != nil {
+				<-p.slots
+				return nil, err
+			}
+			return c, nil
+		}
+	}
+}
+
+// release returns c to the pool, or closes it if publishing on it failed.
+func (p *pool) release(c *channel, err error) {
+	if err != nil || c.ch.IsClosed() {
+		_ = c.ch.Close()
+	} else {
+		p.idle <- c
+	}
+	<-p.slots
+}
+
+// publishTagHeader carries the delivery tag of a tracked mandatory message, so a return
+// can be matched with the publish it belongs to.
+const publishTagHeader = "x-publish-tag"
+
+// channel is a confirm-mode channel. One goroutine reads both its returns and its confirms,
+// so the return of a message is known before its confirm is handed to PublishConfirmed.
+type channel struct {
+	ch *amqp.Channel
+
+	mu      sync.Mutex
+	pending map[uint64]*inflight // delivery tag -> tracked publish
+}
+
+// inflight is a publish awaiting its confirm.
+type inflight struct {
+	c        *channel
+	tag      uint64
+	returned bool
+	done     chan error // Receives nil, ErrNacked, ErrUnroutable or amqp.ErrClosed
+}
+
+func openChannel(rmq *bunnymq.RabbitMQ) (*channel, error) {
+	conn := rmq.Connection()
+	if conn == nil || conn.IsClosed() {
+		return nil, amqp.ErrClosed // Connection is not ready
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	// Confirm mode lets PublishConfirmed wait for the broker's ack.
+	if err := ch.Confirm(false); err != nil {
+		_ = ch.Close()
+		return nil, err
+	}
+
+	c := &channel{ch: ch, pending: map[uint64]*inflight{}}
+
+	// Both are closed with the channel, ending the goroutine. The confirms are consumed
+	// promptly, so the buffer only smooths bursts.
+	returns := ch.NotifyReturn(make(chan amqp.Return, 16))
+	confirms := ch.NotifyPublish(make(chan amqp.Confirmation, 16))
+	go c.dispatch(returns, confirms)
+
+	return c, nil
+}
+
+// dispatch matches returns and confirms with the tracked publishes until the channel closes.
+func (c *channel) dispatch(returns <-chan amqp.Return, confirms <-chan amqp.Confirmation) {
+	for returns != nil || confirms != nil {
+		select {
+		case r, ok := <-returns:
+			if !ok {
+				returns = nil
+				continue
+			}
+			c.returned(r)
+		case confirmation, ok := <-confirms:
+			if !ok {
+				confirms = nil
+				continue
+			}
+			// The broker sends the return of a message before its confirm, and the client queues
+			// them in that order: every return preceding this confirm is already buffered.
+			c.drain(returns)
+			c.confirmed(confirmation)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for tag, f := range c.pending {
+		f.done <- amqp.ErrClosed
+		delete(c.pending, tag)
+	}
+}
+
+// drain handles the returns already received without waiting for more.
+func (c *channel) drain(returns <-chan amqp.Return) {
+	for {
+		select {
+		case r, ok := <-returns:
+			if !ok {
+				return
+			}
+			c.returned(r)
+		default:
+			return
+		}
+	}
+}
+
+func (c *channel) returned(r amqp.Return) {
+	if tag, ok := r.Headers[publishTagHeader].(int64); ok {
+		c.mu.Lock()
+		if f, ok := c.pending[uint64(tag)]; ok {
+			f.returned = true
+		}
+		c.mu.Unlock()
+	}
+
+	log.Warn().
+		Str("exchange", r.Exchange).
+		Str("routing_key", r.RoutingKey).
+		Str("msg_id", r.MessageId).
+		Str("reason", r.ReplyText).
+		Msg("Message returned as unroutable")
+}
+
+func (c *channel) confirmed(confirmation amqp.Confirmation) {
+	c.mu.Lock()
+	f, ok := c.pending[confirmation.DeliveryTag]
+	delete(c.pending, confirmation.DeliveryTag)
+	c.mu.Unlock()
+	if !ok {
+		return // Not tracked: published by Publish
+	}
+
+	switch {
+	case !confirmation.Ack:
+		f.done <- ErrNacked
+	case f.returned:
+		f.done <- ErrUnroutable
+	default:
+		f.done <- nil
+	}
+}
+
+// publish sends msg; with track set, the returned inflight reports the confirm of it.
+// The caller must hold the channel, so the next delivery tag is the one of msg.
+func (c *channel) publish(ctx context.Context, exchange, key string, mandatory, track bool, msg amqp.Publishing) (*inflight, error) {
+	var f *inflight
+	if track {
+		f = &inflight{c: c, tag: c.ch.GetNextPublishSeqNo(), done: make(chan error, 1)}
+		if mandatory {
+			headers := amqp.Table{publishTagHeader: int64(f.tag)}
+			for k, v := range msg.Headers {
+				headers[k] = v
+			}
+			msg.Headers = headers
+		}
+
+		c.mu.Lock()
+		c.pending[f.tag] = f
+		c.mu.Unlock()
+	}
+
+	err := c.ch.PublishWithContext(
+		ctx,
+		exchange,
+		key,
+		mandatory, // mandatory
+		false,     // immediate
+		msg,
+	)
+	if err != nil && track {
+		c.forget(f.tag)
+	}
+	return f, err
+}
+
+// forget stops tracking a publish.
+func (c *channel) forget(tag uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.pending, tag)
+}
+
+// wait blocks until the broker confirmed the publish or ctx is done.
+func (f *inflight) wait(ctx context.Context) error {
+	select {
+	case err := <-f.done:
+		return err
+	case <-ctx.Done():
+		f.c.forget(f.tag)
+		return ctx.Err()
+	}
+}
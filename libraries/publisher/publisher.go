@@ -3,12 +3,15 @@ package publisher
 import (
 	"context"
 	"errors"
-	"sync"
+	"strconv"
 	"time"
 
+	"github.com/google/uuid"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/rs/zerolog/log"
 	"github.com/siakup/morgan-be/framework/bunnymq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Event represents a message to be published to RabbitMQ.
@@ -20,117 +23,183 @@ type Event interface {
 	Body() []byte
 }
 
+// Properties are the optional AMQP properties of an event.
+type Properties struct {
+	// Headers are sent as-is; the trace context of the publishing context is added to them.
+	Headers amqp.Table
+	// DeliveryMode defaults to amqp.Persistent.
+	DeliveryMode uint8
+	// Expiration discards the message if it is not consumed in time. Zero keeps it forever.
+	Expiration time.Duration
+	// CorrelationId ties the message to the request that produced it.
+	CorrelationId string
+	// Timestamp defaults to the publishing time.
+	Timestamp time.Time
+	// Mandatory makes the broker return the message when no queue is bound to its routing key.
+	// PublishConfirmed then fails with ErrUnroutable; it adds the x-publish-tag header to
+	// tell the returned message from others.
+	Mandatory bool
+}
+
+// PropertiesEvent is implemented by events that carry AMQP properties beyond Event.
+type PropertiesEvent interface {
+	Event
+	Properties() Properties
+}
+
+// DefaultPoolSize is the number of channels a Publisher publishes through concurrently.
+const DefaultPoolSize = 8
+
 // Publisher handles publishing messages to RabbitMQ with auto-reconnect logic.
+// Messages are published in confirm mode over a pool of channels.
 type Publisher struct {
-	rmq *bunnymq.RabbitMQ
-	mu  sync.Mutex
-	ch  *amqp.Channel
+	rmq  *bunnymq.RabbitMQ
+	pool *pool
+}
+
+// Option configures a Publisher.
+type Option func(*Publisher)
+
+// WithPoolSize sets the number of channels used for concurrent publishing.
+func WithPoolSize(size int) Option {
+	return func(p *Publisher) {
+		p.pool = newPool(size)
+	}
 }
 
 // New creates a new Publisher instance.
-func New(rmq *bunnymq.RabbitMQ) *Publisher {
-	return &Publisher{
-		rmq: rmq,
+func New(rmq *bunnymq.RabbitMQ, opts ...Option) *Publisher {
+	p := &Publisher{
+		rmq:  rmq,
+		pool: newPool(DefaultPoolSize),
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
-// Publish publishes an event to RabbitMQ.
+// Publish publishes an event to RabbitMQ without waiting for the broker confirm.
 // It automatically attempts to reconnect if the channel is closed.
-func (p *Publisher) Publish(ctx context.Context, event Event) error {
+func (p *Publisher) Publish(ctx context.Context, event Event) (err error) {
+	ctx, span := startSpan(ctx, event)
+	defer func() {
+		endSpan(span, err)
+		record(ctx, event, err)
+	}()
+
+	_, err = p.publish(ctx, event, false)
+	return err
+}
+
+// PublishConfirmed publishes an event and waits until the broker confirms it.
+// A nack from the broker is reported as ErrNacked, a returned mandatory message as ErrUnroutable.
+func (p *Publisher) PublishConfirmed(ctx context.Context, event Event) (err error) {
+	ctx, span := startSpan(ctx, event)
+	defer func() {
+		endSpan(span, err)
+		record(ctx, event, err)
+	}()
+
+	f, err := p.publish(ctx, event, true)
+	if err != nil {
+		return err
+	}
+
+	return f.wait(ctx)
+}
+
+// ErrNacked is returned by PublishConfirmed when the broker rejects a message.
+var ErrNacked = errors.New("publisher: message nacked by broker")
+
+// ErrUnroutable is returned by PublishConfirmed when a mandatory message matched no queue.
+var ErrUnroutable = errors.New("publisher: message returned as unroutable")
+
+// publish sends event on a pooled channel. With track set, the returned inflight reports
+// the confirm of the message, and whether a mandatory message was returned.
+func (p *Publisher) publish(ctx context.Context, event Event, track bool) (*inflight, error) {
 	const maxRetries = 1
 	var err error
 
+	msg, mandatory := Publishing(ctx, event)
+
 	for i := 0; i <= maxRetries; i++ {
-		if err = p.ensureChannel(); err != nil {
+		var ch *channel
+		if ch, err = p.pool.acquire(ctx, p.rmq); err != nil {
 			// If we can't get a channel, wait a bit and retry if we have retries left
-			if i < maxRetries {
+			if i < maxRetries && errors.Is(err, amqp.ErrClosed) {
 				select {
 				case <-ctx.Done():
-					return ctx.Err()
+					return nil, ctx.Err()
 				case <-time.After(100 * time.Millisecond):
 					continue
 				}
 			}
-			return err
-		}
-
-		// Try to publish
-		p.mu.Lock()
-		ch := p.ch
-		p.mu.Unlock()
-
-		msg := amqp.Publishing{
-			MessageId:   event.MessageId(),
-			ContentType: event.ContentType(),
-			Body:        event.Body(),
+			return nil, err
 		}
 
-		err = ch.PublishWithContext(
-			ctx,
-			event.Exchange(),
-			event.Topic(),
-			false, // mandatory
-			false, // immediate
-			msg,
-		)
+		var f *inflight
+		f, err = ch.publish(ctx, event.Exchange(), event.Topic(), mandatory, track, msg)
+		p.pool.release(ch, err)
 		if err == nil {
-			return nil
+			return f, nil
 		}
 
 		// Check if error is due to channel closure
 		if errors.Is(err, amqp.ErrClosed) {
 			log.Warn().Msg("Publisher channel closed, attempting to reopen and retry...")
-			p.resetChannel()
 			continue
 		}
 
 		// Other errors, return immediately
-		return err
+		return nil, err
 	}
 
-	return err
+	return nil, err
 }
 
-// ensureChannel ensures that there is an open channel available.
-func (p *Publisher) ensureChannel() error {
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
-	if p.ch != nil && !p.ch.IsClosed() {
-		return nil
+// Publishing builds the AMQP message for event and reports whether it is mandatory.
+// The trace context of ctx is injected into the headers; without an active span in ctx,
+// a trace context already carried by the event is kept.
+func Publishing(ctx context.Context, event Event) (amqp.Publishing, bool) {
+	var props Properties
+	if e, ok := event.(PropertiesEvent); ok {
+		props = e.Properties()
 	}
 
-	conn := p.rmq.Connection()
-	if conn == nil || conn.IsClosed() {
-		return amqp.ErrClosed // Connection is not ready
+	headers := amqp.Table{}
+	for k, v := range props.Headers {
+		headers[k] = v
 	}
-
-	ch, err := conn.Channel()
-	if err != nil {
-		return err
+	if _, ok := headers["traceparent"]; !ok || trace.SpanContextFromContext(ctx).IsValid() {
+		otel.GetTextMapPropagator().Inject(ctx, HeaderCarrier(headers))
+	}
+	if len(headers) == 0 {
+		headers = nil
 	}
 
-	p.ch = ch
-
-	// Register NotifyClose to proactively reset p.ch when the channel closes.
-	// This avoids trying to publish to a closed channel and failing.
-	go func() {
-		closeChan := make(chan *amqp.Error, 1)
-		ch.NotifyClose(closeChan)
-
-		// Wait for channel closure
-		<-closeChan
-
-		// Reset the channel reference
-		p.resetChannel()
-	}()
-
-	return nil
-}
+	msg := amqp.Publishing{
+		Headers:       headers,
+		MessageId:     event.MessageId(),
+		ContentType:   event.ContentType(),
+		DeliveryMode:  props.DeliveryMode,
+		CorrelationId: props.CorrelationId,
+		Timestamp:     props.Timestamp,
+		Body:          event.Body(),
+	}
+	if msg.MessageId == "" {
+		msg.MessageId = uuid.NewString()
+	}
+	if msg.DeliveryMode == 0 {
+		msg.DeliveryMode = amqp.Persistent
+	}
+	if msg.Timestamp.IsZero() {
+		msg.Timestamp = time.Now().UTC()
+	}
+	if props.Expiration > 0 {
+		// RabbitMQ expects the per-message TTL in milliseconds
+		msg.Expiration = strconv.FormatInt(max(props.Expiration.Milliseconds(), 1), 10)
+	}
 
-// resetChannel clears the current channel so a new one can be created.
-func (p *Publisher) resetChannel() {
-	p.mu.Lock()
-	defer p.mu.Unlock()
-	p.ch = nil
+	return msg, props.Mandatory
 }
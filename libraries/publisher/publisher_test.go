@@ -0,0 +1,216 @@
+package publisher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type testEvent struct {
+	id    string
+	props *Properties
+}
+
+func (e testEvent) Exchange() string    { return "morgan.events" }
+func (e testEvent) Topic() string       { return "role.created" }
+func (e testEvent) MessageId() string   { return e.id }
+func (e testEvent) ContentType() string { return "application/json" }
+func (e testEvent) Body() []byte        { return []byte("{}") }
+
+type testPropertiesEvent struct {
+	testEvent
+}
+
+func (e testPropertiesEvent) Properties() Properties { return *e.props }
+
+func TestPublishing(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		msg, mandatory := Publishing(context.Background(), testEvent{id: "msg-1"})
+
+		if msg.MessageId != "msg-1" {
+			t.Errorf("expected message id 'msg-1', got '%s'", msg.MessageId)
+		}
+		if msg.DeliveryMode != amqp.Persistent {
+			t.Errorf("expected persistent delivery, got %d", msg.DeliveryMode)
+		}
+		if msg.Timestamp.IsZero() {
+			t.Error("expected timestamp to be set")
+		}
+		if msg.Expiration != "" || mandatory {
+			t.Error("expected no expiration and not mandatory")
+		}
+	})
+
+	t.Run("Generated Message Id", func(t *testing.T) {
+		msg, _ := Publishing(context.Background(), testEvent{})
+		if msg.MessageId == "" {
+			t.Error("expected a generated message id")
+		}
+	})
+
+	t.Run("Properties", func(t *testing.T) {
+		at := time.Date(2026, 2, 1, 8, 0, 0, 0, time.UTC)
+		props := &Properties{
+			Headers:       amqp.Table{"x-tenant": "inst-1"},
+			DeliveryMode:  amqp.Transient,
+			Expiration:    1500 * time.Millisecond,
+			CorrelationId: "trace-1",
+			Timestamp:     at,
+			Mandatory:     true,
+		}
+		msg, mandatory := Publishing(context.Background(), testPropertiesEvent{testEvent{id: "msg-1", props: props}})
+
+		if msg.Headers["x-tenant"] != "inst-1" {
+			t.Errorf("expected header to be kept, got %v", msg.Headers)
+		}
+		if msg.DeliveryMode != amqp.Transient {
+			t.Errorf("expected transient delivery, got %d", msg.DeliveryMode)
+		}
+		if msg.Expiration != "1500" {
+			t.Errorf("expected expiration '1500', got '%s'", msg.Expiration)
+		}
+		if msg.CorrelationId != "trace-1" || !msg.Timestamp.Equal(at) {
+			t.Error("expected correlation id and timestamp to be kept")
+		}
+		if !mandatory {
+			t.Error("expected mandatory")
+		}
+		if _, ok := props.Headers["traceparent"]; ok {
+			t.Error("expected event headers to be left untouched")
+		}
+	})
+
+	t.Run("Trace Context", func(t *testing.T) {
+		previous := otel.GetTextMapPropagator()
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+		defer otel.SetTextMapPropagator(previous)
+
+		traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceId,
+			SpanID:     spanId,
+			TraceFlags: trace.FlagsSampled,
+		}))
+
+		msg, _ := Publishing(ctx, testEvent{id: "msg-1"})
+		want := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+		if msg.Headers["traceparent"] != want {
+			t.Errorf("expected traceparent '%s', got %v", want, msg.Headers["traceparent"])
+		}
+
+		// The active span wins over a trace context captured earlier, e.g. by the outbox.
+		props := &Properties{Headers: amqp.Table{"traceparent": "stored"}}
+		msg, _ = Publishing(ctx, testPropertiesEvent{testEvent{id: "msg-1", props: props}})
+		if msg.Headers["traceparent"] != want {
+			t.Errorf("expected traceparent '%s', got %v", want, msg.Headers["traceparent"])
+		}
+
+		// Without an active span the stored trace context is kept.
+		msg, _ = Publishing(context.Background(), testPropertiesEvent{testEvent{id: "msg-1", props: props}})
+		if msg.Headers["traceparent"] != "stored" {
+			t.Errorf("expected stored traceparent, got %v", msg.Headers["traceparent"])
+		}
+	})
+}
+
+func TestStartSpan_ContinuesStoredTrace(t *testing.T) {
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(previous)
+
+	props := &Properties{Headers: amqp.Table{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}}
+	ctx, span := startSpan(context.Background(), testPropertiesEvent{testEvent{id: "msg-1", props: props}})
+	defer span.End()
+
+	if got := trace.SpanContextFromContext(ctx).TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("expected the stored trace to be continued, got %s", got)
+	}
+}
+
+func TestHeaderCarrier(t *testing.T) {
+	c := HeaderCarrier(amqp.Table{"count": int32(1)})
+	c.Set("traceparent", "value")
+
+	if c.Get("traceparent") != "value" {
+		t.Errorf("expected 'value', got '%s'", c.Get("traceparent"))
+	}
+	if c.Get("count") != "" {
+		t.Error("expected non-string header to read as empty")
+	}
+	if len(c.Keys()) != 2 {
+		t.Errorf("expected 2 keys, got %d", len(c.Keys()))
+	}
+}
+
+func TestChannel_Dispatch(t *testing.T) {
+	tracked := func(c *channel, tag uint64) *inflight {
+		f := &inflight{c: c, tag: tag, done: make(chan error, 1)}
+		c.pending[tag] = f
+		return f
+	}
+	tagged := func(tag uint64) amqp.Return {
+		return amqp.Return{Headers: amqp.Table{publishTagHeader: int64(tag)}}
+	}
+
+	// Each return is queued right before the confirm of its message, as the client does.
+	for i := 0; i < 100; i++ {
+		c := &channel{pending: map[uint64]*inflight{}}
+		returned, acked, nacked, closed := tracked(c, 1), tracked(c, 2), tracked(c, 3), tracked(c, 4)
+
+		returns := make(chan amqp.Return, 2)
+		confirms := make(chan amqp.Confirmation, 3)
+		done := make(chan struct{})
+		go func() {
+			c.dispatch(returns, confirms)
+			close(done)
+		}()
+
+		returns <- tagged(1)
+		confirms <- amqp.Confirmation{DeliveryTag: 1, Ack: true}
+		confirms <- amqp.Confirmation{DeliveryTag: 2, Ack: true}
+		confirms <- amqp.Confirmation{DeliveryTag: 3, Ack: false}
+		confirms <- amqp.Confirmation{DeliveryTag: 5, Ack: true} // Published by Publish, not tracked
+		close(returns)
+		close(confirms)
+		<-done
+
+		for _, tt := range []struct {
+			f    *inflight
+			want error
+		}{
+			{f: returned, want: ErrUnroutable},
+			{f: acked, want: nil},
+			{f: nacked, want: ErrNacked},
+			{f: closed, want: amqp.ErrClosed},
+		} {
+			if err := tt.f.wait(context.Background()); err != tt.want {
+				t.Fatalf("run %d: wait() of tag %d = %v, want %v", i, tt.f.tag, err, tt.want)
+			}
+		}
+		if len(c.pending) != 0 {
+			t.Fatalf("run %d: pending = %v, want none", i, c.pending)
+		}
+	}
+}
+
+func TestInflight_WaitCanceled(t *testing.T) {
+	c := &channel{pending: map[uint64]*inflight{}}
+	f := &inflight{c: c, tag: 1, done: make(chan error, 1)}
+	c.pending[1] = f
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := f.wait(ctx); err != context.Canceled {
+		t.Errorf("wait() = %v, want context.Canceled", err)
+	}
+	if len(c.pending) != 0 {
+		t.Errorf("pending = %v, want the canceled publish forgotten", c.pending)
+	}
+}
@@ -0,0 +1,43 @@
+package publisher
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/siakup/morgan-be/libraries/publisher")
+
+// startSpan starts the producer span of event. Without an active span in ctx, e.g. in the
+// outbox relay, the span continues the trace context stored in the event headers.
+func startSpan(ctx context.Context, event Event) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		if e, ok := event.(PropertiesEvent); ok {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, HeaderCarrier(e.Properties().Headers))
+		}
+	}
+
+	return tracer.Start(ctx, "send "+event.Exchange(),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemRabbitMQ,
+			semconv.MessagingOperationTypeSend,
+			semconv.MessagingOperationName("send"),
+			semconv.MessagingDestinationName(event.Exchange()),
+			semconv.MessagingRabbitMQDestinationRoutingKey(event.Topic()),
+			semconv.MessagingMessageID(event.MessageId()),
+			semconv.MessagingMessageBodySize(len(event.Body())),
+		),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
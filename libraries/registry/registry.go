@@ -0,0 +1,197 @@
+// Package registry collects feature modules contributed through the Fx "modules" group,
+// filters them by configuration and contributes the enabled ones to the Fiber "routers" group.
+package registry
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+	fiberfx "github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"go.uber.org/fx"
+)
+
+// Group is the Fx value group feature modules are provided into.
+const Group = `group:"modules"`
+
+// routersGroup is the Fiber value group the enabled modules are contributed to.
+const routersGroup = `group:"routers,flatten"`
+
+// permissionCode mirrors the valid_code_format constraint of iam.permissions.
+var permissionCode = regexp.MustCompile(`^[a-z_]+\.[a-z_*]+\.[a-z_*]+\.[a-z_]+$`)
+
+// Module describes a feature module and the routes it owns.
+type Module struct {
+	// Name identifies the module in configuration and in auth.institutions.features.
+	Name string
+	// Version is the API version the routes are served under, e.g. fiberfx.V1 for "/v1".
+	// Empty serves them unversioned, for URLs handed out to third parties such as the IdP.
+	Version string
+	// BasePath is the route prefix owned by the module, e.g. "/attendances".
+	BasePath string
+	// Permissions lists every permission code the module's routes require.
+	Permissions []string
+	// Core modules are always available regardless of institution features.
+	Core bool
+	// RateLimit limits the requests to BasePath, unless overridden by the module's entry in rate_limits.
+	RateLimit *middleware.RateLimitRule
+	// Endpoints documents the module's routes in the OpenAPI document.
+	Endpoints []openapi.Endpoint
+	// Middleware runs before every route of the module, after the rate limit and feature gate.
+	Middleware []fiber.Handler
+	// Register mounts the module's routes on a router prefixed with Version.
+	Register func(router fiber.Router)
+}
+
+// Config controls which modules are served.
+type Config struct {
+	// EnabledModules restricts the served modules to this list. Empty means all.
+	EnabledModules []string `config:"enabled_modules"`
+	// DisabledModules is subtracted from the enabled modules.
+	DisabledModules []string `config:"disabled_modules"`
+	// OpenAPIDisabled stops serving the OpenAPI document and docs UI.
+	OpenAPIDisabled bool `config:"openapi_disabled"`
+}
+
+// IsEnabled reports whether the named module should be served.
+func (c *Config) IsEnabled(name string) bool {
+	if c == nil {
+		return true
+	}
+	if slices.Contains(c.DisabledModules, name) {
+		return false
+	}
+
+	return len(c.EnabledModules) == 0 || slices.Contains(c.EnabledModules, name)
+}
+
+// Validate checks module names are unique and permission codes follow module.sub_module.page.action.
+func Validate(modules []Module) error {
+	seen := make(map[string]struct{}, len(modules))
+	for _, m := range modules {
+		if m.Name == "" {
+			return fmt.Errorf("registry: module without name (base path %q)", m.BasePath)
+		}
+		if _, ok := seen[m.Name]; ok {
+			return fmt.Errorf("registry: module %q registered twice", m.Name)
+		}
+		seen[m.Name] = struct{}{}
+
+		if m.Register == nil {
+			return fmt.Errorf("registry: module %q has no route registration", m.Name)
+		}
+
+		for _, code := range m.Permissions {
+			if !permissionCode.MatchString(code) {
+				return fmt.Errorf("registry: module %q has invalid permission code %q", m.Name, code)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RoutersParams holds the dependencies for turning modules into routers.
+type RoutersParams struct {
+	fx.In
+
+	Config  *Config                 `optional:"true"`
+	Limiter *middleware.RateLimiter `optional:"true"`
+	Modules []Module                `group:"modules"`
+}
+
+// Routers validates the contributed modules and returns a router for each enabled one.
+// Disabled modules are never mounted and therefore answer 404. The middleware chain of a
+// router is the module's rate limit (in code or configuration), middleware.Feature for
+// non-core modules, so that institutions without the feature get 403, then Module.Middleware.
+func Routers(params RoutersParams) ([]fiberfx.Router, error) {
+	if err := Validate(params.Modules); err != nil {
+		return nil, err
+	}
+
+	routers := make([]fiberfx.Router, 0, len(params.Modules))
+	for _, m := range params.Modules {
+		if !params.Config.IsEnabled(m.Name) {
+			log.Info().Str("module", m.Name).Msg("module disabled, routes not registered")
+			continue
+		}
+
+		var chain []fiber.Handler
+		if params.Limiter != nil && m.BasePath != "" {
+			var rule middleware.RateLimitRule
+			if m.RateLimit != nil {
+				rule = *m.RateLimit
+			}
+			if rule = params.Limiter.Rule(m.Name, rule); rule.Limit > 0 {
+				chain = append(chain, params.Limiter.Limit(m.Name, rule))
+			}
+		}
+		if !m.Core && m.BasePath != "" {
+			chain = append(chain, middleware.Feature(m.Name))
+		}
+		chain = append(chain, m.Middleware...)
+
+		routers = append(routers, fiberfx.Router{
+			Name:       m.Name,
+			Version:    m.Version,
+			BasePath:   m.BasePath,
+			Middleware: chain,
+			Register:   m.Register,
+		})
+	}
+
+	return routers, nil
+}
+
+// SpecParams holds the dependencies for documenting the modules.
+type SpecParams struct {
+	fx.In
+
+	App     *fiber.App
+	Config  *Config      `optional:"true"`
+	Info    openapi.Info `optional:"true"`
+	Modules []Module     `group:"modules"`
+}
+
+// NewSpec documents the routes of the enabled modules, each under its name as tag.
+func NewSpec(params SpecParams) *openapi.Spec {
+	groups := make([]openapi.Group, 0, len(params.Modules))
+	for _, m := range params.Modules {
+		if params.Config.IsEnabled(m.Name) {
+			groups = append(groups, openapi.Group{Tag: m.Name, Version: m.Version, Endpoints: m.Endpoints})
+		}
+	}
+	return openapi.NewSpec(params.App, params.Info, groups...)
+}
+
+// DocsRouter serves the OpenAPI document and docs UI, unless disabled in the configuration.
+func DocsRouter(spec *openapi.Spec, cfg *Config) []fiberfx.Router {
+	if cfg != nil && cfg.OpenAPIDisabled {
+		return nil
+	}
+	return []fiberfx.Router{{
+		Name: "openapi",
+		Register: func(router fiber.Router) {
+			openapi.Register(router, spec)
+		},
+	}}
+}
+
+// Provide annotates a constructor returning Module so that it joins the "modules" group.
+func Provide(constructor any) fx.Option {
+	return fx.Provide(fx.Annotate(constructor, fx.ResultTags(Group)))
+}
+
+// FxModule contributes the enabled feature modules, and the OpenAPI document describing them,
+// to the Fiber "routers" group.
+var FxModule = fx.Module("registry",
+	fx.Provide(
+		fx.Annotate(Routers, fx.ResultTags(routersGroup)),
+		NewSpec,
+		fx.Annotate(DocsRouter, fx.ParamTags(``, `optional:"true"`), fx.ResultTags(routersGroup)),
+	),
+)
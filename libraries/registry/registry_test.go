@@ -0,0 +1,180 @@
+package registry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	fiberfx "github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/openapi"
+)
+
+func TestConfig_IsEnabled(t *testing.T) {
+	var empty *Config
+	if !empty.IsEnabled("users") {
+		t.Error("expected nil config to enable every module")
+	}
+
+	cfg := &Config{DisabledModules: []string{"attendances"}}
+	if cfg.IsEnabled("attendances") {
+		t.Error("expected attendances to be disabled")
+	}
+	if !cfg.IsEnabled("users") {
+		t.Error("expected users to be enabled")
+	}
+
+	cfg = &Config{EnabledModules: []string{"users", "roles"}, DisabledModules: []string{"roles"}}
+	if !cfg.IsEnabled("users") {
+		t.Error("expected users to be enabled")
+	}
+	if cfg.IsEnabled("roles") {
+		t.Error("expected disabled list to win over enabled list")
+	}
+	if cfg.IsEnabled("domains") {
+		t.Error("expected modules outside the enabled list to be disabled")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	register := func(router fiber.Router) {}
+
+	t.Run("Valid", func(t *testing.T) {
+		err := Validate([]Module{
+			{Name: "roles", Permissions: []string{"roles.iam.roles.view"}, Register: register},
+			{Name: "users", Permissions: []string{"users.iam.users.view"}, Register: register},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("InvalidPermission", func(t *testing.T) {
+		err := Validate([]Module{{Name: "shift_groups", Permissions: []string{"shift_groups.view"}, Register: register}})
+		if err == nil {
+			t.Fatal("expected error for permission code without four segments")
+		}
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		err := Validate([]Module{{Name: "roles", Register: register}, {Name: "roles", Register: register}})
+		if err == nil {
+			t.Fatal("expected error for duplicate module")
+		}
+	})
+}
+
+func TestRouters(t *testing.T) {
+	app := fiber.New()
+
+	handler := func(c *fiber.Ctx) error {
+		feature, _ := c.Locals(middleware.XFeatureKey).(string)
+		return c.SendString(feature)
+	}
+
+	routers, err := Routers(RoutersParams{
+		Config: &Config{DisabledModules: []string{"domains"}},
+		Modules: []Module{
+			{Name: "users", Version: fiberfx.V1, BasePath: "/users", Core: true, Register: func(r fiber.Router) { r.Get("/users", handler) }},
+			{Name: "attendances", Version: fiberfx.V1, BasePath: "/attendances", Register: func(r fiber.Router) { r.Get("/attendances", handler) }},
+			{Name: "domains", Version: fiberfx.V1, BasePath: "/domains", Register: func(r fiber.Router) { r.Get("/domains", handler) }},
+			{Name: "redirect", BasePath: "/redirect", Core: true, Register: func(r fiber.Router) { r.Get("/redirect/:id", handler) }},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	table := fiberfx.NewRouteTable(fiberfx.RouteTableParams{App: app, Routers: routers})
+	if err := table.Mount(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		path    string
+		status  int
+		feature string
+	}{
+		{"/v1/users", http.StatusOK, ""},
+		{"/v1/attendances", http.StatusOK, "attendances"},
+		{"/v1/domains", http.StatusNotFound, ""},
+		{"/users", http.StatusNotFound, ""},
+		{"/redirect/inst-1", http.StatusOK, ""},
+	}
+
+	for _, tc := range cases {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, tc.path, nil))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.path, err)
+		}
+		if resp.StatusCode != tc.status {
+			t.Errorf("%s: expected status %d, got %d", tc.path, tc.status, resp.StatusCode)
+		}
+		if tc.status != http.StatusOK {
+			continue
+		}
+
+		body := make([]byte, 64)
+		n, _ := resp.Body.Read(body)
+		if string(body[:n]) != tc.feature {
+			t.Errorf("%s: expected feature %q, got %q", tc.path, tc.feature, string(body[:n]))
+		}
+	}
+
+	want := []fiberfx.Route{
+		{Method: http.MethodGet, Path: "/redirect/:id", Router: "redirect"},
+		{Method: http.MethodGet, Path: "/v1/attendances", Router: "attendances", Version: fiberfx.V1},
+		{Method: http.MethodGet, Path: "/v1/users", Router: "users", Version: fiberfx.V1},
+	}
+	if got := table.Routes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected route table %+v, got %+v", want, got)
+	}
+}
+
+func TestNewSpec(t *testing.T) {
+	app := fiber.New()
+	handler := func(c *fiber.Ctx) error { return nil }
+	modules := []Module{
+		{Name: "users", Version: fiberfx.V1, BasePath: "/users", Core: true,
+			Endpoints: []openapi.Endpoint{{Method: http.MethodGet, Path: "/users", Summary: "List users"}},
+			Register:  func(r fiber.Router) { r.Get("/users", handler) }},
+		{Name: "domains", Version: fiberfx.V1, BasePath: "/domains",
+			Endpoints: []openapi.Endpoint{{Method: http.MethodGet, Path: "/domains", Summary: "List domains"}},
+			Register:  func(r fiber.Router) { r.Get("/domains", handler) }},
+	}
+	cfg := &Config{DisabledModules: []string{"domains"}}
+
+	routers, err := Routers(RoutersParams{Config: cfg, Modules: modules})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	spec := NewSpec(SpecParams{App: app, Config: cfg, Info: openapi.Info{Title: "Test"}, Modules: modules})
+	routers = append(routers, DocsRouter(spec, cfg)...)
+
+	table := fiberfx.NewRouteTable(fiberfx.RouteTableParams{App: app, Routers: routers})
+	if err := table.Mount(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, openapi.SpecPath, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	doc := spec.Document()
+	if op := doc.Paths["/v1/users"]["get"]; op == nil || op.Summary != "List users" || op.Tags[0] != "users" {
+		t.Errorf("expected the documented users route, got %+v", op)
+	}
+	if _, ok := doc.Paths["/v1/domains"]; ok {
+		t.Error("expected the disabled module to be left out")
+	}
+
+	if routers := DocsRouter(spec, &Config{OpenAPIDisabled: true}); routers != nil {
+		t.Errorf("expected no docs router when disabled, got %v", routers)
+	}
+}
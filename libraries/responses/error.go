@@ -0,0 +1,157 @@
+package responses
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details.
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details object, rendered when the client prefers
+// application/problem+json. Code, Fields, RequestID and TraceID are extension members.
+type Problem struct {
+	Type      string                  `json:"type"`
+	Title     string                  `json:"title"`
+	Status    int                     `json:"status"`
+	Detail    string                  `json:"detail,omitempty"`
+	Instance  string                  `json:"instance,omitempty"`
+	Code      string                  `json:"code"`
+	Fields    []errors.FieldViolation `json:"fields,omitempty"`
+	RequestID string                  `json:"request_id,omitempty"`
+	TraceID   string                  `json:"trace_id,omitempty"`
+}
+
+// HandleError renders err as the standard error response and is the single error-rendering path
+// of the API: the Fiber ErrorHandler and the handlers of every module use it.
+//
+//   - *errors.AppError keeps its code, type, message and field violations.
+//   - *fiber.Error (unknown routes, body limits, ...) is typed after its status.
+//   - validator.ValidationErrors become a 400 VALIDATION_ERROR with a violation per failed field
+//     (untranslated; validation.ValidateStruct returns translated ones).
+//   - Any other error, including recovered panics, is a 500 with a generic message.
+//
+// The text of wrapped and unexpected errors is never sent to the client; server errors are
+// logged with the request and trace IDs returned in the response instead.
+// The response is Response (application/json), or Problem if the client accepts application/problem+json first.
+func HandleError(c *fiber.Ctx, err error) error {
+	appErr := toAppError(err)
+
+	requestID := RequestID(c)
+	traceID := TraceID(c)
+
+	if appErr.Code >= http.StatusInternalServerError {
+		log.Error().
+			Err(err).
+			Str("request_id", requestID).
+			Str("trace_id", traceID).
+			Str("method", c.Method()).
+			Str("path", c.Path()).
+			Msg("request failed")
+	}
+
+	if c.Accepts(fiber.MIMEApplicationJSON, ProblemContentType) == ProblemContentType {
+		c.Status(appErr.Code)
+		c.Set(fiber.HeaderContentType, ProblemContentType)
+		return c.JSON(Problem{
+			Type:      "about:blank",
+			Title:     http.StatusText(appErr.Code),
+			Status:    appErr.Code,
+			Detail:    appErr.Message,
+			Instance:  c.OriginalURL(),
+			Code:      string(appErr.Type),
+			Fields:    appErr.Fields,
+			RequestID: requestID,
+			TraceID:   traceID,
+		}, ProblemContentType)
+	}
+
+	return c.Status(appErr.Code).JSON(Response[any]{
+		Success: false,
+		Error: &Error{
+			Code:      string(appErr.Type),
+			Message:   appErr.Message,
+			Fields:    appErr.Fields,
+			RequestID: requestID,
+			TraceID:   traceID,
+		},
+	})
+}
+
+// toAppError maps err to the AppError to render, replacing messages that must not reach the client.
+func toAppError(err error) *errors.AppError {
+	var (
+		appErr       *errors.AppError
+		fiberErr     *fiber.Error
+		validateErrs validator.ValidationErrors
+	)
+
+	switch {
+	case stderrors.As(err, &appErr):
+		code := appErr.Code
+		if http.StatusText(code) == "" || code < http.StatusBadRequest {
+			code = http.StatusInternalServerError
+		}
+		errType := appErr.Type
+		if errType == "" {
+			errType = errors.TypeOf(code)
+		}
+		return errors.New(errType, code, messageOr(appErr.Message, code), nil).WithFields(appErr.Fields...)
+	case stderrors.As(err, &fiberErr):
+		code := fiberErr.Code
+		if http.StatusText(code) == "" {
+			code = http.StatusInternalServerError
+		}
+		message := fiberErr.Message
+		if code >= http.StatusInternalServerError {
+			message = ""
+		}
+		return errors.FromStatus(code, messageOr(message, code))
+	case stderrors.As(err, &validateErrs):
+		parts := make([]string, 0, len(validateErrs))
+		fields := make([]errors.FieldViolation, 0, len(validateErrs))
+		for _, fe := range validateErrs {
+			message := fmt.Sprintf("%s %s", fe.Field(), fe.Tag())
+			parts = append(parts, message)
+			fields = append(fields, errors.FieldViolation{Field: fe.Field(), Rule: fe.Tag(), Message: message})
+		}
+		return errors.BadRequest(strings.Join(parts, "; ")).WithFields(fields...)
+	default:
+		return errors.InternalServerError(http.StatusText(http.StatusInternalServerError))
+	}
+}
+
+func messageOr(message string, code int) string {
+	if message == "" {
+		return http.StatusText(code)
+	}
+	return message
+}
+
+// RequestID returns the ID of the request, set by the requestid middleware or the X-Request-ID header.
+func RequestID(c *fiber.Ctx) string {
+	if rid, ok := c.Locals("requestid").(string); ok && rid != "" {
+		return rid
+	}
+	if rid := c.GetRespHeader(fiber.HeaderXRequestID); rid != "" {
+		return rid
+	}
+	return c.Get(fiber.HeaderXRequestID)
+}
+
+// TraceID returns the OpenTelemetry trace ID of the request, or "" if it is not traced.
+func TraceID(c *fiber.Ctx) string {
+	sc := trace.SpanContextFromContext(c.UserContext())
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
@@ -0,0 +1,145 @@
+package responses
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	libErrors "github.com/siakup/morgan-be/libraries/errors"
+)
+
+func newTestApp() *fiber.App {
+	app := fiber.New(fiber.Config{ErrorHandler: HandleError})
+	app.Use(requestid.New())
+	app.Use(recover.New())
+
+	app.Get("/app", func(c *fiber.Ctx) error {
+		return libErrors.NotFound("role not found")
+	})
+	app.Get("/wrapped", func(c *fiber.Ctx) error {
+		return fmt.Errorf("usecase: %w", libErrors.Conflict("name already taken"))
+	})
+	app.Get("/internal", func(c *fiber.Ctx) error {
+		return errors.New("pq: connection refused to 10.0.0.7")
+	})
+	app.Get("/system", func(c *fiber.Ctx) error {
+		return libErrors.Wrap(errors.New("pq: deadlock detected"), "failed to save role")
+	})
+	app.Get("/panic", func(c *fiber.Ctx) error {
+		panic("nil map write in secret handler")
+	})
+	app.Get("/validation", func(c *fiber.Ctx) error {
+		var req struct {
+			Name string `validate:"required"`
+		}
+		return validator.New().Struct(req)
+	})
+	return app
+}
+
+func doRequest(t *testing.T, app *fiber.App, path, accept string) (*http.Response, string) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if accept != "" {
+		req.Header.Set(fiber.HeaderAccept, accept)
+	}
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	return resp, string(body)
+}
+
+func TestHandleError_Envelope(t *testing.T) {
+	app := newTestApp()
+
+	tests := []struct {
+		path    string
+		status  int
+		code    string
+		message string
+	}{
+		{"/app", http.StatusNotFound, "NOT_FOUND", "role not found"},
+		{"/wrapped", http.StatusConflict, "CONFLICT_ERROR", "name already taken"},
+		{"/internal", http.StatusInternalServerError, "SYSTEM_ERROR", "Internal Server Error"},
+		{"/system", http.StatusInternalServerError, "SYSTEM_ERROR", "failed to save role"},
+		{"/panic", http.StatusInternalServerError, "SYSTEM_ERROR", "Internal Server Error"},
+		{"/validation", http.StatusBadRequest, "VALIDATION_ERROR", "Name required"},
+		{"/missing", http.StatusNotFound, "NOT_FOUND", "Cannot GET /missing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			resp, raw := doRequest(t, app, tt.path, "")
+			if resp.StatusCode != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, resp.StatusCode)
+			}
+			if ct := resp.Header.Get(fiber.HeaderContentType); !strings.HasPrefix(ct, fiber.MIMEApplicationJSON) {
+				t.Errorf("expected JSON content type, got %q", ct)
+			}
+
+			var body Response[any]
+			if err := json.Unmarshal([]byte(raw), &body); err != nil {
+				t.Fatalf("invalid body %q: %v", raw, err)
+			}
+			if body.Success || body.Error == nil {
+				t.Fatalf("expected an error envelope, got %s", raw)
+			}
+			if body.Error.Code != tt.code || body.Error.Message != tt.message {
+				t.Errorf("expected %s %q, got %s %q", tt.code, tt.message, body.Error.Code, body.Error.Message)
+			}
+			if body.Error.RequestID == "" || body.Error.RequestID != resp.Header.Get(fiber.HeaderXRequestID) {
+				t.Errorf("expected request id %q, got %q", resp.Header.Get(fiber.HeaderXRequestID), body.Error.RequestID)
+			}
+			for _, leaked := range []string{"pq:", "10.0.0.7", "secret handler"} {
+				if strings.Contains(raw, leaked) {
+					t.Errorf("response leaks internal error text %q: %s", leaked, raw)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleError_Problem(t *testing.T) {
+	app := newTestApp()
+
+	resp, raw := doRequest(t, app, "/app?x=1", "application/problem+json, application/json;q=0.5")
+	if ct := resp.Header.Get(fiber.HeaderContentType); ct != ProblemContentType {
+		t.Fatalf("expected %s, got %q", ProblemContentType, ct)
+	}
+
+	var problem Problem
+	if err := json.Unmarshal([]byte(raw), &problem); err != nil {
+		t.Fatalf("invalid body %q: %v", raw, err)
+	}
+	want := Problem{
+		Type:      "about:blank",
+		Title:     "Not Found",
+		Status:    http.StatusNotFound,
+		Detail:    "role not found",
+		Instance:  "/app?x=1",
+		Code:      "NOT_FOUND",
+		RequestID: resp.Header.Get(fiber.HeaderXRequestID),
+	}
+	if !reflect.DeepEqual(problem, want) {
+		t.Errorf("expected %+v, got %+v", want, problem)
+	}
+
+	// JSON stays the default for clients accepting anything
+	resp, _ = doRequest(t, app, "/app", "*/*")
+	if ct := resp.Header.Get(fiber.HeaderContentType); !strings.HasPrefix(ct, fiber.MIMEApplicationJSON) {
+		t.Errorf("expected JSON for */*, got %q", ct)
+	}
+}
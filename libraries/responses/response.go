@@ -1,5 +1,7 @@
 package responses
 
+import "github.com/siakup/morgan-be/libraries/errors"
+
 // Response represents a standard API response container.
 type Response[T any] struct {
 	Success bool   `json:"success"`
@@ -10,9 +12,14 @@ type Response[T any] struct {
 }
 
 // Error represents standard error details.
+// Fields lists the rejected fields of invalid requests.
+// RequestID and TraceID identify the failed request in logs and traces.
 type Error struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Code      string                  `json:"code"`
+	Message   string                  `json:"message"`
+	Fields    []errors.FieldViolation `json:"fields,omitempty"`
+	RequestID string                  `json:"request_id,omitempty"`
+	TraceID   string                  `json:"trace_id,omitempty"`
 }
 
 // Meta represents pagination or other metadata.
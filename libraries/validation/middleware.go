@@ -1,9 +1,8 @@
 package validation
 
 import (
-    "net/http"
-
     "github.com/gofiber/fiber/v2"
+    "github.com/siakup/morgan-be/libraries/errors"
     "github.com/siakup/morgan-be/libraries/responses"
 )
 
@@ -13,11 +12,11 @@ func ValidateBody(factory func() interface{}) fiber.Handler {
     return func(c *fiber.Ctx) error {
         dto := factory()
         if err := c.BodyParser(dto); err != nil {
-            return c.Status(http.StatusBadRequest).JSON(responses.Fail("VALIDATION_ERROR", "invalid JSON body"))
+            return responses.HandleError(c, errors.BadRequest("invalid JSON body"))
         }
 
-        if appErr := ValidateStruct(dto); appErr != nil {
-            return c.Status(appErr.Code).JSON(responses.Fail(string(appErr.Type), appErr.Message))
+        if appErr := ValidateStruct(dto, c.Get(fiber.HeaderAcceptLanguage)); appErr != nil {
+            return responses.HandleError(c, appErr)
         }
 
         c.Locals(ValidatedBodyKey, dto)
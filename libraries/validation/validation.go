@@ -2,29 +2,55 @@ package validation
 
 import (
     "fmt"
+    "reflect"
     "regexp"
+    "sort"
+    "strconv"
     "strings"
 
     "github.com/go-playground/locales/en"
+    "github.com/go-playground/locales/id"
     ut "github.com/go-playground/universal-translator"
     enTranslations "github.com/go-playground/validator/v10/translations/en"
+    idTranslations "github.com/go-playground/validator/v10/translations/id"
     "github.com/go-playground/validator/v10"
     "github.com/google/uuid"
     "github.com/siakup/morgan-be/libraries/errors"
 )
 
 var Validate *validator.Validate
+
+// Translator is the English translator, used when no requested language is supported.
 var Translator ut.Translator
 
+// Universal holds the translators of every supported language (en, id).
+var Universal *ut.UniversalTranslator
+
+// customMessages are the translations of the validators registered by RegisterDefaultValidators.
+var customMessages = map[string]map[string]string{
+    "en": {
+        "uuid":     "{0} must be a valid UUID",
+        "password": "{0} must be at least 8 characters long and contain an uppercase letter, a lowercase letter and a digit",
+    },
+    "id": {
+        "uuid":     "{0} harus berupa UUID yang valid",
+        "password": "{0} harus terdiri dari minimal 8 karakter dan mengandung huruf besar, huruf kecil, dan angka",
+    },
+}
+
 func init() {
     Validate = validator.New()
+    // Report fields by their JSON names
+    Validate.RegisterTagNameFunc(jsonFieldName)
 
     enLocale := en.New()
-    uni := ut.New(enLocale, enLocale)
-    tr, found := uni.GetTranslator("en")
-    if found {
+    Universal = ut.New(enLocale, enLocale, id.New())
+    if tr, found := Universal.GetTranslator("en"); found {
         Translator = tr
-        _ = enTranslations.RegisterDefaultTranslations(Validate, Translator)
+        _ = enTranslations.RegisterDefaultTranslations(Validate, tr)
+    }
+    if tr, found := Universal.GetTranslator("id"); found {
+        _ = idTranslations.RegisterDefaultTranslations(Validate, tr)
     }
     _ = RegisterDefaultValidators(Validate)
 }
@@ -33,7 +59,7 @@ func RegisterDefaultValidators(v *validator.Validate) error {
     if err := v.RegisterValidation("uuid", func(fl validator.FieldLevel) bool {
         val := fl.Field().String()
         if val == "" {
-            return true 
+            return true
         }
         _, err := uuid.Parse(val)
         return err == nil
@@ -54,6 +80,36 @@ func RegisterDefaultValidators(v *validator.Validate) error {
         return err
     }
 
+    return registerCustomTranslations(v)
+}
+
+// registerCustomTranslations registers customMessages for every supported language.
+func registerCustomTranslations(v *validator.Validate) error {
+    if Universal == nil {
+        return nil
+    }
+
+    for lang, messages := range customMessages {
+        tr, found := Universal.GetTranslator(lang)
+        if !found {
+            continue
+        }
+        for tag, message := range messages {
+            message := message
+            err := v.RegisterTranslation(tag, tr,
+                func(ut ut.Translator) error {
+                    return ut.Add(tag, message, true)
+                },
+                func(ut ut.Translator, fe validator.FieldError) string {
+                    t, _ := ut.T(fe.Tag(), fe.Field())
+                    return t
+                },
+            )
+            if err != nil {
+                return err
+            }
+        }
+    }
     return nil
 }
 
@@ -64,36 +120,121 @@ func RegisterCustom(fn func(*validator.Validate) error) error {
     return fn(Validate)
 }
 
-func TranslateValidationErrors(err error) string {
-    if err == nil {
+// jsonFieldName names a field after its json tag, falling back to the Go name.
+func jsonFieldName(field reflect.StructField) string {
+    name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+    switch name {
+    case "-":
         return ""
+    case "":
+        return field.Name
+    default:
+        return name
+    }
+}
+
+// TranslatorFor returns the translator of the most preferred supported language of an
+// Accept-Language header (e.g. "id-ID,id;q=0.9,en;q=0.8"), or the English Translator.
+func TranslatorFor(acceptLanguage string) ut.Translator {
+    if Universal == nil || acceptLanguage == "" {
+        return Translator
+    }
+
+    type language struct {
+        tag string
+        q   float64
+    }
+    var languages []language
+    for _, part := range strings.Split(acceptLanguage, ",") {
+        tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+        q := 1.0
+        if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+            if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+                q = parsed
+            }
+        }
+        if tag == "" || q <= 0 {
+            continue
+        }
+        languages = append(languages, language{tag: strings.ToLower(tag), q: q})
+    }
+    sort.SliceStable(languages, func(i, j int) bool { return languages[i].q > languages[j].q })
+
+    for _, l := range languages {
+        base, _, _ := strings.Cut(strings.ReplaceAll(l.tag, "_", "-"), "-")
+        if tr, found := Universal.GetTranslator(base); found {
+            return tr
+        }
+    }
+    return Translator
+}
+
+// Violations converts validator errors to field violations, with messages translated by tr.
+// Fields are named by their JSON path, e.g. "assignee.id".
+func Violations(err error, tr ut.Translator) []errors.FieldViolation {
+    ve, ok := err.(validator.ValidationErrors)
+    if !ok {
+        return nil
     }
-    if ve, ok := err.(validator.ValidationErrors); ok {
-        parts := make([]string, 0, len(ve))
-        for _, fe := range ve {
-            if Translator != nil {
-                parts = append(parts, fe.Translate(Translator))
-            } else {
-                parts = append(parts, fmt.Sprintf("%s %s", fe.Field(), fe.Tag()))
+
+    fields := make([]errors.FieldViolation, 0, len(ve))
+    for _, fe := range ve {
+        field := fe.Namespace()
+        // Drop the name of the validated struct
+        if _, rest, found := strings.Cut(field, "."); found {
+            field = rest
+        }
+
+        message := fmt.Sprintf("%s %s", fe.Field(), fe.Tag())
+        if tr != nil {
+            // Translate returns the raw validator error when the tag has no translation
+            if translated := fe.Translate(tr); translated != fe.Error() {
+                message = translated
             }
         }
-        return strings.Join(parts, "; ")
+
+        fields = append(fields, errors.FieldViolation{Field: field, Rule: fe.Tag(), Message: message})
+    }
+    return fields
+}
+
+func TranslateValidationErrors(err error) string {
+    if err == nil {
+        return ""
+    }
+    if fields := Violations(err, Translator); fields != nil {
+        return joinMessages(fields)
     }
     return err.Error()
 }
 
-func ValidateStruct(s interface{}) *errors.AppError {
+// ValidateStruct validates s and reports every failed field. Messages are in the language
+// preferred by acceptLanguage (an Accept-Language header), English by default.
+func ValidateStruct(s interface{}, acceptLanguage ...string) *errors.AppError {
     if Validate == nil {
         Validate = validator.New()
     }
 
     if err := Validate.Struct(s); err != nil {
-        msg := TranslateValidationErrors(err)
-        if msg == "" {
-            msg = err.Error()
+        tr := Translator
+        if len(acceptLanguage) > 0 {
+            tr = TranslatorFor(acceptLanguage[0])
         }
-        return errors.BadRequest(msg)
+
+        fields := Violations(err, tr)
+        if fields == nil {
+            return errors.BadRequest(err.Error())
+        }
+        return errors.BadRequest(joinMessages(fields)).WithFields(fields...)
     }
 
     return nil
 }
+
+func joinMessages(fields []errors.FieldViolation) string {
+    parts := make([]string, 0, len(fields))
+    for _, f := range fields {
+        parts = append(parts, f.Message)
+    }
+    return strings.Join(parts, "; ")
+}
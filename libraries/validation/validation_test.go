@@ -0,0 +1,121 @@
+package validation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/responses"
+)
+
+type assigneeRequest struct {
+	Id string `json:"id" validate:"required,uuid"`
+}
+
+type createRequest struct {
+	Title    string          `json:"title" validate:"required"`
+	Password string          `json:"password" validate:"password"`
+	Assignee assigneeRequest `json:"assignee"`
+}
+
+func TestTranslatorFor(t *testing.T) {
+	tests := map[string]string{
+		"":                        "en",
+		"id":                      "id",
+		"id-ID,id;q=0.9,en;q=0.8": "id",
+		"en-US,en;q=0.9,id;q=0.8": "en",
+		"fr-FR,id;q=0.7,en;q=0.5": "id",
+		"en;q=0.2,id;q=0.9":       "id",
+		"de,fr;q=0.5":             "en",
+		"id;q=0,en;q=0.1":         "en",
+		"*":                       "en",
+	}
+
+	for header, want := range tests {
+		if got := TranslatorFor(header).Locale(); got != want {
+			t.Errorf("TranslatorFor(%q) = %s, want %s", header, got, want)
+		}
+	}
+}
+
+func TestValidateStruct_Violations(t *testing.T) {
+	req := createRequest{Password: "short", Assignee: assigneeRequest{Id: "not-a-uuid"}}
+
+	t.Run("English", func(t *testing.T) {
+		appErr := ValidateStruct(&req)
+		if appErr == nil {
+			t.Fatal("expected a validation error")
+		}
+		want := []errors.FieldViolation{
+			{Field: "title", Rule: "required", Message: "title is a required field"},
+			{Field: "password", Rule: "password", Message: "password must be at least 8 characters long and contain an uppercase letter, a lowercase letter and a digit"},
+			{Field: "assignee.id", Rule: "uuid", Message: "id must be a valid UUID"},
+		}
+		if !reflect.DeepEqual(appErr.Fields, want) {
+			t.Errorf("expected %+v, got %+v", want, appErr.Fields)
+		}
+		if appErr.Code != http.StatusBadRequest || appErr.Type != errors.ErrorTypeValidation {
+			t.Errorf("expected a 400 validation error, got %d %s", appErr.Code, appErr.Type)
+		}
+		if !strings.Contains(appErr.Message, "title is a required field") {
+			t.Errorf("expected the message to list the violations, got %q", appErr.Message)
+		}
+	})
+
+	t.Run("Indonesian", func(t *testing.T) {
+		appErr := ValidateStruct(&req, "id-ID,id;q=0.9")
+		if appErr == nil {
+			t.Fatal("expected a validation error")
+		}
+		want := []string{
+			"title wajib diisi",
+			"password harus terdiri dari minimal 8 karakter dan mengandung huruf besar, huruf kecil, dan angka",
+			"id harus berupa UUID yang valid",
+		}
+		for i, f := range appErr.Fields {
+			if f.Message != want[i] {
+				t.Errorf("field %s: expected %q, got %q", f.Field, want[i], f.Message)
+			}
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		valid := createRequest{Title: "Printer", Password: "Secret123", Assignee: assigneeRequest{Id: "0b8f4a9e-2f3c-4d5e-8a6b-7c8d9e0f1a2b"}}
+		if appErr := ValidateStruct(&valid); appErr != nil {
+			t.Errorf("unexpected error: %v", appErr)
+		}
+	})
+}
+
+func TestValidateBody_Envelope(t *testing.T) {
+	app := fiber.New()
+	app.Post("/", ValidateBody(func() interface{} { return &createRequest{} }), func(c *fiber.Ctx) error {
+		return c.SendStatus(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"password":"Secret123","assignee":{"id":"0b8f4a9e-2f3c-4d5e-8a6b-7c8d9e0f1a2b"}}`))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	req.Header.Set(fiber.HeaderAcceptLanguage, "id")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", resp.StatusCode)
+	}
+
+	var body responses.Response[any]
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("invalid body: %v", err)
+	}
+	want := []errors.FieldViolation{{Field: "title", Rule: "required", Message: "title wajib diisi"}}
+	if body.Error == nil || !reflect.DeepEqual(body.Error.Fields, want) {
+		t.Errorf("expected fields %+v, got %+v", want, body.Error)
+	}
+}
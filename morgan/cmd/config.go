@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"github.com/siakup/morgan-be/framework/config"
+	internalConfig "github.com/siakup/morgan-be/morgan/config"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the application configuration",
+}
+
+var configPrint = &cobra.Command{
+	Use:   "print",
+	Short: "Print the effective configuration with the source of every key",
+	Long: "Print loads the configuration the way serve does, merging every source and applying resolvers and defaults.\n" +
+		"Secrets (Secret fields, fields tagged secret and values resolved through vault://, file://, ...) are masked.",
+	Args: cobra.NoArgs,
+	RunE: configPrintE,
+}
+
+func init() {
+	configCmd.AddCommand(configPrint)
+	root.AddCommand(configCmd)
+}
+
+func configPrintE(cmd *cobra.Command, args []string) error {
+	var (
+		cfg    *internalConfig.ApplicationConfig
+		loader *config.Loader
+	)
+
+	app := fx.New(
+		configuration(),
+		fx.NopLogger,
+		fx.Populate(&cfg, &loader),
+	)
+	if err := app.Err(); err != nil {
+		return err
+	}
+
+	return config.Dump(cmd.OutOrStdout(), cfg, loader.Provenance())
+}
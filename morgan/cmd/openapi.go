@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"github.com/spf13/cobra"
+)
+
+var openapiOutput string
+
+var openapiCmd = &cobra.Command{
+	Use:   "openapi",
+	Short: "Export the OpenAPI document",
+	Long: "OpenAPI mounts the enabled modules the way serve does, without connecting to the database, cache or broker,\n" +
+		"and writes the OpenAPI document served at " + openapi.SpecPath + ".",
+	Args: cobra.NoArgs,
+	RunE: openapiE,
+}
+
+func init() {
+	openapiCmd.Flags().StringVarP(&openapiOutput, "output", "o", "", "write the document to this file instead of stdout")
+	root.AddCommand(openapiCmd)
+}
+
+func openapiE(cmd *cobra.Command, args []string) error {
+	var spec *openapi.Spec
+	if err := mountAPI(&spec); err != nil {
+		return err
+	}
+
+	body, err := spec.JSON()
+	if err != nil {
+		return err
+	}
+	body = append(body, '\n')
+
+	if openapiOutput == "" {
+		_, err = cmd.OutOrStdout().Write(body)
+		return err
+	}
+	return os.WriteFile(openapiOutput, body, 0o644)
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/siakup/morgan-be/framework/common/logger"
+	"github.com/siakup/morgan-be/framework/config"
+	internalConfig "github.com/siakup/morgan-be/morgan/config"
+	"go.uber.org/fx"
+)
+
+// configuration loads the application config and provides the config of every module.
+// It is shared by all commands.
+func configuration() fx.Option {
+	return fx.Options(
+		logger.Module,
+		config.Module,
+
+		// supply config source & resolvers
+		fx.Supply(
+			fx.Annotated{
+				Group: "config_options",
+				Target: config.WithSources(
+					// FileSource (Base Config / Local Dev)
+					config.FileSource("config/config.json"),
+
+					// Consul KV (Dynamic Config)
+					// config.KVSource(
+					// 	// Prefix: config/users/<env>/
+					// 	fmt.Sprintf("config/users/%s/", func() string {
+					// 		env := os.Getenv("APP_ENV")
+					// 		if env == "" {
+					// 			return "dev"
+					// 		}
+					// 		return env
+					// 	}()),
+
+					// 	// Client
+					// 	config.ConsulClient(
+					// 		os.Getenv("CONSUL_HTTP_ADDR"),
+					// 		os.Getenv("CONSUL_HTTP_TOKEN"),
+					// 	),
+
+					// 	config.KVDefaultMapper(3),
+					// ).WithWatch(
+					// 	// Reload when the prefix changes (Consul blocking queries)
+					// 	config.ConsulWatch(
+					// 		os.Getenv("CONSUL_HTTP_ADDR"),
+					// 		os.Getenv("CONSUL_HTTP_TOKEN"),
+					// 		"config/users/dev/",
+					// 	),
+					// ),
+
+					// EnvSource (Highest Priority Overrides)
+					config.EnvSource("APP", config.DefaultEnvMapper()),
+				),
+			},
+
+			// Resolvers for the registry, on top of the default env://, file:// and base64://.
+			// Schemes can be chained, e.g. "base64+awssm://tls-key" decodes the fetched secret.
+			fx.Annotated{
+				Group: "config_resolvers",
+				// "sops://config/secrets.enc.yaml#postgres.password", decrypted with the age key of
+				// SOPS_AGE_KEY, SOPS_AGE_KEY_FILE or ~/.config/sops/age/keys.txt
+				Target: config.SchemeResolver{Scheme: "sops", Resolver: config.SOPSResolver()},
+			},
+			fx.Annotated{
+				Group: "config_resolvers",
+				// "awssm://prod/postgres#password", with the AWS_REGION and AWS_ACCESS_KEY_ID/AWS_SECRET_ACCESS_KEY of the environment
+				Target: config.SchemeResolver{Scheme: "awssm", Resolver: config.AWSSecretsManagerResolver()},
+			},
+			fx.Annotated{
+				Group: "config_resolvers",
+				// "gcpsm://postgres-password", in GOOGLE_CLOUD_PROJECT, authenticated by the metadata server
+				Target: config.SchemeResolver{Scheme: "gcpsm", Resolver: config.GCPSecretManagerResolver()},
+			},
+
+			// The Vault resolver ("vault://path#field") is added by vault() when VAULT_ADDR is set.
+		),
+
+		// provide used configurations
+		fx.Provide(
+			config.ProvideWatcher[internalConfig.ApplicationConfig](),
+			internalConfig.Postgres,
+			internalConfig.Redis,
+			internalConfig.RabbitMQ,
+			internalConfig.Fiber,
+			internalConfig.Consumer,
+			internalConfig.Otel,
+			internalConfig.Logger,
+			internalConfig.InternalApp,
+			internalConfig.Modules,
+			internalConfig.Outbox,
+			internalConfig.RateLimit,
+		),
+
+		// react to configuration changes without restart
+		fx.Invoke(watchLogLevel),
+
+		vault(),
+	)
+}
+
+// vault enables the "vault://path#field" resolver when VAULT_ADDR is set. It logs in with
+// AppRole (VAULT_ROLE_ID, VAULT_SECRET_ID), Kubernetes (VAULT_K8S_ROLE) or VAULT_TOKEN,
+// in that order, and keeps the token and leases renewed while the application runs.
+func vault() fx.Option {
+	addr := os.Getenv("VAULT_ADDR")
+	if addr == "" {
+		return fx.Options()
+	}
+
+	var auth config.VaultAuth
+	switch {
+	case os.Getenv("VAULT_ROLE_ID") != "":
+		auth = config.VaultAppRoleAuth(os.Getenv("VAULT_APPROLE_MOUNT"), os.Getenv("VAULT_ROLE_ID"), os.Getenv("VAULT_SECRET_ID"))
+	case os.Getenv("VAULT_K8S_ROLE") != "":
+		auth = config.VaultKubernetesAuth(os.Getenv("VAULT_K8S_MOUNT"), os.Getenv("VAULT_K8S_ROLE"), "")
+	default:
+		auth = config.VaultTokenAuth(os.Getenv("VAULT_TOKEN"))
+	}
+
+	v := config.NewVault(addr, auth, config.WithVaultNamespace(os.Getenv("VAULT_NAMESPACE")))
+
+	return fx.Options(
+		config.SupplyVault(v),
+		fx.Supply(
+			fx.Annotated{
+				Group:  "config_resolvers",
+				Target: config.SchemeResolver{Scheme: "vault", Resolver: v.Resolver()},
+			},
+		),
+	)
+}
+
+// watchLogLevel applies log level changes from the configuration sources.
+func watchLogLevel(w *config.Watcher[internalConfig.ApplicationConfig]) {
+	config.OnChange(w, func(c *internalConfig.ApplicationConfig) string { return c.Logger.Level }, logger.SetLevel)
+}
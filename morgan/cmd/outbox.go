@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/siakup/morgan-be/framework/postgres"
+	"github.com/siakup/morgan-be/libraries/outbox"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+var outboxCmd = &cobra.Command{
+	Use:   "outbox",
+	Short: "Inspect and replay outbox messages",
+}
+
+var outboxList = &cobra.Command{
+	Use:   "list",
+	Short: "List outbox messages, oldest first",
+	Args:  cobra.NoArgs,
+	RunE:  outboxListE,
+}
+
+var outboxReplay = &cobra.Command{
+	Use:   "replay [id...]",
+	Short: "Schedule messages for immediate (re)publication",
+	Long: "Replay resets the attempts and backoff of the given messages, republishing them even if they were published.\n" +
+		"With --stuck it retries every pending message that failed at least outbox_stuck_after times.",
+	RunE: outboxReplayE,
+}
+
+func init() {
+	outboxList.Flags().Bool("pending", false, "only unpublished messages")
+	outboxList.Flags().Bool("stuck", false, "only pending messages that failed at least outbox_stuck_after times")
+	outboxList.Flags().Int("limit", 50, "maximum number of messages")
+	outboxReplay.Flags().Bool("stuck", false, "replay every stuck message")
+
+	outboxCmd.AddCommand(outboxList, outboxReplay)
+	root.AddCommand(outboxCmd)
+}
+
+// withOutboxStore starts the configuration and postgres modules, runs fn and stops them again.
+func withOutboxStore(ctx context.Context, fn func(store *outbox.Store, cfg outbox.Config) error) error {
+	var (
+		db  *pgxpool.Pool
+		cfg *outbox.Config
+	)
+
+	app := fx.New(
+		configuration(),
+		postgres.Module,
+		fx.NopLogger,
+		fx.Populate(&db, &cfg),
+	)
+
+	startCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+	if err := app.Start(startCtx); err != nil {
+		return err
+	}
+	defer app.Stop(context.Background())
+
+	if db == nil {
+		return errors.New("postgres_url is not configured")
+	}
+
+	return fn(outbox.NewStore(db), cfg.WithDefaults())
+}
+
+func outboxListE(cmd *cobra.Command, args []string) error {
+	pending, _ := cmd.Flags().GetBool("pending")
+	stuck, _ := cmd.Flags().GetBool("stuck")
+	limit, _ := cmd.Flags().GetInt("limit")
+
+	return withOutboxStore(cmd.Context(), func(store *outbox.Store, cfg outbox.Config) error {
+		messages, err := store.List(cmd.Context(), outbox.Filter{
+			Pending:    pending,
+			Stuck:      stuck,
+			StuckAfter: cfg.StuckAfter,
+			Limit:      limit,
+		})
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tAGGREGATE\tTOPIC\tATTEMPTS\tNEXT ATTEMPT\tPUBLISHED\tLAST ERROR")
+		for _, m := range messages {
+			published, lastError := "-", "-"
+			if m.PublishedAt != nil {
+				published = m.PublishedAt.Format(time.RFC3339)
+			}
+			if m.LastError != nil {
+				lastError = *m.LastError
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\t%s\n",
+				m.Id, m.AggregateKey, m.Topic, m.Attempts, m.NextAttemptAt.Format(time.RFC3339), published, lastError)
+		}
+		return w.Flush()
+	})
+}
+
+func outboxReplayE(cmd *cobra.Command, args []string) error {
+	stuck, _ := cmd.Flags().GetBool("stuck")
+	if stuck == (len(args) > 0) {
+		return errors.New("pass either message ids or --stuck")
+	}
+
+	ids := make([]int64, len(args))
+	for i, arg := range args {
+		id, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid message id %q", arg)
+		}
+		ids[i] = id
+	}
+
+	return withOutboxStore(cmd.Context(), func(store *outbox.Store, cfg outbox.Config) error {
+		var (
+			n   int64
+			err error
+		)
+		if stuck {
+			n, err = store.ReplayStuck(cmd.Context(), cfg.StuckAfter)
+		} else {
+			n, err = store.Replay(cmd.Context(), ids)
+		}
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "%d message(s) scheduled for replay\n", n)
+		return nil
+	})
+}
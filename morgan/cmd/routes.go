@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/events"
+	"github.com/siakup/morgan-be/libraries/outbox"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+var routesCmd = &cobra.Command{
+	Use:   "routes",
+	Short: "Print the route table",
+	Long: "Routes mounts the enabled modules the way serve does, without connecting to the database, cache or broker,\n" +
+		"and prints every route with the module that owns it and its API version.",
+	Args: cobra.NoArgs,
+	RunE: routesE,
+}
+
+func init() {
+	root.AddCommand(routesCmd)
+}
+
+func routesE(cmd *cobra.Command, args []string) error {
+	var table *fiber.RouteTable
+	if err := mountAPI(&table); err != nil {
+		return err
+	}
+
+	return table.Print(cmd.OutOrStdout())
+}
+
+// mountAPI builds the API without connecting to the database, cache or broker, populates targets
+// and mounts the routes, for commands that only inspect them.
+func mountAPI(targets ...any) error {
+	var table *fiber.RouteTable
+
+	app := fx.New(
+		configuration(),
+		api(),
+		// The usecases only need a publisher to exist; the relay and broker are left out
+		fx.Provide(
+			fx.Annotate(
+				outbox.NewWriter,
+				fx.As(new(events.Publisher)),
+			),
+		),
+		fx.NopLogger,
+		fx.Populate(&table),
+		fx.Populate(targets...),
+	)
+	if err := app.Err(); err != nil {
+		return err
+	}
+
+	return table.Mount()
+}
@@ -1,22 +1,34 @@
 package cmd
 
 import (
-	"github.com/spf13/cobra"
-	"go.uber.org/fx"
-	"github.com/siakup/morgan-be/framework/common/logger"
+	"github.com/rs/zerolog/log"
+	"github.com/siakup/morgan-be/framework/bunnymq"
 	"github.com/siakup/morgan-be/framework/config"
 	"github.com/siakup/morgan-be/framework/fiber"
 	"github.com/siakup/morgan-be/framework/otel"
 	"github.com/siakup/morgan-be/framework/postgres"
 	"github.com/siakup/morgan-be/framework/redis"
+	"github.com/siakup/morgan-be/libraries/consumer"
+	"github.com/siakup/morgan-be/libraries/events"
 	"github.com/siakup/morgan-be/libraries/idp"
 	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"github.com/siakup/morgan-be/libraries/outbox"
+	"github.com/siakup/morgan-be/libraries/registry"
 	internalConfig "github.com/siakup/morgan-be/morgan/config"
+	"github.com/siakup/morgan-be/morgan/module/attendances"
+	"github.com/siakup/morgan-be/morgan/module/audit"
 	"github.com/siakup/morgan-be/morgan/module/domains"
 	"github.com/siakup/morgan-be/morgan/module/redirect"
 	"github.com/siakup/morgan-be/morgan/module/roles"
+	"github.com/siakup/morgan-be/morgan/module/severity_levels"
+	"github.com/siakup/morgan-be/morgan/module/shift_groups"
 	"github.com/siakup/morgan-be/morgan/module/shift_sessions"
+	"github.com/siakup/morgan-be/morgan/module/tickets"
 	"github.com/siakup/morgan-be/morgan/module/users"
+	"github.com/siakup/morgan-be/morgan/version"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
 )
 
 var serve = &cobra.Command{
@@ -27,136 +39,62 @@ var serve = &cobra.Command{
 
 func serveE(cmd *cobra.Command, args []string) error {
 	fx.New(
+		configuration(),
+
 		// provide libraries
-		logger.Module,
-		config.Module,
-		fiber.Module,
 		otel.Module,
-		postgres.Module,
-		redis.Module,
-
-		// supply config source & resolvers
-		fx.Supply(
-			fx.Annotated{
-				Group: "config_options",
-				Target: config.WithSources(
-					// FileSource (Base Config / Local Dev)
-					config.FileSource("config/config.json"),
-
-					// Consul KV (Dynamic Config)
-					// config.KVSource(
-					// 	// Prefix: config/users/<env>/
-					// 	fmt.Sprintf("config/users/%s/", func() string {
-					// 		env := os.Getenv("APP_ENV")
-					// 		if env == "" {
-					// 			return "dev"
-					// 		}
-					// 		return env
-					// 	}()),
-
-					// 	// Client
-					// 	config.ConsulClient(
-					// 		os.Getenv("CONSUL_HTTP_ADDR"),
-					// 		os.Getenv("CONSUL_HTTP_TOKEN"),
-					// 	),
-
-					// 	config.KVDefaultMapper(3),
-					// ),
+		bunnymq.Module,
+		events.Module,
+		outbox.Module,
+		consumer.Module,
 
-					// EnvSource (Highest Priority Overrides)
-					config.EnvSource("APP", config.DefaultEnvMapper()),
-				),
-			},
-
-			fx.Annotated{
-				Group: "config_options",
-				Target: config.WithResolvers(
-					// FileResolver creates a resolver that reads values from files.
-					// It resolves values with the "file://" scheme.
-					//
-					// Examples:
-					//   - "file:///etc/secrets/api.key" -> contents of /etc/secrets/api.key
-					//   - "file://./config/private.pem" -> contents of ./config/private.pem
-					//
-					// Returns:
-					//   - Resolver: A resolver that can be used with ReadInConfig
-					//
-					// Security Note:
-					// The path is validated to prevent directory traversal attacks.
-					// Only files within local paths are generally allowed, depending on validation logic.
-					config.FileResolver(),
-
-					// EnvResolver creates a resolver that fetches values from environment variables.
-					// It resolves values with the "env://" scheme.
-					//
-					// Examples:
-					//   - "env://DATABASE_URL" -> value of DATABASE_URL environment variable
-					//   - "env://API_KEY" -> value of API_KEY environment variable
-					//
-					// Returns:
-					//   - Resolver: A resolver that can be used with ReadInConfig
-					config.EnvResolver(),
+		api(),
+	).Run()
 
-					// Base64Resolver creates a resolver that decodes base64-encoded values.
-					// It resolves values with the "base64://" scheme.
-					//
-					// Examples:
-					//   - "base64://SGVsbG8gV29ybGQ=" -> "Hello World"
-					//   - "base64://c2VjcmV0LXRva2Vu" -> "secret-token"
-					//
-					// Returns:
-					//   - Resolver: A resolver that can be used with ReadInConfig
-					config.Base64Resolver(),
+	return nil
+}
 
-					// VaultResolver creates a resolver that fetches secrets from HashiCorp Vault.
-					// It resolves values with the "vault://" scheme and returns JSON-encoded secrets.
-					//
-					// Parameters:
-					//   - url: Vault server URL (e.g., "https://vault:8200")
-					//   - token: Vault authentication token
-					//   - mountPath: Vault secrets engine mount path (e.g., "secret")
-					//   - vaultPath: Base path for secrets (can be empty if secrets are at root)
-					//
-					// Examples:
-					//   - "vault://database" -> JSON secret from secret/data/database
-					//   - "vault://api/keys" -> JSON secret from secret/data/api/keys
-					//
-					// Returns:
-					//   - Resolver: A resolver that can be used with ReadInConfig
-					//
-					// Note:
-					// The resolver returns the JSON representation of the secret from Vault.
-					// The key is extracted from the portion of the string after "vault://".
-					// config.VaultResolver("", "", "", ""),
-				),
-			},
-		),
+// api provides the HTTP server and the feature modules mounted on it.
+func api() fx.Option {
+	return fx.Options(
+		fiber.Module,
+		postgres.Module,
+		redis.Module,
+		fx.Invoke(watchCORS),
 
-		// provide used configurations
+		// provide middleware
 		fx.Provide(
-			config.ProvideConfig[internalConfig.ApplicationConfig](),
-			internalConfig.Postgres,
-			internalConfig.Redis,
-			internalConfig.Fiber,
-			internalConfig.Otel,
-			internalConfig.Logger,
-			internalConfig.InternalApp,
 			middleware.NewAuthorizationMiddleware,
 		),
 
 		middleware.HealthModule,
+		middleware.RateLimitModule,
+		audit.Module,
 		roles.Module,
 		users.Module,
 		redirect.Module,
 		shift_sessions.Module,
+		shift_groups.Module,
+		severity_levels.Module,
 		domains.Module,
+		attendances.Module,
+		tickets.Module,
+		registry.FxModule,
+		fx.Supply(openapi.Info{Title: "Morgan API", Version: version.Version}),
 		fx.Provide(
 			fx.Annotate(
 				idp.NewIDP,
 				fx.As(new(idp.IDPProvider)),
 			),
 		),
-	).Run()
+	)
+}
 
-	return nil
+// watchCORS applies CORS allowed origins changes from the configuration sources.
+func watchCORS(w *config.Watcher[internalConfig.ApplicationConfig], cors *fiber.CORS) {
+	config.OnChange(w, func(c *internalConfig.ApplicationConfig) string { return c.Fiber.CORSAllowedOrigins }, func(origins string) {
+		if err := cors.SetAllowedOrigins(origins); err != nil {
+			log.Error().Err(err).Msg("Failed to apply CORS allowed origins")
+		}
+	})
 }
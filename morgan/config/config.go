@@ -8,17 +8,23 @@ import (
 	"github.com/siakup/morgan-be/framework/postgres"
 	"github.com/siakup/morgan-be/framework/redis"
 	"github.com/siakup/morgan-be/libraries/consumer"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/outbox"
+	"github.com/siakup/morgan-be/libraries/registry"
 )
 
 type ApplicationConfig struct {
-	AppConfig InternalAppConfig `config:",squash"`
-	Postgres  postgres.Config   `config:",squash"`
-	Redis     redis.Config      `config:",squash"`
-	RabbitMQ  bunnymq.Config    `config:",squash"`
-	Fiber     fiber.Config      `config:",squash"`
-	Consumer  consumer.Config   `config:",squash"`
-	Otel      otel.Config       `config:",squash"`
-	Logger    logger.Config     `config:",squash"`
+	AppConfig InternalAppConfig          `config:",squash"`
+	Postgres  postgres.Config            `config:",squash"`
+	Redis     redis.Config               `config:",squash"`
+	RabbitMQ  bunnymq.Config             `config:",squash"`
+	Fiber     fiber.Config               `config:",squash"`
+	Consumer  consumer.Config            `config:",squash"`
+	Otel      otel.Config                `config:",squash"`
+	Logger    logger.Config              `config:",squash"`
+	Modules   registry.Config            `config:",squash"`
+	Outbox    outbox.Config              `config:",squash"`
+	RateLimit middleware.RateLimitConfig `config:",squash"`
 }
 
 type InternalAppConfig struct {
@@ -56,3 +62,15 @@ func Logger(app *ApplicationConfig) *logger.Config {
 func InternalApp(app *ApplicationConfig) *InternalAppConfig {
 	return &app.AppConfig
 }
+
+func Modules(app *ApplicationConfig) *registry.Config {
+	return &app.Modules
+}
+
+func Outbox(app *ApplicationConfig) *outbox.Config {
+	return &app.Outbox
+}
+
+func RateLimit(app *ApplicationConfig) *middleware.RateLimitConfig {
+	return &app.RateLimit
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS hr.attendance_corrections;
+DROP TABLE IF EXISTS hr.attendances;
+DROP TABLE IF EXISTS hr.shift_group_grace_periods;
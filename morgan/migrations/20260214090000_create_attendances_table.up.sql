@@ -0,0 +1,80 @@
+CREATE TABLE IF NOT EXISTS hr.shift_group_grace_periods
+(
+    shift_group_id             UUID PRIMARY KEY REFERENCES hr.shift_groups(id) ON DELETE CASCADE,
+    late_grace_minutes         INTEGER NOT NULL DEFAULT 0 CHECK (late_grace_minutes >= 0),
+    early_leave_grace_minutes  INTEGER NOT NULL DEFAULT 0 CHECK (early_leave_grace_minutes >= 0),
+
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by  UUID,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by  UUID
+);
+
+CREATE TABLE IF NOT EXISTS hr.attendances
+(
+    id                   UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    institution_id       UUID NOT NULL REFERENCES auth.institutions(id),
+    user_id              UUID NOT NULL REFERENCES auth.users(id),
+    shift_session_id     UUID NOT NULL REFERENCES hr.shift_sessions(id),
+    shift_group_id       UUID REFERENCES hr.shift_groups(id),
+
+    work_date            DATE NOT NULL,
+    clock_in_at          TIMESTAMPTZ NOT NULL,
+    clock_out_at         TIMESTAMPTZ,
+
+    late_minutes         INTEGER NOT NULL DEFAULT 0,
+    early_leave_minutes  INTEGER NOT NULL DEFAULT 0,
+    status               VARCHAR(20) NOT NULL DEFAULT 'present'
+        CHECK (status IN ('present', 'late', 'early_leave', 'late_early_leave')),
+    notes                TEXT,
+
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by           UUID,
+    updated_at           TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by           UUID,
+
+    CONSTRAINT clock_out_after_clock_in CHECK (clock_out_at IS NULL OR clock_out_at >= clock_in_at)
+);
+
+-- One attendance record per user, shift session and day
+DROP INDEX IF EXISTS hr.ux_attendances_user_session_date;
+CREATE UNIQUE INDEX ux_attendances_user_session_date
+ON hr.attendances (user_id, shift_session_id, work_date);
+
+-- Daily summary hot-path
+DROP INDEX IF EXISTS hr.idx_attendances_institution_date;
+CREATE INDEX idx_attendances_institution_date
+ON hr.attendances (institution_id, work_date);
+
+CREATE TABLE IF NOT EXISTS hr.attendance_corrections
+(
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    institution_id  UUID NOT NULL REFERENCES auth.institutions(id),
+    attendance_id   UUID NOT NULL REFERENCES hr.attendances(id) ON DELETE CASCADE,
+
+    clock_in_at     TIMESTAMPTZ,
+    clock_out_at    TIMESTAMPTZ,
+    reason          TEXT NOT NULL,
+
+    status          VARCHAR(10) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    requested_by    UUID NOT NULL REFERENCES auth.users(id),
+    reviewed_by     UUID REFERENCES auth.users(id),
+    reviewed_at     TIMESTAMPTZ,
+    review_note     TEXT,
+
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT correction_has_changes CHECK (clock_in_at IS NOT NULL OR clock_out_at IS NOT NULL)
+);
+
+-- Only one open correction per attendance record
+DROP INDEX IF EXISTS hr.ux_attendance_corrections_pending;
+CREATE UNIQUE INDEX ux_attendance_corrections_pending
+ON hr.attendance_corrections (attendance_id)
+WHERE status = 'pending';
+
+DROP INDEX IF EXISTS hr.idx_attendance_corrections_institution_status;
+CREATE INDEX idx_attendance_corrections_institution_status
+ON hr.attendance_corrections (institution_id, status);
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS master.idx_severity_levels_rank;
+
+ALTER TABLE master.severity_levels
+    DROP CONSTRAINT IF EXISTS severity_levels_color_hex,
+    DROP CONSTRAINT IF EXISTS severity_levels_rank_positive,
+    DROP COLUMN IF EXISTS icon,
+    DROP COLUMN IF EXISTS color,
+    DROP COLUMN IF EXISTS resolution_sla_minutes,
+    DROP COLUMN IF EXISTS response_sla_minutes,
+    DROP COLUMN IF EXISTS rank;
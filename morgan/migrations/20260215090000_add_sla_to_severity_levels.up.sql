@@ -0,0 +1,25 @@
+ALTER TABLE master.severity_levels
+    ADD COLUMN IF NOT EXISTS rank                   INTEGER,
+    ADD COLUMN IF NOT EXISTS response_sla_minutes   INTEGER NOT NULL DEFAULT 0 CHECK (response_sla_minutes >= 0),
+    ADD COLUMN IF NOT EXISTS resolution_sla_minutes INTEGER NOT NULL DEFAULT 0 CHECK (resolution_sla_minutes >= 0),
+    ADD COLUMN IF NOT EXISTS color                  VARCHAR(7),
+    ADD COLUMN IF NOT EXISTS icon                   VARCHAR(50);
+
+-- Existing levels are ranked in creation order, 1 being the most severe.
+UPDATE master.severity_levels sl
+SET rank = ranked.rank
+FROM (
+    SELECT id, row_number() OVER (ORDER BY created_at, name) AS rank
+    FROM master.severity_levels
+) ranked
+WHERE sl.id = ranked.id;
+
+ALTER TABLE master.severity_levels
+    ALTER COLUMN rank SET NOT NULL,
+    ADD CONSTRAINT severity_levels_rank_positive CHECK (rank > 0),
+    ADD CONSTRAINT severity_levels_color_hex CHECK (color IS NULL OR color ~ '^#[0-9A-Fa-f]{6}$');
+
+-- Ranks are unique among levels that are not soft-deleted.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_severity_levels_rank
+    ON master.severity_levels (rank)
+    WHERE deleted_at IS NULL;
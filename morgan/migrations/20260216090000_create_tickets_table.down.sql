@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS ticketing.ticket_history;
+DROP TABLE IF EXISTS ticketing.ticket_comments;
+DROP TABLE IF EXISTS ticketing.tickets;
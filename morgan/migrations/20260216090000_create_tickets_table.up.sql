@@ -0,0 +1,78 @@
+CREATE TABLE IF NOT EXISTS ticketing.tickets
+(
+    id                 UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    institution_id     UUID NOT NULL REFERENCES auth.institutions(id),
+
+    title              VARCHAR(200) NOT NULL,
+    description        TEXT,
+    domain_id          UUID NOT NULL REFERENCES master.domains(id),
+    severity_level_id  UUID NOT NULL REFERENCES master.severity_levels(id),
+
+    reporter_id        UUID NOT NULL REFERENCES auth.users(id),
+    assignee_id        UUID REFERENCES auth.users(id),
+    shift_group_id     UUID REFERENCES hr.shift_groups(id),
+
+    status             VARCHAR(20) NOT NULL DEFAULT 'open'
+        CHECK (status IN ('open', 'in_progress', 'on_hold', 'resolved', 'closed', 'cancelled')),
+
+    -- Derived from the severity level SLA targets, NULL when the level has no target
+    response_due_at    TIMESTAMPTZ,
+    resolution_due_at  TIMESTAMPTZ,
+    responded_at       TIMESTAMPTZ,
+    resolved_at        TIMESTAMPTZ,
+    closed_at          TIMESTAMPTZ,
+
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by         UUID,
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by         UUID
+);
+
+DROP INDEX IF EXISTS ticketing.idx_tickets_institution_status;
+CREATE INDEX idx_tickets_institution_status
+ON ticketing.tickets (institution_id, status);
+
+DROP INDEX IF EXISTS ticketing.idx_tickets_shift_group;
+CREATE INDEX idx_tickets_shift_group
+ON ticketing.tickets (shift_group_id)
+WHERE shift_group_id IS NOT NULL;
+
+-- Overdue hot-path: open work ordered by its resolution deadline
+DROP INDEX IF EXISTS ticketing.idx_tickets_resolution_due;
+CREATE INDEX idx_tickets_resolution_due
+ON ticketing.tickets (institution_id, resolution_due_at)
+WHERE status IN ('open', 'in_progress', 'on_hold');
+
+CREATE TABLE IF NOT EXISTS ticketing.ticket_comments
+(
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    ticket_id   UUID NOT NULL REFERENCES ticketing.tickets(id) ON DELETE CASCADE,
+    author_id   UUID NOT NULL REFERENCES auth.users(id),
+    body        TEXT NOT NULL,
+
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+DROP INDEX IF EXISTS ticketing.idx_ticket_comments_ticket;
+CREATE INDEX idx_ticket_comments_ticket
+ON ticketing.ticket_comments (ticket_id, created_at);
+
+-- Append-only log of changes made to a ticket
+CREATE TABLE IF NOT EXISTS ticketing.ticket_history
+(
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    ticket_id   UUID NOT NULL REFERENCES ticketing.tickets(id) ON DELETE CASCADE,
+    actor_id    UUID NOT NULL,
+    action      VARCHAR(30) NOT NULL
+        CHECK (action IN ('created', 'updated', 'status_changed', 'assigned')),
+    field       VARCHAR(50),
+    old_value   TEXT,
+    new_value   TEXT,
+    note        TEXT,
+
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+DROP INDEX IF EXISTS ticketing.idx_ticket_history_ticket;
+CREATE INDEX idx_ticket_history_ticket
+ON ticketing.ticket_history (ticket_id, created_at);
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS trg_logs_append_only ON audit.logs;
+DROP FUNCTION IF EXISTS audit.reject_mutation();
+DROP TABLE IF EXISTS audit.logs;
+DROP SCHEMA IF EXISTS audit;
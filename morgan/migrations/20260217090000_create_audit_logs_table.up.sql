@@ -0,0 +1,47 @@
+CREATE SCHEMA IF NOT EXISTS audit;
+
+CREATE TABLE IF NOT EXISTS audit.logs
+(
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    institution_id  UUID,
+    actor_id        UUID,
+
+    entity          VARCHAR(50) NOT NULL,
+    entity_id       VARCHAR(100) NOT NULL,
+    action          VARCHAR(30) NOT NULL,
+
+    before          JSONB,
+    after           JSONB,
+    diff            JSONB NOT NULL DEFAULT '{}'::JSONB,
+
+    trace_id        VARCHAR(64),
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+DROP INDEX IF EXISTS audit.idx_logs_institution_created;
+CREATE INDEX idx_logs_institution_created
+ON audit.logs (institution_id, created_at DESC);
+
+DROP INDEX IF EXISTS audit.idx_logs_entity;
+CREATE INDEX idx_logs_entity
+ON audit.logs (entity, entity_id, created_at DESC);
+
+DROP INDEX IF EXISTS audit.idx_logs_actor;
+CREATE INDEX idx_logs_actor
+ON audit.logs (actor_id, created_at DESC);
+
+DROP INDEX IF EXISTS audit.idx_logs_trace;
+CREATE INDEX idx_logs_trace
+ON audit.logs (trace_id);
+
+-- The audit trail is append-only
+CREATE OR REPLACE FUNCTION audit.reject_mutation() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit.logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_logs_append_only ON audit.logs;
+CREATE TRIGGER trg_logs_append_only
+BEFORE UPDATE OR DELETE ON audit.logs
+FOR EACH ROW EXECUTE FUNCTION audit.reject_mutation();
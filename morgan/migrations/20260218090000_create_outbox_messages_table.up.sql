@@ -0,0 +1,31 @@
+CREATE SCHEMA IF NOT EXISTS outbox;
+
+CREATE TABLE IF NOT EXISTS outbox.messages
+(
+    id              BIGSERIAL PRIMARY KEY,
+
+    aggregate_key   VARCHAR(150) NOT NULL,
+    exchange        VARCHAR(100) NOT NULL,
+    topic           VARCHAR(100) NOT NULL,
+    message_id      VARCHAR(100) NOT NULL UNIQUE,
+    content_type    VARCHAR(100) NOT NULL,
+    body            BYTEA NOT NULL,
+
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    published_at    TIMESTAMPTZ
+);
+
+-- The relay only reads pending rows; the partial index keeps its scan small as published rows accumulate.
+DROP INDEX IF EXISTS outbox.idx_messages_pending;
+CREATE INDEX idx_messages_pending
+ON outbox.messages (aggregate_key, id)
+WHERE published_at IS NULL;
+
+DROP INDEX IF EXISTS outbox.idx_messages_published;
+CREATE INDEX idx_messages_published
+ON outbox.messages (published_at)
+WHERE published_at IS NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE outbox.messages
+    DROP COLUMN IF EXISTS headers;
@@ -0,0 +1,3 @@
+-- AMQP headers captured when the message was written, e.g. the W3C trace context of the request.
+ALTER TABLE outbox.messages
+    ADD COLUMN IF NOT EXISTS headers JSONB NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS hr.shift_group_members;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS hr.shift_group_members
+(
+    shift_group_id  UUID NOT NULL REFERENCES hr.shift_groups(id) ON DELETE CASCADE,
+    user_id         UUID NOT NULL REFERENCES auth.users(id) ON DELETE CASCADE,
+
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by      UUID,
+
+    PRIMARY KEY (shift_group_id, user_id)
+);
+
+-- Clock-in resolves the shift groups of a user
+DROP INDEX IF EXISTS hr.idx_shift_group_members_user;
+CREATE INDEX idx_shift_group_members_user
+ON hr.shift_group_members (user_id);
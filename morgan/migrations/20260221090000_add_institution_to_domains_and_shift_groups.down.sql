@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS hr.idx_shift_groups_institution;
+DROP INDEX IF EXISTS master.idx_domains_institution;
+
+ALTER TABLE hr.shift_groups DROP COLUMN IF EXISTS institution_id;
+ALTER TABLE master.domains DROP COLUMN IF EXISTS institution_id;
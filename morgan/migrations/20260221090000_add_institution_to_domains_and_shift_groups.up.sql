@@ -0,0 +1,16 @@
+-- Domains and shift groups without an institution are shared by every institution.
+ALTER TABLE master.domains
+    ADD COLUMN IF NOT EXISTS institution_id UUID REFERENCES auth.institutions(id);
+
+ALTER TABLE hr.shift_groups
+    ADD COLUMN IF NOT EXISTS institution_id UUID REFERENCES auth.institutions(id);
+
+DROP INDEX IF EXISTS master.idx_domains_institution;
+CREATE INDEX idx_domains_institution
+ON master.domains (institution_id)
+WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS hr.idx_shift_groups_institution;
+CREATE INDEX idx_shift_groups_institution
+ON hr.shift_groups (institution_id)
+WHERE deleted_at IS NULL;
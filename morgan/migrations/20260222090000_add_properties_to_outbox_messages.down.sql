@@ -0,0 +1,6 @@
+ALTER TABLE outbox.messages
+    DROP COLUMN IF EXISTS occurred_at,
+    DROP COLUMN IF EXISTS mandatory,
+    DROP COLUMN IF EXISTS expiration_ms,
+    DROP COLUMN IF EXISTS delivery_mode,
+    DROP COLUMN IF EXISTS correlation_id;
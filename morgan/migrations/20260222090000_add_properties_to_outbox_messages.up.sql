@@ -0,0 +1,13 @@
+-- AMQP properties of the event, restored by the relay so a message leaves the outbox as it was published.
+ALTER TABLE outbox.messages
+    ADD COLUMN IF NOT EXISTS correlation_id VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS delivery_mode  SMALLINT NOT NULL DEFAULT 2,
+    ADD COLUMN IF NOT EXISTS expiration_ms  BIGINT NOT NULL DEFAULT 0 CHECK (expiration_ms >= 0),
+    ADD COLUMN IF NOT EXISTS mandatory      BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS occurred_at    TIMESTAMPTZ;
+
+UPDATE outbox.messages SET occurred_at = created_at WHERE occurred_at IS NULL;
+
+ALTER TABLE outbox.messages
+    ALTER COLUMN occurred_at SET NOT NULL,
+    ALTER COLUMN occurred_at SET DEFAULT now();
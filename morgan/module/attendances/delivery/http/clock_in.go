@@ -0,0 +1,65 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+type (
+	ClockInRequest struct {
+		ShiftSessionId string `json:"shift_session_id" validate:"required,uuid"`
+		ShiftGroupId   string `json:"shift_group_id" validate:"omitempty,uuid"`
+		WorkDate       string `json:"work_date"`
+		Notes          string `json:"notes"`
+	}
+)
+
+// ClockIn handles POST /attendances/clock-in
+func (h *AttendanceHandler) ClockIn(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userId, ok := c.Locals(middleware.XUserIdKey).(string)
+	if !ok || userId == "" {
+		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
+	}
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	var req ClockInRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.handleError(c, errors.BadRequest("Invalid request body"))
+	}
+
+	if req.ShiftSessionId == "" {
+		return h.handleError(c, errors.BadRequest("field shift_session_id is required"))
+	}
+
+	workDate, err := parseDate(req.WorkDate)
+	if err != nil {
+		return h.handleError(c, errors.BadRequest("work_date must be in YYYY-MM-DD format"))
+	}
+
+	cmd := domain.ClockInCommand{
+		InstitutionId:  institutionId,
+		UserId:         userId,
+		ShiftSessionId: req.ShiftSessionId,
+		ShiftGroupId:   req.ShiftGroupId,
+		At:             time.Now(),
+		Notes:          req.Notes,
+	}
+	if workDate != nil {
+		cmd.WorkDate = *workDate
+	}
+
+	attendance, err := h.useCase.ClockIn(ctx, cmd)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(responses.Success(toAttendanceResponse(attendance), "Clocked in"))
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+type (
+	ClockOutRequest struct {
+		ShiftSessionId string `json:"shift_session_id" validate:"required,uuid"`
+		WorkDate       string `json:"work_date"`
+	}
+)
+
+// ClockOut handles POST /attendances/clock-out
+func (h *AttendanceHandler) ClockOut(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	userId, ok := c.Locals(middleware.XUserIdKey).(string)
+	if !ok || userId == "" {
+		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
+	}
+
+	var req ClockOutRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.handleError(c, errors.BadRequest("Invalid request body"))
+	}
+
+	if req.ShiftSessionId == "" {
+		return h.handleError(c, errors.BadRequest("field shift_session_id is required"))
+	}
+
+	workDate, err := parseDate(req.WorkDate)
+	if err != nil {
+		return h.handleError(c, errors.BadRequest("work_date must be in YYYY-MM-DD format"))
+	}
+
+	cmd := domain.ClockOutCommand{
+		UserId:         userId,
+		ShiftSessionId: req.ShiftSessionId,
+		At:             time.Now(),
+	}
+	if workDate != nil {
+		cmd.WorkDate = *workDate
+	}
+
+	attendance, err := h.useCase.ClockOut(ctx, cmd)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success(toAttendanceResponse(attendance), "Clocked out"))
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+)
+
+// GetAttendanceByID handles GET /attendances/:id
+func (h *AttendanceHandler) GetAttendanceByID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id := c.Params("id")
+	if id == "" {
+		return h.handleError(c, errors.BadRequest("Invalid ID"))
+	}
+
+	attendance, err := h.useCase.Get(ctx, id)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+	if institutionId != "" && attendance.InstitutionId != institutionId {
+		return h.handleError(c, errors.NotFound("attendance not found"))
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success(toAttendanceResponse(attendance), "Attendance retrieved"))
+}
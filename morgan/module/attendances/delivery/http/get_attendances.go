@@ -0,0 +1,63 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/types"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// GetAttendances handles GET /attendances
+func (h *AttendanceHandler) GetAttendances(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	page, pageSize := parsePagination(c)
+
+	dateFrom, err := parseDate(c.Query("date_from"))
+	if err != nil {
+		return h.handleError(c, errors.BadRequest("date_from must be in YYYY-MM-DD format"))
+	}
+	dateTo, err := parseDate(c.Query("date_to"))
+	if err != nil {
+		return h.handleError(c, errors.BadRequest("date_to must be in YYYY-MM-DD format"))
+	}
+
+	filter := domain.AttendanceFilter{
+		Pagination: types.Pagination{
+			Page: page,
+			Size: pageSize,
+		},
+		InstitutionId:  institutionId,
+		UserId:         c.Query("user_id"),
+		ShiftSessionId: c.Query("shift_session_id"),
+		ShiftGroupId:   c.Query("shift_group_id"),
+		Status:         c.Query("status"),
+		DateFrom:       dateFrom,
+		DateTo:         dateTo,
+	}
+
+	attendances, total, err := h.useCase.FindAll(ctx, filter)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	result := make([]AttendanceResponse, len(attendances))
+	for i, a := range attendances {
+		result[i] = toAttendanceResponse(a)
+	}
+
+	meta := &responses.Meta{
+		Page:       page,
+		Size:       pageSize,
+		Total:      total,
+		TotalPages: (int(total) + pageSize - 1) / pageSize,
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.SuccessWithMeta(result, "Attendances retrieved", meta))
+}
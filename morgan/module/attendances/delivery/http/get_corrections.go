@@ -0,0 +1,49 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/types"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// GetCorrections handles GET /attendances/corrections
+func (h *AttendanceHandler) GetCorrections(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	page, pageSize := parsePagination(c)
+
+	filter := domain.CorrectionFilter{
+		Pagination: types.Pagination{
+			Page: page,
+			Size: pageSize,
+		},
+		InstitutionId: institutionId,
+		AttendanceId:  c.Query("attendance_id"),
+		Status:        c.Query("status"),
+	}
+
+	corrections, total, err := h.useCase.FindAllCorrections(ctx, filter)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	result := make([]CorrectionResponse, len(corrections))
+	for i, correction := range corrections {
+		result[i] = toCorrectionResponse(correction)
+	}
+
+	meta := &responses.Meta{
+		Page:       page,
+		Size:       pageSize,
+		Total:      total,
+		TotalPages: (int(total) + pageSize - 1) / pageSize,
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.SuccessWithMeta(result, "Attendance corrections retrieved", meta))
+}
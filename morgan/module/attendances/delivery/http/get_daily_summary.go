@@ -0,0 +1,59 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+)
+
+type (
+	DailySummaryResponse struct {
+		ShiftSessionId   string `json:"shift_session_id"`
+		ShiftSessionName string `json:"shift_session_name"`
+		Total            int64  `json:"total"`
+		OnTime           int64  `json:"on_time"`
+		Late             int64  `json:"late"`
+		EarlyLeave       int64  `json:"early_leave"`
+		NotClockedOut    int64  `json:"not_clocked_out"`
+	}
+)
+
+// GetDailySummary handles GET /attendances/summary?date=YYYY-MM-DD
+func (h *AttendanceHandler) GetDailySummary(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	date, err := parseDate(c.Query("date"))
+	if err != nil {
+		return h.handleError(c, errors.BadRequest("date must be in YYYY-MM-DD format"))
+	}
+	workDate := time.Now()
+	if date != nil {
+		workDate = *date
+	}
+
+	summaries, err := h.useCase.DailySummary(ctx, institutionId, workDate)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	result := make([]DailySummaryResponse, len(summaries))
+	for i, s := range summaries {
+		result[i] = DailySummaryResponse{
+			ShiftSessionId:   s.ShiftSessionId,
+			ShiftSessionName: s.ShiftSessionName,
+			Total:            s.Total,
+			OnTime:           s.OnTime,
+			Late:             s.Late,
+			EarlyLeave:       s.EarlyLeave,
+			NotClockedOut:    s.NotClockedOut,
+		}
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success(result, "Attendance summary retrieved"))
+}
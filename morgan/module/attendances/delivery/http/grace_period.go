@@ -0,0 +1,80 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+type (
+	SetGracePeriodRequest struct {
+		LateGraceMinutes       int `json:"late_grace_minutes" validate:"min=0"`
+		EarlyLeaveGraceMinutes int `json:"early_leave_grace_minutes" validate:"min=0"`
+	}
+	GracePeriodResponse struct {
+		ShiftGroupId           string `json:"shift_group_id"`
+		LateGraceMinutes       int    `json:"late_grace_minutes"`
+		EarlyLeaveGraceMinutes int    `json:"early_leave_grace_minutes"`
+	}
+)
+
+// GetGracePeriod handles GET /attendances/grace-periods/:shift_group_id
+func (h *AttendanceHandler) GetGracePeriod(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	shiftGroupId := c.Params("shift_group_id")
+	if shiftGroupId == "" {
+		return h.handleError(c, errors.BadRequest("Invalid shift group ID"))
+	}
+
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	gracePeriod, err := h.useCase.GetGracePeriod(ctx, institutionId, shiftGroupId)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success(GracePeriodResponse{
+		ShiftGroupId:           gracePeriod.ShiftGroupId,
+		LateGraceMinutes:       gracePeriod.LateGraceMinutes,
+		EarlyLeaveGraceMinutes: gracePeriod.EarlyLeaveGraceMinutes,
+	}, "Grace period retrieved"))
+}
+
+// SetGracePeriod handles PUT /attendances/grace-periods/:shift_group_id
+func (h *AttendanceHandler) SetGracePeriod(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	shiftGroupId := c.Params("shift_group_id")
+	if shiftGroupId == "" {
+		return h.handleError(c, errors.BadRequest("Invalid shift group ID"))
+	}
+
+	userId, ok := c.Locals(middleware.XUserIdKey).(string)
+	if !ok || userId == "" {
+		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
+	}
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	var req SetGracePeriodRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.handleError(c, errors.BadRequest("Invalid request body"))
+	}
+
+	gracePeriod := domain.GracePeriod{
+		ShiftGroupId:           shiftGroupId,
+		LateGraceMinutes:       req.LateGraceMinutes,
+		EarlyLeaveGraceMinutes: req.EarlyLeaveGraceMinutes,
+		UpdatedBy:              &userId,
+	}
+
+	if err := h.useCase.SetGracePeriod(ctx, institutionId, &gracePeriod); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success[any](nil, "Grace period updated"))
+}
@@ -0,0 +1,135 @@
+package http
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+const dateLayout = "2006-01-02"
+
+// Permission codes guarding the attendances routes.
+const (
+	PermissionView    = "attendances.hr.attendances.view"
+	PermissionClock   = "attendances.hr.attendances.clock"
+	PermissionEdit    = "attendances.hr.attendances.edit"
+	PermissionApprove = "attendances.hr.attendances.approve"
+)
+
+// Permissions lists every permission code required by the attendances routes.
+var Permissions = []string{PermissionView, PermissionClock, PermissionEdit, PermissionApprove}
+
+// AttendanceHandler handles HTTP requests for attendances module.
+type AttendanceHandler struct {
+	useCase domain.UseCase
+	auth    *middleware.AuthorizationMiddleware
+}
+
+// NewAttendanceHandler creates a new AttendanceHandler.
+func NewAttendanceHandler(useCase domain.UseCase, auth *middleware.AuthorizationMiddleware) *AttendanceHandler {
+	return &AttendanceHandler{
+		useCase: useCase,
+		auth:    auth,
+	}
+}
+
+// RegisterRoutes registers the routes for the attendances module.
+func (h *AttendanceHandler) RegisterRoutes(router fiber.Router) {
+	router.Use("/attendances", middleware.TraceMiddleware)
+	openapi.Mount(router, h.auth.Authenticate, h.Endpoints())
+}
+
+// handleError renders err with the standard error envelope.
+func (h *AttendanceHandler) handleError(c *fiber.Ctx, err error) error {
+	return responses.HandleError(c, err)
+}
+
+// maxPageSize caps the page_size query parameter.
+const maxPageSize = 100
+
+// parsePagination reads page and page_size from the query, clamping page to at least 1 and page_size to 1..maxPageSize.
+func parsePagination(c *fiber.Ctx) (int, int) {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size", "10"))
+
+	return max(page, 1), min(max(pageSize, 1), maxPageSize)
+}
+
+// parseDate parses an optional YYYY-MM-DD value in the local timezone.
+func parseDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.ParseInLocation(dateLayout, value, time.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// AttendanceResponse is the JSON representation of an attendance.
+type AttendanceResponse struct {
+	Id                string     `json:"id"`
+	UserId            string     `json:"user_id"`
+	ShiftSessionId    string     `json:"shift_session_id"`
+	ShiftGroupId      *string    `json:"shift_group_id"`
+	WorkDate          string     `json:"work_date"`
+	ClockInAt         time.Time  `json:"clock_in_at"`
+	ClockOutAt        *time.Time `json:"clock_out_at"`
+	LateMinutes       int        `json:"late_minutes"`
+	EarlyLeaveMinutes int        `json:"early_leave_minutes"`
+	Status            string     `json:"status"`
+	Notes             *string    `json:"notes"`
+}
+
+func toAttendanceResponse(a *domain.Attendance) AttendanceResponse {
+	return AttendanceResponse{
+		Id:                a.Id,
+		UserId:            a.UserId,
+		ShiftSessionId:    a.ShiftSessionId,
+		ShiftGroupId:      a.ShiftGroupId,
+		WorkDate:          a.WorkDate.Format(dateLayout),
+		ClockInAt:         a.ClockInAt,
+		ClockOutAt:        a.ClockOutAt,
+		LateMinutes:       a.LateMinutes,
+		EarlyLeaveMinutes: a.EarlyLeaveMinutes,
+		Status:            a.Status,
+		Notes:             a.Notes,
+	}
+}
+
+// CorrectionResponse is the JSON representation of an attendance correction.
+type CorrectionResponse struct {
+	Id           string     `json:"id"`
+	AttendanceId string     `json:"attendance_id"`
+	ClockInAt    *time.Time `json:"clock_in_at"`
+	ClockOutAt   *time.Time `json:"clock_out_at"`
+	Reason       string     `json:"reason"`
+	Status       string     `json:"status"`
+	RequestedBy  string     `json:"requested_by"`
+	ReviewedBy   *string    `json:"reviewed_by"`
+	ReviewedAt   *time.Time `json:"reviewed_at"`
+	ReviewNote   *string    `json:"review_note"`
+}
+
+func toCorrectionResponse(c *domain.AttendanceCorrection) CorrectionResponse {
+	return CorrectionResponse{
+		Id:           c.Id,
+		AttendanceId: c.AttendanceId,
+		ClockInAt:    c.ClockInAt,
+		ClockOutAt:   c.ClockOutAt,
+		Reason:       c.Reason,
+		Status:       c.Status,
+		RequestedBy:  c.RequestedBy,
+		ReviewedBy:   c.ReviewedBy,
+		ReviewedAt:   c.ReviewedAt,
+		ReviewNote:   c.ReviewNote,
+	}
+}
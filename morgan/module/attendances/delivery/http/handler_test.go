@@ -0,0 +1,339 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	deliverhttp "github.com/siakup/morgan-be/morgan/module/attendances/delivery/http"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+	"github.com/siakup/morgan-be/morgan/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupAttendanceApp(useCase domain.UseCase) *fiber.App {
+	handler := deliverhttp.NewAttendanceHandler(useCase, nil)
+
+	app := fiber.New()
+
+	// Mock middleware
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals(middleware.XUserIdKey, "user-1")
+		c.Locals(middleware.XInstitutionId, "inst-1")
+		return c.Next()
+	})
+
+	app.Get("/attendances", handler.GetAttendances)
+	app.Get("/attendances/summary", handler.GetDailySummary)
+	app.Post("/attendances/clock-in", handler.ClockIn)
+	app.Post("/attendances/clock-out", handler.ClockOut)
+	app.Get("/attendances/grace-periods/:shift_group_id", handler.GetGracePeriod)
+	app.Put("/attendances/grace-periods/:shift_group_id", handler.SetGracePeriod)
+	app.Get("/attendances/corrections", handler.GetCorrections)
+	app.Patch("/attendances/corrections/:id/approve", handler.ApproveCorrection)
+	app.Patch("/attendances/corrections/:id/reject", handler.RejectCorrection)
+	app.Get("/attendances/:id", handler.GetAttendanceByID)
+	app.Post("/attendances/:id/corrections", handler.RequestCorrection)
+
+	return app
+}
+
+func TestAttendanceHandler_GetAttendances(t *testing.T) {
+	mockUseCase := new(mocks.AttendancesUseCaseMock)
+	app := setupAttendanceApp(mockUseCase)
+
+	t.Run("PageSizeClamped", func(t *testing.T) {
+		for query, size := range map[string]int{"page_size=0": 1, "page_size=-5": 1, "page_size=1000": 100} {
+			mockUseCase.On("FindAll", mock.Anything, mock.MatchedBy(func(f domain.AttendanceFilter) bool {
+				return f.Page == 1 && f.Size == size
+			})).Return([]*domain.Attendance{}, int64(3), nil).Once()
+
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/attendances?page=0&"+query, nil))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		attendances := []*domain.Attendance{{Id: "a1", InstitutionId: "inst-1", Status: domain.StatusPresent}}
+
+		mockUseCase.On("FindAll", mock.Anything, mock.MatchedBy(func(f domain.AttendanceFilter) bool {
+			return f.InstitutionId == "inst-1" && f.DateFrom != nil
+		})).Return(attendances, int64(1), nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/attendances?date_from=2026-02-16", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidDate", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/attendances?date_from=16-02-2026", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestAttendanceHandler_GetAttendanceByID(t *testing.T) {
+	mockUseCase := new(mocks.AttendancesUseCaseMock)
+	app := setupAttendanceApp(mockUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		mockUseCase.On("Get", mock.Anything, "a1").Return(&domain.Attendance{Id: "a1", InstitutionId: "inst-1"}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/attendances/a1", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("OtherInstitution", func(t *testing.T) {
+		mockUseCase.On("Get", mock.Anything, "a2").Return(&domain.Attendance{Id: "a2", InstitutionId: "inst-2"}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/attendances/a2", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceHandler_ClockIn(t *testing.T) {
+	mockUseCase := new(mocks.AttendancesUseCaseMock)
+	app := setupAttendanceApp(mockUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		reqBody := map[string]interface{}{
+			"shift_session_id": "ss1",
+			"shift_group_id":   "sg1",
+		}
+		reqBytes, _ := json.Marshal(reqBody)
+
+		mockUseCase.On("ClockIn", mock.Anything, mock.MatchedBy(func(cmd domain.ClockInCommand) bool {
+			return cmd.UserId == "user-1" && cmd.InstitutionId == "inst-1" && cmd.ShiftSessionId == "ss1"
+		})).Return(&domain.Attendance{Id: "a1", ClockInAt: time.Now()}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/attendances/clock-in", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		reqBytes, _ := json.Marshal(map[string]interface{}{"shift_session_id": "ss1"})
+
+		mockUseCase.On("ClockIn", mock.Anything, mock.Anything).Return(nil, errors.Conflict("already clocked in for this shift session")).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/attendances/clock-in", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("MissingShiftSession", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/attendances/clock-in", bytes.NewReader([]byte(`{}`)))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestAttendanceHandler_ClockOut(t *testing.T) {
+	mockUseCase := new(mocks.AttendancesUseCaseMock)
+	app := setupAttendanceApp(mockUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		reqBytes, _ := json.Marshal(map[string]interface{}{"shift_session_id": "ss1", "work_date": "2026-02-16"})
+
+		mockUseCase.On("ClockOut", mock.Anything, mock.MatchedBy(func(cmd domain.ClockOutCommand) bool {
+			return cmd.UserId == "user-1" && cmd.WorkDate.Format("2006-01-02") == "2026-02-16"
+		})).Return(&domain.Attendance{Id: "a1"}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/attendances/clock-out", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceHandler_GetDailySummary(t *testing.T) {
+	mockUseCase := new(mocks.AttendancesUseCaseMock)
+	app := setupAttendanceApp(mockUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		summaries := []*domain.DailySummary{{ShiftSessionId: "ss1", Total: 3, Late: 1}}
+
+		mockUseCase.On("DailySummary", mock.Anything, "inst-1", mock.Anything).Return(summaries, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/attendances/summary?date=2026-02-16", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceHandler_GracePeriod(t *testing.T) {
+	mockUseCase := new(mocks.AttendancesUseCaseMock)
+	app := setupAttendanceApp(mockUseCase)
+
+	t.Run("Get", func(t *testing.T) {
+		mockUseCase.On("GetGracePeriod", mock.Anything, "inst-1", "sg1").Return(&domain.GracePeriod{ShiftGroupId: "sg1", LateGraceMinutes: 5}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/attendances/grace-periods/sg1", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		reqBytes, _ := json.Marshal(map[string]interface{}{"late_grace_minutes": 10, "early_leave_grace_minutes": 5})
+
+		mockUseCase.On("SetGracePeriod", mock.Anything, "inst-1", mock.MatchedBy(func(g *domain.GracePeriod) bool {
+			return g.ShiftGroupId == "sg1" && g.LateGraceMinutes == 10 && g.EarlyLeaveGraceMinutes == 5
+		})).Return(nil).Once()
+
+		req := httptest.NewRequest(http.MethodPut, "/attendances/grace-periods/sg1", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Get_OtherInstitution", func(t *testing.T) {
+		mockUseCase.On("GetGracePeriod", mock.Anything, "inst-1", "sg-other").Return(nil, errors.NotFound("shift group not found")).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/attendances/grace-periods/sg-other", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Set_UnknownGroup", func(t *testing.T) {
+		reqBytes, _ := json.Marshal(map[string]interface{}{"late_grace_minutes": 10, "early_leave_grace_minutes": 5})
+
+		mockUseCase.On("SetGracePeriod", mock.Anything, "inst-1", mock.MatchedBy(func(g *domain.GracePeriod) bool {
+			return g.ShiftGroupId == "missing"
+		})).Return(errors.NotFound("shift group not found")).Once()
+
+		req := httptest.NewRequest(http.MethodPut, "/attendances/grace-periods/missing", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestAttendanceHandler_Corrections(t *testing.T) {
+	mockUseCase := new(mocks.AttendancesUseCaseMock)
+	app := setupAttendanceApp(mockUseCase)
+
+	t.Run("List", func(t *testing.T) {
+		corrections := []*domain.AttendanceCorrection{{Id: "c1", Status: domain.CorrectionPending}}
+
+		mockUseCase.On("FindAllCorrections", mock.Anything, mock.MatchedBy(func(f domain.CorrectionFilter) bool {
+			return f.InstitutionId == "inst-1" && f.Status == domain.CorrectionPending
+		})).Return(corrections, int64(1), nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/attendances/corrections?status=pending", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Request", func(t *testing.T) {
+		reqBytes, _ := json.Marshal(map[string]interface{}{
+			"clock_in_at": "2026-02-16T08:00:00+07:00",
+			"reason":      "Forgot to clock in",
+		})
+
+		mockUseCase.On("RequestCorrection", mock.Anything, mock.MatchedBy(func(cmd domain.RequestCorrectionCommand) bool {
+			return cmd.AttendanceId == "a1" && cmd.RequestedBy == "user-1" && cmd.ClockInAt != nil
+		})).Return(&domain.AttendanceCorrection{Id: "c1", Status: domain.CorrectionPending}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/attendances/a1/corrections", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("RequestWithoutTimes", func(t *testing.T) {
+		reqBytes, _ := json.Marshal(map[string]interface{}{"reason": "Forgot"})
+
+		req := httptest.NewRequest(http.MethodPost, "/attendances/a1/corrections", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Approve", func(t *testing.T) {
+		mockUseCase.On("ReviewCorrection", mock.Anything, mock.MatchedBy(func(cmd domain.ReviewCorrectionCommand) bool {
+			return cmd.CorrectionId == "c1" && cmd.Approve && cmd.InstitutionId == "inst-1"
+		})).Return(&domain.AttendanceCorrection{Id: "c1", Status: domain.CorrectionApproved}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/attendances/corrections/c1/approve", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		reqBytes, _ := json.Marshal(map[string]interface{}{"note": "Not supported by CCTV"})
+
+		mockUseCase.On("ReviewCorrection", mock.Anything, mock.MatchedBy(func(cmd domain.ReviewCorrectionCommand) bool {
+			return cmd.CorrectionId == "c1" && !cmd.Approve && cmd.Note == "Not supported by CCTV"
+		})).Return(&domain.AttendanceCorrection{Id: "c1", Status: domain.CorrectionRejected}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPatch, "/attendances/corrections/c1/reject", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"github.com/siakup/morgan-be/libraries/validation"
+)
+
+type (
+	listQuery struct {
+		Page           int    `query:"page" default:"1"`
+		PageSize       int    `query:"page_size" default:"10"`
+		DateFrom       string `query:"date_from" validate:"omitempty,datetime=2006-01-02"`
+		DateTo         string `query:"date_to" validate:"omitempty,datetime=2006-01-02"`
+		UserId         string `query:"user_id"`
+		ShiftSessionId string `query:"shift_session_id"`
+		ShiftGroupId   string `query:"shift_group_id"`
+		Status         string `query:"status"`
+	}
+
+	summaryQuery struct {
+		Date string `query:"date" validate:"omitempty,datetime=2006-01-02" doc:"Day to summarize, today by default"`
+	}
+
+	correctionsQuery struct {
+		Page         int    `query:"page" default:"1"`
+		PageSize     int    `query:"page_size" default:"10"`
+		AttendanceId string `query:"attendance_id"`
+		Status       string `query:"status"`
+	}
+)
+
+// Endpoints lists the attendances routes: RegisterRoutes mounts them and the OpenAPI document describes them.
+func (h *AttendanceHandler) Endpoints() []openapi.Endpoint {
+	return []openapi.Endpoint{
+		{Method: fiber.MethodGet, Path: "/attendances", Summary: "List attendances", Permissions: []string{PermissionView}, Query: listQuery{}, Response: []AttendanceResponse{}, Paginated: true, Handlers: []fiber.Handler{h.GetAttendances}},
+		{Method: fiber.MethodGet, Path: "/attendances/summary", Summary: "Summarize the attendances of a day", Permissions: []string{PermissionView}, Query: summaryQuery{}, Response: []DailySummaryResponse{}, Handlers: []fiber.Handler{h.GetDailySummary}},
+		{Method: fiber.MethodPost, Path: "/attendances/clock-in", Summary: "Clock in", Description: "The shift group is taken from the user's membership; shift_group_id is only needed for users in several groups.", Permissions: []string{PermissionClock}, Request: ClockInRequest{}, Response: AttendanceResponse{}, Status: http.StatusCreated, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &ClockInRequest{} }), h.ClockIn}},
+		{Method: fiber.MethodPost, Path: "/attendances/clock-out", Summary: "Clock out", Permissions: []string{PermissionClock}, Request: ClockOutRequest{}, Response: AttendanceResponse{}, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &ClockOutRequest{} }), h.ClockOut}},
+		{Method: fiber.MethodGet, Path: "/attendances/grace-periods/:shift_group_id", Summary: "Get the grace period of a shift group", Permissions: []string{PermissionView}, Response: GracePeriodResponse{}, Handlers: []fiber.Handler{h.GetGracePeriod}},
+		{Method: fiber.MethodPut, Path: "/attendances/grace-periods/:shift_group_id", Summary: "Set the grace period of a shift group", Permissions: []string{PermissionEdit}, Request: SetGracePeriodRequest{}, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &SetGracePeriodRequest{} }), h.SetGracePeriod}},
+		{Method: fiber.MethodGet, Path: "/attendances/corrections", Summary: "List attendance corrections", Permissions: []string{PermissionApprove}, Query: correctionsQuery{}, Response: []CorrectionResponse{}, Paginated: true, Handlers: []fiber.Handler{h.GetCorrections}},
+		{Method: fiber.MethodPatch, Path: "/attendances/corrections/:id/approve", Summary: "Approve an attendance correction", Permissions: []string{PermissionApprove}, Request: ReviewCorrectionRequest{}, Response: CorrectionResponse{}, Handlers: []fiber.Handler{h.ApproveCorrection}},
+		{Method: fiber.MethodPatch, Path: "/attendances/corrections/:id/reject", Summary: "Reject an attendance correction", Permissions: []string{PermissionApprove}, Request: ReviewCorrectionRequest{}, Response: CorrectionResponse{}, Handlers: []fiber.Handler{h.RejectCorrection}},
+		{Method: fiber.MethodGet, Path: "/attendances/:id", Summary: "Get an attendance", Permissions: []string{PermissionView}, Response: AttendanceResponse{}, Handlers: []fiber.Handler{h.GetAttendanceByID}},
+		{Method: fiber.MethodPost, Path: "/attendances/:id/corrections", Summary: "Request an attendance correction", Permissions: []string{PermissionClock}, Request: RequestCorrectionRequest{}, Response: CorrectionResponse{}, Status: http.StatusCreated, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &RequestCorrectionRequest{} }), h.RequestCorrection}},
+	}
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+type (
+	RequestCorrectionRequest struct {
+		ClockInAt  *time.Time `json:"clock_in_at"`
+		ClockOutAt *time.Time `json:"clock_out_at"`
+		Reason     string     `json:"reason" validate:"required"`
+	}
+)
+
+// RequestCorrection handles POST /attendances/:id/corrections
+func (h *AttendanceHandler) RequestCorrection(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id := c.Params("id")
+	if id == "" {
+		return h.handleError(c, errors.BadRequest("Invalid ID"))
+	}
+
+	userId, ok := c.Locals(middleware.XUserIdKey).(string)
+	if !ok || userId == "" {
+		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
+	}
+
+	var req RequestCorrectionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.handleError(c, errors.BadRequest("Invalid request body"))
+	}
+
+	if req.Reason == "" {
+		return h.handleError(c, errors.BadRequest("field reason is required"))
+	}
+	if req.ClockInAt == nil && req.ClockOutAt == nil {
+		return h.handleError(c, errors.BadRequest("clock_in_at or clock_out_at is required"))
+	}
+
+	correction, err := h.useCase.RequestCorrection(ctx, domain.RequestCorrectionCommand{
+		AttendanceId: id,
+		ClockInAt:    req.ClockInAt,
+		ClockOutAt:   req.ClockOutAt,
+		Reason:       req.Reason,
+		RequestedBy:  userId,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(responses.Success(toCorrectionResponse(correction), "Attendance correction requested"))
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+type (
+	ReviewCorrectionRequest struct {
+		Note string `json:"note"`
+	}
+)
+
+// ApproveCorrection handles PATCH /attendances/corrections/:id/approve
+func (h *AttendanceHandler) ApproveCorrection(c *fiber.Ctx) error {
+	return h.reviewCorrection(c, true, "Attendance correction approved")
+}
+
+// RejectCorrection handles PATCH /attendances/corrections/:id/reject
+func (h *AttendanceHandler) RejectCorrection(c *fiber.Ctx) error {
+	return h.reviewCorrection(c, false, "Attendance correction rejected")
+}
+
+func (h *AttendanceHandler) reviewCorrection(c *fiber.Ctx, approve bool, message string) error {
+	ctx := c.UserContext()
+
+	id := c.Params("id")
+	if id == "" {
+		return h.handleError(c, errors.BadRequest("Invalid ID"))
+	}
+
+	userId, ok := c.Locals(middleware.XUserIdKey).(string)
+	if !ok || userId == "" {
+		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
+	}
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	// The note is optional, an empty body is accepted.
+	var req ReviewCorrectionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return h.handleError(c, errors.BadRequest("Invalid request body"))
+		}
+	}
+
+	correction, err := h.useCase.ReviewCorrection(ctx, domain.ReviewCorrectionCommand{
+		CorrectionId:  id,
+		InstitutionId: institutionId,
+		Approve:       approve,
+		ReviewedBy:    userId,
+		Note:          req.Note,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success(toCorrectionResponse(correction), message))
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/siakup/morgan-be/libraries/types"
+)
+
+// Attendance statuses derived from lateness and early leave.
+const (
+	StatusPresent        = "present"
+	StatusLate           = "late"
+	StatusEarlyLeave     = "early_leave"
+	StatusLateEarlyLeave = "late_early_leave"
+)
+
+// Correction statuses.
+const (
+	CorrectionPending  = "pending"
+	CorrectionApproved = "approved"
+	CorrectionRejected = "rejected"
+)
+
+// Attendance represents a user's actual attendance on a shift session for a given day.
+type Attendance struct {
+	Id                string     `object:"id"`
+	InstitutionId     string     `object:"institution_id"`
+	UserId            string     `object:"user_id"`
+	ShiftSessionId    string     `object:"shift_session_id"`
+	ShiftGroupId      *string    `object:"shift_group_id"` // Nullable
+	WorkDate          time.Time  `object:"work_date"`
+	ClockInAt         time.Time  `object:"clock_in_at"`
+	ClockOutAt        *time.Time `object:"clock_out_at"` // Nullable until clock-out
+	LateMinutes       int        `object:"late_minutes"`
+	EarlyLeaveMinutes int        `object:"early_leave_minutes"`
+	Status            string     `object:"status"`
+	Notes             *string    `object:"notes"`
+	CreatedAt         time.Time  `object:"created_at"`
+	UpdatedAt         time.Time  `object:"updated_at"`
+	CreatedBy         *string    `object:"created_by"`
+	UpdatedBy         *string    `object:"updated_by"`
+}
+
+// AttendanceCorrection represents a manual correction request awaiting approval.
+type AttendanceCorrection struct {
+	Id            string     `object:"id"`
+	InstitutionId string     `object:"institution_id"`
+	AttendanceId  string     `object:"attendance_id"`
+	ClockInAt     *time.Time `object:"clock_in_at"`
+	ClockOutAt    *time.Time `object:"clock_out_at"`
+	Reason        string     `object:"reason"`
+	Status        string     `object:"status"`
+	RequestedBy   string     `object:"requested_by"`
+	ReviewedBy    *string    `object:"reviewed_by"`
+	ReviewedAt    *time.Time `object:"reviewed_at"`
+	ReviewNote    *string    `object:"review_note"`
+	CreatedAt     time.Time  `object:"created_at"`
+	UpdatedAt     time.Time  `object:"updated_at"`
+}
+
+// GracePeriod holds the tolerated lateness and early leave for a shift group.
+type GracePeriod struct {
+	ShiftGroupId           string  `object:"shift_group_id"`
+	LateGraceMinutes       int     `object:"late_grace_minutes"`
+	EarlyLeaveGraceMinutes int     `object:"early_leave_grace_minutes"`
+	UpdatedBy              *string `object:"updated_by"`
+}
+
+// ShiftWindow is the expected start and end ("HH:MM:SS") of a shift session.
+type ShiftWindow struct {
+	ShiftSessionId string `object:"shift_session_id"`
+	Start          string `object:"start"`
+	End            string `object:"end"`
+}
+
+// DailySummary aggregates attendance for a single shift session on a day.
+type DailySummary struct {
+	ShiftSessionId   string `object:"shift_session_id"`
+	ShiftSessionName string `object:"shift_session_name"`
+	Total            int64  `object:"total"`
+	OnTime           int64  `object:"on_time"`
+	Late             int64  `object:"late"`
+	EarlyLeave       int64  `object:"early_leave"`
+	NotClockedOut    int64  `object:"not_clocked_out"`
+}
+
+// AttendanceFilter represents filter options for listing attendances.
+type AttendanceFilter struct {
+	types.Pagination
+	InstitutionId  string
+	UserId         string
+	ShiftSessionId string
+	ShiftGroupId   string
+	Status         string
+	DateFrom       *time.Time
+	DateTo         *time.Time
+}
+
+// CorrectionFilter represents filter options for listing correction requests.
+type CorrectionFilter struct {
+	types.Pagination
+	InstitutionId string
+	AttendanceId  string
+	Status        string
+}
+
+// AttendanceRepository defines the persistence layer contract.
+type AttendanceRepository interface {
+	FindAll(ctx context.Context, filter AttendanceFilter) ([]*Attendance, int64, error)
+	FindByID(ctx context.Context, id string) (*Attendance, error)
+	FindByUserSessionDate(ctx context.Context, userId string, shiftSessionId string, workDate time.Time) (*Attendance, error)
+	// FindOpenByUserSession returns the latest attendance of the user on the shift session that has no clock-out.
+	FindOpenByUserSession(ctx context.Context, userId string, shiftSessionId string) (*Attendance, error)
+	Store(ctx context.Context, attendance *Attendance) error
+	Update(ctx context.Context, attendance *Attendance) error
+
+	FindShiftWindow(ctx context.Context, shiftSessionId string) (*ShiftWindow, error)
+	FindGracePeriod(ctx context.Context, shiftGroupId string) (*GracePeriod, error)
+	// FindShiftGroupIDs lists the active shift groups the user is a member of.
+	FindShiftGroupIDs(ctx context.Context, userId string) ([]string, error)
+	UpsertGracePeriod(ctx context.Context, gracePeriod *GracePeriod) error
+	// FindShiftGroupOwner returns the institution owning a shift group available to the institution,
+	// nil when the shift group is shared. It returns pgx.ErrNoRows when the shift group is missing
+	// or belongs to another institution.
+	FindShiftGroupOwner(ctx context.Context, institutionId string, shiftGroupId string) (*string, error)
+
+	FindAllCorrections(ctx context.Context, filter CorrectionFilter) ([]*AttendanceCorrection, int64, error)
+	FindCorrectionByID(ctx context.Context, id string) (*AttendanceCorrection, error)
+	StoreCorrection(ctx context.Context, correction *AttendanceCorrection) error
+	// ReviewCorrection persists the review outcome and, when approved, the corrected attendance in one transaction.
+	ReviewCorrection(ctx context.Context, correction *AttendanceCorrection, attendance *Attendance) error
+
+	DailySummary(ctx context.Context, institutionId string, workDate time.Time) ([]*DailySummary, error)
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const clockLayout = "15:04:05"
+
+// Bounds resolves the shift window to absolute start and end times on the given work date.
+// Shifts whose end is not after their start (e.g. 22:00-06:00) end on the following day.
+func (w ShiftWindow) Bounds(workDate time.Time) (time.Time, time.Time, error) {
+	start, err := atClock(workDate, w.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid shift start %q: %w", w.Start, err)
+	}
+
+	end, err := atClock(workDate, w.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid shift end %q: %w", w.End, err)
+	}
+
+	if !end.After(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+
+	return start, end, nil
+}
+
+// Evaluate computes lateness, early leave and the resulting status of an attendance
+// against the shift window and grace period. Minutes are counted from the scheduled
+// start/end once the grace period is exceeded.
+func (a *Attendance) Evaluate(window ShiftWindow, grace GracePeriod) error {
+	start, end, err := window.Bounds(a.WorkDate)
+	if err != nil {
+		return err
+	}
+
+	a.LateMinutes = 0
+	if a.ClockInAt.After(start.Add(time.Duration(grace.LateGraceMinutes) * time.Minute)) {
+		a.LateMinutes = int(a.ClockInAt.Sub(start) / time.Minute)
+	}
+
+	a.EarlyLeaveMinutes = 0
+	if a.ClockOutAt != nil && a.ClockOutAt.Before(end.Add(-time.Duration(grace.EarlyLeaveGraceMinutes)*time.Minute)) {
+		a.EarlyLeaveMinutes = int(end.Sub(*a.ClockOutAt) / time.Minute)
+	}
+
+	switch {
+	case a.LateMinutes > 0 && a.EarlyLeaveMinutes > 0:
+		a.Status = StatusLateEarlyLeave
+	case a.LateMinutes > 0:
+		a.Status = StatusLate
+	case a.EarlyLeaveMinutes > 0:
+		a.Status = StatusEarlyLeave
+	default:
+		a.Status = StatusPresent
+	}
+
+	return nil
+}
+
+// atClock combines the calendar date of day with a "HH:MM[:SS]" clock value in day's location.
+func atClock(day time.Time, clock string) (time.Time, error) {
+	t, err := time.Parse(clockLayout, clock)
+	if err != nil {
+		t, err = time.Parse("15:04", clock)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	y, m, d := day.Date()
+	return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, day.Location()), nil
+}
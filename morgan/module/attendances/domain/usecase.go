@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// UseCase defines the business logic contract for the attendances module.
+type UseCase interface {
+	FindAll(ctx context.Context, filter AttendanceFilter) ([]*Attendance, int64, error)
+	Get(ctx context.Context, id string) (*Attendance, error)
+	ClockIn(ctx context.Context, cmd ClockInCommand) (*Attendance, error)
+	ClockOut(ctx context.Context, cmd ClockOutCommand) (*Attendance, error)
+	DailySummary(ctx context.Context, institutionId string, workDate time.Time) ([]*DailySummary, error)
+
+	GetGracePeriod(ctx context.Context, institutionId string, shiftGroupId string) (*GracePeriod, error)
+	SetGracePeriod(ctx context.Context, institutionId string, gracePeriod *GracePeriod) error
+
+	FindAllCorrections(ctx context.Context, filter CorrectionFilter) ([]*AttendanceCorrection, int64, error)
+	RequestCorrection(ctx context.Context, cmd RequestCorrectionCommand) (*AttendanceCorrection, error)
+	ReviewCorrection(ctx context.Context, cmd ReviewCorrectionCommand) (*AttendanceCorrection, error)
+}
+
+// ClockInCommand encapsulates data for clocking in on a shift session.
+type ClockInCommand struct {
+	InstitutionId  string
+	UserId         string
+	ShiftSessionId string
+	ShiftGroupId   string    // Optional, must be one of the user's shift groups
+	WorkDate       time.Time // Zero value means the open attendance, e.g. of an overnight shift started the day before
+	At             time.Time
+	Notes          string
+}
+
+// ClockOutCommand encapsulates data for clocking out of a shift session.
+type ClockOutCommand struct {
+	UserId         string
+	ShiftSessionId string
+	WorkDate       time.Time // Zero value means the day of At
+	At             time.Time
+}
+
+// RequestCorrectionCommand encapsulates data for requesting a manual correction.
+type RequestCorrectionCommand struct {
+	AttendanceId string
+	ClockInAt    *time.Time
+	ClockOutAt   *time.Time
+	Reason       string
+	RequestedBy  string
+}
+
+// ReviewCorrectionCommand encapsulates the approval or rejection of a correction.
+type ReviewCorrectionCommand struct {
+	CorrectionId  string
+	InstitutionId string
+	Approve       bool
+	ReviewedBy    string
+	Note          string
+}
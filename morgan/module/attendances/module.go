@@ -0,0 +1,40 @@
+package attendances
+
+import (
+	"github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/registry"
+	"github.com/siakup/morgan-be/morgan/module/attendances/delivery/http"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+	"github.com/siakup/morgan-be/morgan/module/attendances/repository/postgresql"
+	"github.com/siakup/morgan-be/morgan/module/attendances/usecase"
+	"go.uber.org/fx"
+)
+
+// Module exports the attendances module for Fx.
+var Module = fx.Options(
+	fx.Provide(
+		postgresql.NewRepository,
+		fx.Annotate(
+			postgresql.NewRepository,
+			fx.As(new(domain.AttendanceRepository)),
+		),
+		usecase.NewUseCase,
+		fx.Annotate(
+			usecase.NewUseCase,
+			fx.As(new(domain.UseCase)),
+		),
+		http.NewAttendanceHandler,
+	),
+	registry.Provide(newModule),
+)
+
+func newModule(h *http.AttendanceHandler) registry.Module {
+	return registry.Module{
+		Name:        "attendances",
+		Version:     fiber.V1,
+		BasePath:    "/attendances",
+		Permissions: http.Permissions,
+		Endpoints:   h.Endpoints(),
+		Register:    h.RegisterRoutes,
+	}
+}
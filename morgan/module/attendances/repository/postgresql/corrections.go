@@ -0,0 +1,177 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/object"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+const correctionColumns = `
+		id,
+		institution_id,
+		attendance_id,
+		clock_in_at,
+		clock_out_at,
+		reason,
+		status,
+		requested_by,
+		reviewed_by,
+		reviewed_at,
+		review_note,
+		created_at,
+		updated_at
+`
+
+var queryFindCorrectionById = `
+	SELECT` + correctionColumns + `
+	FROM hr.attendance_corrections
+	WHERE id = @id
+	LIMIT 1
+`
+
+var queryStoreCorrection = `
+	INSERT INTO hr.attendance_corrections (
+		institution_id, attendance_id, clock_in_at, clock_out_at, reason, status, requested_by
+	) VALUES (
+		@institution_id, @attendance_id, @clock_in_at, @clock_out_at, @reason, @status, @requested_by
+	)
+	RETURNING id
+`
+
+var queryReviewCorrection = `
+	UPDATE hr.attendance_corrections
+	SET
+		status = @status,
+		reviewed_by = @reviewed_by,
+		reviewed_at = @reviewed_at,
+		review_note = @review_note,
+		updated_at = now()
+	WHERE id = @id AND status = 'pending'
+`
+
+// FindAllCorrections retrieves a list of correction requests based on the provided filter.
+func (r *Repository) FindAllCorrections(ctx context.Context, filter domain.CorrectionFilter) ([]*domain.AttendanceCorrection, int64, error) {
+	baseQuery := `
+		FROM hr.attendance_corrections
+		WHERE institution_id = @institution_id
+	`
+	args := pgx.NamedArgs{
+		"institution_id": filter.InstitutionId,
+	}
+
+	if filter.AttendanceId != "" {
+		baseQuery += " AND attendance_id = @attendance_id"
+		args["attendance_id"] = filter.AttendanceId
+	}
+
+	if filter.Status != "" {
+		baseQuery += " AND status = @status"
+		args["status"] = filter.Status
+	}
+
+	// 1. Count Total
+	var total int64
+	countQuery := "SELECT count(id)" + baseQuery
+	if err := r.db.QueryRow(ctx, countQuery, args).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// 2. Select Data
+	selectQuery := "SELECT" + correctionColumns + baseQuery + " ORDER BY created_at DESC LIMIT @limit OFFSET @offset"
+
+	args["limit"] = filter.Pagination.GetLimit()
+	args["offset"] = filter.Pagination.GetOffset()
+
+	rows, err := r.db.Query(ctx, selectQuery, args)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	records, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[AttendanceCorrectionEntity])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	corrections, err := object.ParseAll[*AttendanceCorrectionEntity, *domain.AttendanceCorrection](object.TagDB, object.TagObject, records)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return corrections, total, nil
+}
+
+// FindCorrectionByID retrieves a single correction request by its ID.
+func (r *Repository) FindCorrectionByID(ctx context.Context, id string) (*domain.AttendanceCorrection, error) {
+	rows, err := r.db.Query(ctx, queryFindCorrectionById, pgx.NamedArgs{
+		"id": id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[AttendanceCorrectionEntity])
+	if err != nil {
+		return nil, err
+	}
+
+	return object.Parse[*AttendanceCorrectionEntity, *domain.AttendanceCorrection](object.TagDB, object.TagObject, record)
+}
+
+// StoreCorrection persists a new correction request.
+func (r *Repository) StoreCorrection(ctx context.Context, correction *domain.AttendanceCorrection) error {
+	rows, err := r.db.Query(ctx, queryStoreCorrection, pgx.NamedArgs{
+		"institution_id": correction.InstitutionId,
+		"attendance_id":  correction.AttendanceId,
+		"clock_in_at":    correction.ClockInAt,
+		"clock_out_at":   correction.ClockOutAt,
+		"reason":         correction.Reason,
+		"status":         correction.Status,
+		"requested_by":   correction.RequestedBy,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Scan returning ID
+	var id string
+	if _, err := pgx.ForEachRow(rows, []any{&id}, func() error { return nil }); err != nil {
+		return err
+	}
+	correction.Id = id
+	return nil
+}
+
+// ReviewCorrection stores the review outcome of a correction and, if given, the corrected attendance.
+// Both writes happen in a single transaction; a correction reviewed concurrently yields pgx.ErrNoRows.
+func (r *Repository) ReviewCorrection(ctx context.Context, correction *domain.AttendanceCorrection, attendance *domain.Attendance) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, queryReviewCorrection, pgx.NamedArgs{
+		"id":          correction.Id,
+		"status":      correction.Status,
+		"reviewed_by": correction.ReviewedBy,
+		"reviewed_at": correction.ReviewedAt,
+		"review_note": correction.ReviewNote,
+	})
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if attendance != nil {
+		if _, err := tx.Exec(ctx, queryUpdate, updateArgs(attendance)); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
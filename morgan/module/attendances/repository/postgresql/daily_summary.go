@@ -0,0 +1,46 @@
+package postgresql
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/object"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+var queryDailySummary = `
+	SELECT
+		ss.id AS shift_session_id,
+		ss.name AS shift_session_name,
+		count(a.id) AS total,
+		count(a.id) FILTER (WHERE a.status = 'present') AS on_time,
+		count(a.id) FILTER (WHERE a.late_minutes > 0) AS late,
+		count(a.id) FILTER (WHERE a.early_leave_minutes > 0) AS early_leave,
+		count(a.id) FILTER (WHERE a.clock_out_at IS NULL) AS not_clocked_out
+	FROM hr.attendances a
+	JOIN hr.shift_sessions ss ON ss.id = a.shift_session_id
+	WHERE a.institution_id = @institution_id
+	AND a.work_date = @work_date
+	GROUP BY ss.id, ss.name, ss.start
+	ORDER BY ss.start
+`
+
+// DailySummary aggregates attendance counters per shift session for a day.
+func (r *Repository) DailySummary(ctx context.Context, institutionId string, workDate time.Time) ([]*domain.DailySummary, error) {
+	rows, err := r.db.Query(ctx, queryDailySummary, pgx.NamedArgs{
+		"institution_id": institutionId,
+		"work_date":      workDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[DailySummaryEntity])
+	if err != nil {
+		return nil, err
+	}
+
+	return object.ParseAll[*DailySummaryEntity, *domain.DailySummary](object.TagDB, object.TagObject, records)
+}
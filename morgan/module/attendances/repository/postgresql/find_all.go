@@ -0,0 +1,81 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/object"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// FindAll retrieves a list of attendances based on the provided filter.
+func (r *Repository) FindAll(ctx context.Context, filter domain.AttendanceFilter) ([]*domain.Attendance, int64, error) {
+	baseQuery := `
+		FROM hr.attendances
+		WHERE institution_id = @institution_id
+	`
+	args := pgx.NamedArgs{
+		"institution_id": filter.InstitutionId,
+	}
+
+	if filter.UserId != "" {
+		baseQuery += " AND user_id = @user_id"
+		args["user_id"] = filter.UserId
+	}
+
+	if filter.ShiftSessionId != "" {
+		baseQuery += " AND shift_session_id = @shift_session_id"
+		args["shift_session_id"] = filter.ShiftSessionId
+	}
+
+	if filter.ShiftGroupId != "" {
+		baseQuery += " AND shift_group_id = @shift_group_id"
+		args["shift_group_id"] = filter.ShiftGroupId
+	}
+
+	if filter.Status != "" {
+		baseQuery += " AND status = @status"
+		args["status"] = filter.Status
+	}
+
+	if filter.DateFrom != nil {
+		baseQuery += " AND work_date >= @date_from"
+		args["date_from"] = *filter.DateFrom
+	}
+
+	if filter.DateTo != nil {
+		baseQuery += " AND work_date <= @date_to"
+		args["date_to"] = *filter.DateTo
+	}
+
+	// 1. Count Total
+	var total int64
+	countQuery := "SELECT count(id)" + baseQuery
+	if err := r.db.QueryRow(ctx, countQuery, args).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// 2. Select Data
+	selectQuery := "SELECT" + attendanceColumns + baseQuery + " ORDER BY work_date DESC, clock_in_at DESC LIMIT @limit OFFSET @offset"
+
+	args["limit"] = filter.Pagination.GetLimit()
+	args["offset"] = filter.Pagination.GetOffset()
+
+	rows, err := r.db.Query(ctx, selectQuery, args)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	records, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[AttendanceEntity])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	attendances, err := object.ParseAll[*AttendanceEntity, *domain.Attendance](object.TagDB, object.TagObject, records)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return attendances, total, nil
+}
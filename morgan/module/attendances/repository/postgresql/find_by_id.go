@@ -0,0 +1,103 @@
+package postgresql
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/object"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+const attendanceColumns = `
+		id,
+		institution_id,
+		user_id,
+		shift_session_id,
+		shift_group_id,
+		work_date,
+		clock_in_at,
+		clock_out_at,
+		late_minutes,
+		early_leave_minutes,
+		status,
+		notes,
+		created_at,
+		updated_at,
+		created_by,
+		updated_by
+`
+
+var queryFindById = `
+	SELECT` + attendanceColumns + `
+	FROM hr.attendances
+	WHERE id = @id
+	LIMIT 1
+`
+
+var queryFindByUserSessionDate = `
+	SELECT` + attendanceColumns + `
+	FROM hr.attendances
+	WHERE user_id = @user_id
+	AND shift_session_id = @shift_session_id
+	AND work_date = @work_date
+	LIMIT 1
+`
+
+var queryFindOpenByUserSession = `
+	SELECT` + attendanceColumns + `
+	FROM hr.attendances
+	WHERE user_id = @user_id
+	AND shift_session_id = @shift_session_id
+	AND clock_out_at IS NULL
+	ORDER BY work_date DESC
+	LIMIT 1
+`
+
+// FindByID retrieves a single attendance by its ID.
+func (r *Repository) FindByID(ctx context.Context, id string) (*domain.Attendance, error) {
+	rows, err := r.db.Query(ctx, queryFindById, pgx.NamedArgs{
+		"id": id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return collectAttendance(rows)
+}
+
+// FindByUserSessionDate retrieves the attendance of a user on a shift session for a day.
+func (r *Repository) FindByUserSessionDate(ctx context.Context, userId string, shiftSessionId string, workDate time.Time) (*domain.Attendance, error) {
+	rows, err := r.db.Query(ctx, queryFindByUserSessionDate, pgx.NamedArgs{
+		"user_id":          userId,
+		"shift_session_id": shiftSessionId,
+		"work_date":        workDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return collectAttendance(rows)
+}
+
+// FindOpenByUserSession retrieves the latest attendance of a user on a shift session without a clock-out.
+func (r *Repository) FindOpenByUserSession(ctx context.Context, userId string, shiftSessionId string) (*domain.Attendance, error) {
+	rows, err := r.db.Query(ctx, queryFindOpenByUserSession, pgx.NamedArgs{
+		"user_id":          userId,
+		"shift_session_id": shiftSessionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return collectAttendance(rows)
+}
+
+func collectAttendance(rows pgx.Rows) (*domain.Attendance, error) {
+	record, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[AttendanceEntity])
+	if err != nil {
+		return nil, err
+	}
+
+	return object.Parse[*AttendanceEntity, *domain.Attendance](object.TagDB, object.TagObject, record)
+}
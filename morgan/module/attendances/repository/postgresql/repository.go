@@ -0,0 +1,83 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+var _ domain.AttendanceRepository = (*Repository)(nil)
+
+// AttendanceEntity maps to hr.attendances table.
+type AttendanceEntity struct {
+	Id                string     `db:"id"`
+	InstitutionId     string     `db:"institution_id"`
+	UserId            string     `db:"user_id"`
+	ShiftSessionId    string     `db:"shift_session_id"`
+	ShiftGroupId      *string    `db:"shift_group_id"`
+	WorkDate          time.Time  `db:"work_date"`
+	ClockInAt         time.Time  `db:"clock_in_at"`
+	ClockOutAt        *time.Time `db:"clock_out_at"`
+	LateMinutes       int        `db:"late_minutes"`
+	EarlyLeaveMinutes int        `db:"early_leave_minutes"`
+	Status            string     `db:"status"`
+	Notes             *string    `db:"notes"`
+	CreatedAt         time.Time  `db:"created_at"`
+	UpdatedAt         time.Time  `db:"updated_at"`
+	CreatedBy         *string    `db:"created_by"`
+	UpdatedBy         *string    `db:"updated_by"`
+}
+
+// AttendanceCorrectionEntity maps to hr.attendance_corrections table.
+type AttendanceCorrectionEntity struct {
+	Id            string     `db:"id"`
+	InstitutionId string     `db:"institution_id"`
+	AttendanceId  string     `db:"attendance_id"`
+	ClockInAt     *time.Time `db:"clock_in_at"`
+	ClockOutAt    *time.Time `db:"clock_out_at"`
+	Reason        string     `db:"reason"`
+	Status        string     `db:"status"`
+	RequestedBy   string     `db:"requested_by"`
+	ReviewedBy    *string    `db:"reviewed_by"`
+	ReviewedAt    *time.Time `db:"reviewed_at"`
+	ReviewNote    *string    `db:"review_note"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+// GracePeriodEntity maps to hr.shift_group_grace_periods table.
+type GracePeriodEntity struct {
+	ShiftGroupId           string  `db:"shift_group_id"`
+	LateGraceMinutes       int     `db:"late_grace_minutes"`
+	EarlyLeaveGraceMinutes int     `db:"early_leave_grace_minutes"`
+	UpdatedBy              *string `db:"updated_by"`
+}
+
+// ShiftWindowEntity holds the expected start and end of a shift session.
+type ShiftWindowEntity struct {
+	ShiftSessionId string `db:"shift_session_id"`
+	Start          string `db:"start"`
+	End            string `db:"end"`
+}
+
+// DailySummaryEntity holds per shift session attendance counters.
+type DailySummaryEntity struct {
+	ShiftSessionId   string `db:"shift_session_id"`
+	ShiftSessionName string `db:"shift_session_name"`
+	Total            int64  `db:"total"`
+	OnTime           int64  `db:"on_time"`
+	Late             int64  `db:"late"`
+	EarlyLeave       int64  `db:"early_leave"`
+	NotClockedOut    int64  `db:"not_clocked_out"`
+}
+
+// Repository implements domain.AttendanceRepository.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+// NewRepository creates a new Attendance Repository.
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
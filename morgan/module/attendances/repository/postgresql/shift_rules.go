@@ -0,0 +1,128 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/object"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+var queryFindShiftWindow = `
+	SELECT
+		id AS shift_session_id,
+		to_char(start, 'HH24:MI:SS') AS start,
+		to_char("end", 'HH24:MI:SS') AS "end"
+	FROM hr.shift_sessions
+	WHERE id = @id AND deleted_at IS NULL
+	LIMIT 1
+`
+
+var queryFindGracePeriod = `
+	SELECT
+		shift_group_id, late_grace_minutes, early_leave_grace_minutes, updated_by
+	FROM hr.shift_group_grace_periods
+	WHERE shift_group_id = @shift_group_id
+	LIMIT 1
+`
+
+var queryFindShiftGroupIDs = `
+	SELECT m.shift_group_id
+	FROM hr.shift_group_members m
+	JOIN hr.shift_groups g ON g.id = m.shift_group_id
+	WHERE m.user_id = @user_id AND g.deleted_at IS NULL AND g.status
+	ORDER BY m.shift_group_id
+`
+
+var queryFindShiftGroupOwner = `
+	SELECT institution_id
+	FROM hr.shift_groups
+	WHERE id = @id AND deleted_at IS NULL
+		AND (institution_id IS NULL OR institution_id = @institution_id)
+`
+
+var queryUpsertGracePeriod = `
+	INSERT INTO hr.shift_group_grace_periods (
+		shift_group_id, late_grace_minutes, early_leave_grace_minutes, created_by, updated_by
+	) VALUES (
+		@shift_group_id, @late_grace_minutes, @early_leave_grace_minutes, @updated_by, @updated_by
+	)
+	ON CONFLICT (shift_group_id)
+	DO UPDATE SET
+		late_grace_minutes = EXCLUDED.late_grace_minutes,
+		early_leave_grace_minutes = EXCLUDED.early_leave_grace_minutes,
+		updated_by = EXCLUDED.updated_by,
+		updated_at = now()
+`
+
+// FindShiftWindow retrieves the expected start and end of a shift session.
+func (r *Repository) FindShiftWindow(ctx context.Context, shiftSessionId string) (*domain.ShiftWindow, error) {
+	rows, err := r.db.Query(ctx, queryFindShiftWindow, pgx.NamedArgs{
+		"id": shiftSessionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[ShiftWindowEntity])
+	if err != nil {
+		return nil, err
+	}
+
+	return object.Parse[*ShiftWindowEntity, *domain.ShiftWindow](object.TagDB, object.TagObject, record)
+}
+
+// FindGracePeriod retrieves the grace period configured for a shift group.
+func (r *Repository) FindGracePeriod(ctx context.Context, shiftGroupId string) (*domain.GracePeriod, error) {
+	rows, err := r.db.Query(ctx, queryFindGracePeriod, pgx.NamedArgs{
+		"shift_group_id": shiftGroupId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[GracePeriodEntity])
+	if err != nil {
+		return nil, err
+	}
+
+	return object.Parse[*GracePeriodEntity, *domain.GracePeriod](object.TagDB, object.TagObject, record)
+}
+
+// FindShiftGroupIDs lists the active shift groups the user is a member of.
+func (r *Repository) FindShiftGroupIDs(ctx context.Context, userId string) ([]string, error) {
+	rows, err := r.db.Query(ctx, queryFindShiftGroupIDs, pgx.NamedArgs{
+		"user_id": userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// FindShiftGroupOwner returns the institution owning a shift group available to the institution,
+// nil when the shift group is shared.
+func (r *Repository) FindShiftGroupOwner(ctx context.Context, institutionId string, shiftGroupId string) (*string, error) {
+	var owner *string
+	err := r.db.QueryRow(ctx, queryFindShiftGroupOwner, pgx.NamedArgs{
+		"id":             shiftGroupId,
+		"institution_id": institutionId,
+	}).Scan(&owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return owner, nil
+}
+
+// UpsertGracePeriod creates or replaces the grace period of a shift group.
+func (r *Repository) UpsertGracePeriod(ctx context.Context, gracePeriod *domain.GracePeriod) error {
+	_, err := r.db.Exec(ctx, queryUpsertGracePeriod, pgx.NamedArgs{
+		"shift_group_id":            gracePeriod.ShiftGroupId,
+		"late_grace_minutes":        gracePeriod.LateGraceMinutes,
+		"early_leave_grace_minutes": gracePeriod.EarlyLeaveGraceMinutes,
+		"updated_by":                gracePeriod.UpdatedBy,
+	})
+	return err
+}
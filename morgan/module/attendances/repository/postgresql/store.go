@@ -0,0 +1,50 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+var queryStore = `
+	INSERT INTO hr.attendances (
+		institution_id, user_id, shift_session_id, shift_group_id, work_date,
+		clock_in_at, late_minutes, early_leave_minutes, status, notes,
+		created_by, updated_by
+	) VALUES (
+		@institution_id, @user_id, @shift_session_id, @shift_group_id, @work_date,
+		@clock_in_at, @late_minutes, @early_leave_minutes, @status, @notes,
+		@created_by, @updated_by
+	)
+	RETURNING id
+`
+
+// Store persists a new attendance record.
+func (r *Repository) Store(ctx context.Context, attendance *domain.Attendance) error {
+	rows, err := r.db.Query(ctx, queryStore, pgx.NamedArgs{
+		"institution_id":      attendance.InstitutionId,
+		"user_id":             attendance.UserId,
+		"shift_session_id":    attendance.ShiftSessionId,
+		"shift_group_id":      attendance.ShiftGroupId,
+		"work_date":           attendance.WorkDate,
+		"clock_in_at":         attendance.ClockInAt,
+		"late_minutes":        attendance.LateMinutes,
+		"early_leave_minutes": attendance.EarlyLeaveMinutes,
+		"status":              attendance.Status,
+		"notes":               attendance.Notes,
+		"created_by":          attendance.CreatedBy,
+		"updated_by":          attendance.UpdatedBy,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Scan returning ID
+	var id string
+	if _, err := pgx.ForEachRow(rows, []any{&id}, func() error { return nil }); err != nil {
+		return err
+	}
+	attendance.Id = id
+	return nil
+}
@@ -0,0 +1,39 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+var queryUpdate = `
+	UPDATE hr.attendances
+	SET
+		clock_in_at = @clock_in_at,
+		clock_out_at = @clock_out_at,
+		late_minutes = @late_minutes,
+		early_leave_minutes = @early_leave_minutes,
+		status = @status,
+		updated_by = @updated_by,
+		updated_at = now()
+	WHERE id = @id
+`
+
+// Update modifies the clock times and evaluation of an attendance record.
+func (r *Repository) Update(ctx context.Context, attendance *domain.Attendance) error {
+	_, err := r.db.Exec(ctx, queryUpdate, updateArgs(attendance))
+	return err
+}
+
+func updateArgs(attendance *domain.Attendance) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"id":                  attendance.Id,
+		"clock_in_at":         attendance.ClockInAt,
+		"clock_out_at":        attendance.ClockOutAt,
+		"late_minutes":        attendance.LateMinutes,
+		"early_leave_minutes": attendance.EarlyLeaveMinutes,
+		"status":              attendance.Status,
+		"updated_by":          attendance.UpdatedBy,
+	}
+}
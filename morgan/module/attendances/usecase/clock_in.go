@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// ClockIn records the start of a user's attendance on a shift session.
+func (u *UseCase) ClockIn(ctx context.Context, cmd domain.ClockInCommand) (*domain.Attendance, error) {
+	ctx, span := u.tracer.Start(ctx, "ClockIn")
+	defer span.End()
+
+	logger := zerolog.Ctx(ctx)
+
+	if cmd.UserId == "" || cmd.InstitutionId == "" || cmd.ShiftSessionId == "" {
+		return nil, errors.BadRequest("missing required fields")
+	}
+
+	workDate := cmd.WorkDate
+	if workDate.IsZero() {
+		workDate = cmd.At
+	}
+	workDate = dateOf(workDate)
+
+	shiftGroupId, err := u.resolveShiftGroup(ctx, cmd.UserId, cmd.ShiftGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.repository.FindByUserSessionDate(ctx, cmd.UserId, cmd.ShiftSessionId, workDate)
+	if err != nil && !errs.Is(err, pgx.ErrNoRows) {
+		logger.Error().
+			Str("func", "repository.FindByUserSessionDate").
+			Err(err).
+			Msg("failed to check existing attendance")
+		return nil, errors.InternalServerError("failed to clock in")
+	}
+	if existing != nil {
+		return nil, errors.Conflict("already clocked in for this shift session")
+	}
+
+	attendance := &domain.Attendance{
+		InstitutionId:  cmd.InstitutionId,
+		UserId:         cmd.UserId,
+		ShiftSessionId: cmd.ShiftSessionId,
+		ShiftGroupId:   shiftGroupId,
+		WorkDate:       workDate,
+		ClockInAt:      cmd.At,
+		CreatedBy:      &cmd.UserId,
+		UpdatedBy:      &cmd.UserId,
+	}
+	if cmd.Notes != "" {
+		attendance.Notes = &cmd.Notes
+	}
+
+	window, grace, err := u.loadRules(ctx, attendance.ShiftSessionId, attendance.ShiftGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := attendance.Evaluate(window, grace); err != nil {
+		logger.Error().Err(err).Msg("failed to evaluate attendance")
+		return nil, errors.InternalServerError("failed to evaluate attendance")
+	}
+
+	if err := u.repository.Store(ctx, attendance); err != nil {
+		logger.Error().
+			Str("func", "repository.Store").
+			Err(err).
+			Msg("failed to store attendance")
+		return nil, errors.InternalServerError("failed to clock in")
+	}
+
+	return attendance, nil
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// ClockOut records the end of a user's attendance on a shift session.
+// Without a work date it closes the open attendance, which may have started the day before.
+func (u *UseCase) ClockOut(ctx context.Context, cmd domain.ClockOutCommand) (*domain.Attendance, error) {
+	ctx, span := u.tracer.Start(ctx, "ClockOut")
+	defer span.End()
+
+	logger := zerolog.Ctx(ctx)
+
+	if cmd.UserId == "" || cmd.ShiftSessionId == "" {
+		return nil, errors.BadRequest("missing required fields")
+	}
+
+	var (
+		attendance *domain.Attendance
+		err        error
+		find       = "repository.FindOpenByUserSession"
+	)
+	if cmd.WorkDate.IsZero() {
+		attendance, err = u.repository.FindOpenByUserSession(ctx, cmd.UserId, cmd.ShiftSessionId)
+	} else {
+		find = "repository.FindByUserSessionDate"
+		attendance, err = u.repository.FindByUserSessionDate(ctx, cmd.UserId, cmd.ShiftSessionId, dateOf(cmd.WorkDate))
+	}
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("no clock-in found for this shift session")
+		}
+
+		logger.Error().
+			Str("func", find).
+			Err(err).
+			Msg("failed to find attendance")
+		return nil, errors.InternalServerError("failed to clock out")
+	}
+
+	if attendance.ClockOutAt != nil {
+		return nil, errors.Conflict("already clocked out for this shift session")
+	}
+	if cmd.At.Before(attendance.ClockInAt) {
+		return nil, errors.BadRequest("clock-out cannot be before clock-in")
+	}
+
+	at := cmd.At
+	attendance.ClockOutAt = &at
+	attendance.UpdatedBy = &cmd.UserId
+
+	window, grace, err := u.loadRules(ctx, attendance.ShiftSessionId, attendance.ShiftGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := attendance.Evaluate(window, grace); err != nil {
+		logger.Error().Err(err).Msg("failed to evaluate attendance")
+		return nil, errors.InternalServerError("failed to evaluate attendance")
+	}
+
+	if err := u.repository.Update(ctx, attendance); err != nil {
+		logger.Error().
+			Str("func", "repository.Update").
+			Err(err).
+			Msg("failed to update attendance")
+		return nil, errors.InternalServerError("failed to clock out")
+	}
+
+	return attendance, nil
+}
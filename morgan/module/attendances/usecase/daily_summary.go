@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// DailySummary aggregates attendance per shift session for the given day.
+func (u *UseCase) DailySummary(ctx context.Context, institutionId string, workDate time.Time) ([]*domain.DailySummary, error) {
+	ctx, span := u.tracer.Start(ctx, "DailySummary")
+	defer span.End()
+
+	logger := zerolog.Ctx(ctx)
+
+	summaries, err := u.repository.DailySummary(ctx, institutionId, dateOf(workDate))
+	if err != nil {
+		logger.Error().
+			Str("func", "repository.DailySummary").
+			Err(err).
+			Msg("failed to summarize attendances")
+
+		return nil, errors.InternalServerError("failed to summarize attendances")
+	}
+
+	return summaries, nil
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// FindAll retrieves a list of attendances based on filter criteria.
+func (u *UseCase) FindAll(ctx context.Context, filter domain.AttendanceFilter) ([]*domain.Attendance, int64, error) {
+	ctx, span := u.tracer.Start(ctx, "FindAll")
+	defer span.End()
+
+	logger := zerolog.Ctx(ctx)
+
+	attendances, total, err := u.repository.FindAll(ctx, filter)
+	if err != nil {
+		logger.Error().
+			Str("func", "repository.FindAll").
+			Err(err).
+			Msg("failed to find attendances")
+
+		return nil, 0, errors.InternalServerError("failed to find attendances")
+	}
+
+	return attendances, total, nil
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// FindAllCorrections retrieves a list of correction requests based on filter criteria.
+func (u *UseCase) FindAllCorrections(ctx context.Context, filter domain.CorrectionFilter) ([]*domain.AttendanceCorrection, int64, error) {
+	ctx, span := u.tracer.Start(ctx, "FindAllCorrections")
+	defer span.End()
+
+	logger := zerolog.Ctx(ctx)
+
+	corrections, total, err := u.repository.FindAllCorrections(ctx, filter)
+	if err != nil {
+		logger.Error().
+			Str("func", "repository.FindAllCorrections").
+			Err(err).
+			Msg("failed to find attendance corrections")
+
+		return nil, 0, errors.InternalServerError("failed to find attendance corrections")
+	}
+
+	return corrections, total, nil
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// Get finds an attendance record by its unique identifier.
+func (u *UseCase) Get(ctx context.Context, id string) (*domain.Attendance, error) {
+	ctx, span := u.tracer.Start(ctx, "Get")
+	defer span.End()
+
+	logger := zerolog.Ctx(ctx)
+
+	attendance, err := u.repository.FindByID(ctx, id)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("attendance not found")
+		}
+
+		logger.Error().
+			Str("func", "repository.FindByID").
+			Err(err).
+			Msg("failed to find attendance by id")
+		return nil, errors.InternalServerError("failed to find attendance by id")
+	}
+
+	return attendance, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// GetGracePeriod returns the grace period configured for a shift group available to the institution.
+// Shift groups without configuration get a zero grace period.
+func (u *UseCase) GetGracePeriod(ctx context.Context, institutionId string, shiftGroupId string) (*domain.GracePeriod, error) {
+	ctx, span := u.tracer.Start(ctx, "GetGracePeriod")
+	defer span.End()
+
+	logger := zerolog.Ctx(ctx)
+
+	if _, err := u.findShiftGroupOwner(ctx, institutionId, shiftGroupId); err != nil {
+		return nil, err
+	}
+
+	gracePeriod, err := u.repository.FindGracePeriod(ctx, shiftGroupId)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return &domain.GracePeriod{ShiftGroupId: shiftGroupId}, nil
+		}
+
+		logger.Error().
+			Str("func", "repository.FindGracePeriod").
+			Err(err).
+			Msg("failed to find grace period")
+		return nil, errors.InternalServerError("failed to find grace period")
+	}
+
+	return gracePeriod, nil
+}
+
+// SetGracePeriod creates or replaces the grace period of a shift group of the institution.
+// Shared shift groups are refused: their grace period applies to every institution.
+func (u *UseCase) SetGracePeriod(ctx context.Context, institutionId string, gracePeriod *domain.GracePeriod) error {
+	ctx, span := u.tracer.Start(ctx, "SetGracePeriod")
+	defer span.End()
+
+	logger := zerolog.Ctx(ctx)
+
+	if gracePeriod.LateGraceMinutes < 0 || gracePeriod.EarlyLeaveGraceMinutes < 0 {
+		return errors.BadRequest("grace period minutes cannot be negative")
+	}
+
+	owner, err := u.findShiftGroupOwner(ctx, institutionId, gracePeriod.ShiftGroupId)
+	if err != nil {
+		return err
+	}
+	if owner == nil {
+		return errors.Forbidden("grace periods of shared shift groups cannot be modified")
+	}
+
+	if err := u.repository.UpsertGracePeriod(ctx, gracePeriod); err != nil {
+		logger.Error().
+			Str("func", "repository.UpsertGracePeriod").
+			Err(err).
+			Msg("failed to store grace period")
+
+		return errors.InternalServerError("failed to store grace period")
+	}
+
+	return nil
+}
+
+// findShiftGroupOwner returns the owner of a shift group available to the institution, nil when it
+// is shared. Missing shift groups and those of another institution are not found.
+func (u *UseCase) findShiftGroupOwner(ctx context.Context, institutionId string, shiftGroupId string) (*string, error) {
+	owner, err := u.repository.FindShiftGroupOwner(ctx, institutionId, shiftGroupId)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("shift group not found")
+		}
+
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.FindShiftGroupOwner").
+			Err(err).
+			Msg("failed to find shift group")
+		return nil, errors.InternalServerError("failed to find shift group")
+	}
+
+	return owner, nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// RequestCorrection files a manual correction of clock-in/out times for approval.
+// Only the owner of the attendance record may request a correction.
+func (u *UseCase) RequestCorrection(ctx context.Context, cmd domain.RequestCorrectionCommand) (*domain.AttendanceCorrection, error) {
+	ctx, span := u.tracer.Start(ctx, "RequestCorrection")
+	defer span.End()
+
+	logger := zerolog.Ctx(ctx)
+
+	if cmd.ClockInAt == nil && cmd.ClockOutAt == nil {
+		return nil, errors.BadRequest("clock_in_at or clock_out_at is required")
+	}
+	if cmd.Reason == "" {
+		return nil, errors.BadRequest("reason is required")
+	}
+
+	attendance, err := u.repository.FindByID(ctx, cmd.AttendanceId)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("attendance not found")
+		}
+
+		logger.Error().
+			Str("func", "repository.FindByID").
+			Err(err).
+			Msg("failed to find attendance by id")
+		return nil, errors.InternalServerError("failed to find attendance by id")
+	}
+
+	if attendance.UserId != cmd.RequestedBy {
+		return nil, errors.Forbidden("cannot request correction for another user's attendance")
+	}
+
+	clockIn, clockOut := attendance.ClockInAt, attendance.ClockOutAt
+	if cmd.ClockInAt != nil {
+		clockIn = *cmd.ClockInAt
+	}
+	if cmd.ClockOutAt != nil {
+		clockOut = cmd.ClockOutAt
+	}
+	if clockOut != nil && clockOut.Before(clockIn) {
+		return nil, errors.BadRequest("clock-out cannot be before clock-in")
+	}
+
+	correction := &domain.AttendanceCorrection{
+		InstitutionId: attendance.InstitutionId,
+		AttendanceId:  attendance.Id,
+		ClockInAt:     cmd.ClockInAt,
+		ClockOutAt:    cmd.ClockOutAt,
+		Reason:        cmd.Reason,
+		Status:        domain.CorrectionPending,
+		RequestedBy:   cmd.RequestedBy,
+	}
+
+	if err := u.repository.StoreCorrection(ctx, correction); err != nil {
+		logger.Error().
+			Str("func", "repository.StoreCorrection").
+			Err(err).
+			Msg("failed to store attendance correction")
+		return nil, errors.InternalServerError("failed to store attendance correction")
+	}
+
+	return correction, nil
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// ReviewCorrection approves or rejects a pending correction.
+// Approval applies the corrected times and re-evaluates lateness and early leave.
+func (u *UseCase) ReviewCorrection(ctx context.Context, cmd domain.ReviewCorrectionCommand) (*domain.AttendanceCorrection, error) {
+	ctx, span := u.tracer.Start(ctx, "ReviewCorrection")
+	defer span.End()
+
+	logger := zerolog.Ctx(ctx)
+
+	correction, err := u.repository.FindCorrectionByID(ctx, cmd.CorrectionId)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("attendance correction not found")
+		}
+
+		logger.Error().
+			Str("func", "repository.FindCorrectionByID").
+			Err(err).
+			Msg("failed to find attendance correction")
+		return nil, errors.InternalServerError("failed to find attendance correction")
+	}
+
+	if correction.InstitutionId != cmd.InstitutionId {
+		return nil, errors.NotFound("attendance correction not found")
+	}
+	if correction.Status != domain.CorrectionPending {
+		return nil, errors.Conflict("attendance correction has already been reviewed")
+	}
+	if correction.RequestedBy == cmd.ReviewedBy {
+		return nil, errors.Forbidden("cannot review your own correction")
+	}
+
+	now := time.Now()
+	correction.ReviewedBy = &cmd.ReviewedBy
+	correction.ReviewedAt = &now
+	if cmd.Note != "" {
+		correction.ReviewNote = &cmd.Note
+	}
+
+	if !cmd.Approve {
+		correction.Status = domain.CorrectionRejected
+		if err := u.repository.ReviewCorrection(ctx, correction, nil); err != nil {
+			if errs.Is(err, pgx.ErrNoRows) {
+				return nil, errors.Conflict("attendance correction has already been reviewed")
+			}
+
+			logger.Error().
+				Str("func", "repository.ReviewCorrection").
+				Err(err).
+				Msg("failed to reject attendance correction")
+			return nil, errors.InternalServerError("failed to review attendance correction")
+		}
+		return correction, nil
+	}
+
+	attendance, err := u.repository.FindByID(ctx, correction.AttendanceId)
+	if err != nil {
+		logger.Error().
+			Str("func", "repository.FindByID").
+			Err(err).
+			Msg("failed to find corrected attendance")
+		return nil, errors.InternalServerError("failed to review attendance correction")
+	}
+
+	if correction.ClockInAt != nil {
+		attendance.ClockInAt = *correction.ClockInAt
+	}
+	if correction.ClockOutAt != nil {
+		attendance.ClockOutAt = correction.ClockOutAt
+	}
+	attendance.UpdatedBy = &cmd.ReviewedBy
+
+	window, grace, err := u.loadRules(ctx, attendance.ShiftSessionId, attendance.ShiftGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := attendance.Evaluate(window, grace); err != nil {
+		logger.Error().Err(err).Msg("failed to evaluate attendance")
+		return nil, errors.InternalServerError("failed to evaluate attendance")
+	}
+
+	correction.Status = domain.CorrectionApproved
+	if err := u.repository.ReviewCorrection(ctx, correction, attendance); err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Conflict("attendance correction has already been reviewed")
+		}
+
+		logger.Error().
+			Str("func", "repository.ReviewCorrection").
+			Err(err).
+			Msg("failed to approve attendance correction")
+		return nil, errors.InternalServerError("failed to review attendance correction")
+	}
+
+	return correction, nil
+}
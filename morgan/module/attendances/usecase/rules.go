@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+)
+
+// resolveShiftGroup picks the shift group an attendance is evaluated against from the user's
+// memberships. A requested group must be one of them; without a request the only membership is
+// used, and a user in several groups has to choose. A user without membership has no group.
+func (u *UseCase) resolveShiftGroup(ctx context.Context, userId string, requested string) (*string, error) {
+	groups, err := u.repository.FindShiftGroupIDs(ctx, userId)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.FindShiftGroupIDs").
+			Err(err).
+			Msg("failed to find shift groups")
+		return nil, errors.InternalServerError("failed to find shift groups")
+	}
+
+	if requested != "" {
+		if !slices.Contains(groups, requested) {
+			return nil, errors.Forbidden("user is not a member of this shift group")
+		}
+		return &requested, nil
+	}
+
+	switch len(groups) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &groups[0], nil
+	default:
+		return nil, errors.BadRequest("shift_group_id is required for users in several shift groups")
+	}
+}
+
+// loadRules fetches the shift window and the grace period used to evaluate an attendance.
+// A shift group without a configured grace period tolerates nothing.
+func (u *UseCase) loadRules(ctx context.Context, shiftSessionId string, shiftGroupId *string) (domain.ShiftWindow, domain.GracePeriod, error) {
+	logger := zerolog.Ctx(ctx)
+
+	window, err := u.repository.FindShiftWindow(ctx, shiftSessionId)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return domain.ShiftWindow{}, domain.GracePeriod{}, errors.NotFound("shift session not found")
+		}
+
+		logger.Error().
+			Str("func", "repository.FindShiftWindow").
+			Err(err).
+			Msg("failed to find shift session")
+		return domain.ShiftWindow{}, domain.GracePeriod{}, errors.InternalServerError("failed to find shift session")
+	}
+
+	grace := domain.GracePeriod{}
+	if shiftGroupId != nil && *shiftGroupId != "" {
+		found, err := u.repository.FindGracePeriod(ctx, *shiftGroupId)
+		switch {
+		case err == nil:
+			grace = *found
+		case !errs.Is(err, pgx.ErrNoRows):
+			logger.Error().
+				Str("func", "repository.FindGracePeriod").
+				Err(err).
+				Msg("failed to find grace period")
+			return domain.ShiftWindow{}, domain.GracePeriod{}, errors.InternalServerError("failed to find grace period")
+		}
+	}
+
+	return *window, grace, nil
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ domain.UseCase = (*UseCase)(nil)
+
+// UseCase implements the logic for attendances management.
+type UseCase struct {
+	repository domain.AttendanceRepository
+	tracer     trace.Tracer
+}
+
+// NewUseCase creates a new instance of Attendances UseCase.
+func NewUseCase(repository domain.AttendanceRepository) *UseCase {
+	return &UseCase{
+		repository: repository,
+		tracer:     otel.Tracer("attendances"),
+	}
+}
+
+// dateOf truncates t to midnight in its own location.
+func dateOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
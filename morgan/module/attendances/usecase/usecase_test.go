@@ -0,0 +1,392 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+	"github.com/siakup/morgan-be/morgan/module/attendances/usecase"
+	"github.com/siakup/morgan-be/morgan/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func at(hour, min int) time.Time {
+	return time.Date(2026, 2, 16, hour, min, 0, 0, time.Local)
+}
+
+func TestUseCase_Attendances(t *testing.T) {
+	mockRepo := new(mocks.AttendancesRepositoryMock)
+	uc := usecase.NewUseCase(mockRepo)
+
+	workDate := at(0, 0)
+	window := &domain.ShiftWindow{ShiftSessionId: "ss1", Start: "08:00:00", End: "16:00:00"}
+
+	t.Run("ClockIn_WithinGrace", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindShiftGroupIDs", mock.Anything, "u1").Return([]string{"sg1", "sg2"}, nil).Once()
+		mockRepo.On("FindByUserSessionDate", mock.Anything, "u1", "ss1", workDate).Return(nil, pgx.ErrNoRows).Once()
+		mockRepo.On("FindShiftWindow", mock.Anything, "ss1").Return(window, nil).Once()
+		mockRepo.On("FindGracePeriod", mock.Anything, "sg1").Return(&domain.GracePeriod{ShiftGroupId: "sg1", LateGraceMinutes: 10}, nil).Once()
+		mockRepo.On("Store", mock.Anything, mock.MatchedBy(func(a *domain.Attendance) bool {
+			return a.Status == domain.StatusPresent && a.LateMinutes == 0 && *a.ShiftGroupId == "sg1"
+		})).Return(nil).Once()
+
+		res, err := uc.ClockIn(ctx, domain.ClockInCommand{
+			InstitutionId:  "i1",
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			ShiftGroupId:   "sg1",
+			At:             at(8, 9),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, workDate, res.WorkDate)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ClockIn_Late", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindShiftGroupIDs", mock.Anything, "u1").Return([]string{"sg1"}, nil).Once()
+		mockRepo.On("FindByUserSessionDate", mock.Anything, "u1", "ss1", workDate).Return(nil, pgx.ErrNoRows).Once()
+		mockRepo.On("FindShiftWindow", mock.Anything, "ss1").Return(window, nil).Once()
+		mockRepo.On("FindGracePeriod", mock.Anything, "sg1").Return(&domain.GracePeriod{ShiftGroupId: "sg1", LateGraceMinutes: 10}, nil).Once()
+		mockRepo.On("Store", mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := uc.ClockIn(ctx, domain.ClockInCommand{
+			InstitutionId:  "i1",
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			At:             at(8, 25),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusLate, res.Status)
+		assert.Equal(t, 25, res.LateMinutes)
+		assert.Equal(t, "sg1", *res.ShiftGroupId)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ClockIn_NotMember", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindShiftGroupIDs", mock.Anything, "u1").Return([]string{"sg2"}, nil).Once()
+
+		_, err := uc.ClockIn(ctx, domain.ClockInCommand{
+			InstitutionId:  "i1",
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			ShiftGroupId:   "sg1",
+			At:             at(8, 0),
+		})
+		appErr, ok := err.(*errors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.ErrorTypeForbidden, appErr.Type)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ClockIn_SeveralGroups", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindShiftGroupIDs", mock.Anything, "u1").Return([]string{"sg1", "sg2"}, nil).Once()
+
+		_, err := uc.ClockIn(ctx, domain.ClockInCommand{
+			InstitutionId:  "i1",
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			At:             at(8, 0),
+		})
+		appErr, ok := err.(*errors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.ErrorTypeValidation, appErr.Type)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ClockIn_AlreadyClockedIn", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindShiftGroupIDs", mock.Anything, "u1").Return([]string(nil), nil).Once()
+		mockRepo.On("FindByUserSessionDate", mock.Anything, "u1", "ss1", workDate).Return(&domain.Attendance{Id: "a1"}, nil).Once()
+
+		_, err := uc.ClockIn(ctx, domain.ClockInCommand{
+			InstitutionId:  "i1",
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			At:             at(8, 0),
+		})
+		assert.Error(t, err)
+		appErr, ok := err.(*errors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.ErrorTypeConflict, appErr.Type)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ClockOut_EarlyLeave", func(t *testing.T) {
+		ctx := context.Background()
+		attendance := &domain.Attendance{
+			Id:             "a1",
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			WorkDate:       workDate,
+			ClockInAt:      at(8, 0),
+		}
+
+		mockRepo.On("FindOpenByUserSession", mock.Anything, "u1", "ss1").Return(attendance, nil).Once()
+		mockRepo.On("FindShiftWindow", mock.Anything, "ss1").Return(window, nil).Once()
+		mockRepo.On("Update", mock.Anything, attendance).Return(nil).Once()
+
+		res, err := uc.ClockOut(ctx, domain.ClockOutCommand{
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			At:             at(15, 30),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusEarlyLeave, res.Status)
+		assert.Equal(t, 30, res.EarlyLeaveMinutes)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ClockOut_WorkDate", func(t *testing.T) {
+		ctx := context.Background()
+		attendance := &domain.Attendance{
+			Id:             "a1",
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			WorkDate:       workDate,
+			ClockInAt:      at(8, 0),
+		}
+
+		mockRepo.On("FindByUserSessionDate", mock.Anything, "u1", "ss1", workDate).Return(attendance, nil).Once()
+		mockRepo.On("FindShiftWindow", mock.Anything, "ss1").Return(window, nil).Once()
+		mockRepo.On("Update", mock.Anything, attendance).Return(nil).Once()
+
+		res, err := uc.ClockOut(ctx, domain.ClockOutCommand{
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			WorkDate:       at(0, 0),
+			At:             at(16, 0),
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, res.ClockOutAt)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ClockOut_Overnight", func(t *testing.T) {
+		ctx := context.Background()
+		attendance := &domain.Attendance{
+			Id:             "a2",
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			WorkDate:       workDate,
+			ClockInAt:      at(22, 0),
+		}
+
+		mockRepo.On("FindOpenByUserSession", mock.Anything, "u1", "ss1").Return(attendance, nil).Once()
+		mockRepo.On("FindShiftWindow", mock.Anything, "ss1").Return(window, nil).Once()
+		mockRepo.On("Update", mock.Anything, attendance).Return(nil).Once()
+
+		nextMorning := at(6, 0).AddDate(0, 0, 1)
+		res, err := uc.ClockOut(ctx, domain.ClockOutCommand{
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			At:             nextMorning,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, nextMorning, *res.ClockOutAt)
+		assert.Equal(t, workDate, res.WorkDate)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ClockOut_NotClockedIn", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindOpenByUserSession", mock.Anything, "u1", "ss1").Return(nil, pgx.ErrNoRows).Once()
+
+		_, err := uc.ClockOut(ctx, domain.ClockOutCommand{
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			At:             at(16, 0),
+		})
+		assert.Error(t, err)
+		appErr, ok := err.(*errors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.ErrorTypeNotFound, appErr.Type)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("SetGracePeriod_Negative", func(t *testing.T) {
+		ctx := context.Background()
+
+		err := uc.SetGracePeriod(ctx, "inst-1", &domain.GracePeriod{ShiftGroupId: "sg1", LateGraceMinutes: -1})
+		assert.Error(t, err)
+	})
+
+	t.Run("GetGracePeriod_Unconfigured", func(t *testing.T) {
+		ctx := context.Background()
+		owner := "inst-1"
+
+		mockRepo.On("FindShiftGroupOwner", mock.Anything, "inst-1", "sg1").Return(&owner, nil).Once()
+		mockRepo.On("FindGracePeriod", mock.Anything, "sg1").Return(nil, pgx.ErrNoRows).Once()
+
+		gracePeriod, err := uc.GetGracePeriod(ctx, "inst-1", "sg1")
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.GracePeriod{ShiftGroupId: "sg1"}, gracePeriod)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetGracePeriod_OtherInstitution", func(t *testing.T) {
+		ctx := context.Background()
+
+		// The shift group of another institution is not available to inst-1
+		mockRepo.On("FindShiftGroupOwner", mock.Anything, "inst-1", "sg-other").Return(nil, pgx.ErrNoRows).Once()
+
+		_, err := uc.GetGracePeriod(ctx, "inst-1", "sg-other")
+		appErr, ok := err.(*errors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.ErrorTypeNotFound, appErr.Type)
+
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "FindGracePeriod", mock.Anything, "sg-other")
+	})
+
+	t.Run("SetGracePeriod", func(t *testing.T) {
+		ctx := context.Background()
+		owner := "inst-1"
+		gracePeriod := &domain.GracePeriod{ShiftGroupId: "sg1", LateGraceMinutes: 10}
+
+		mockRepo.On("FindShiftGroupOwner", mock.Anything, "inst-1", "sg1").Return(&owner, nil).Once()
+		mockRepo.On("UpsertGracePeriod", mock.Anything, gracePeriod).Return(nil).Once()
+
+		err := uc.SetGracePeriod(ctx, "inst-1", gracePeriod)
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("SetGracePeriod_UnknownGroup", func(t *testing.T) {
+		ctx := context.Background()
+		gracePeriod := &domain.GracePeriod{ShiftGroupId: "missing", LateGraceMinutes: 10}
+
+		mockRepo.On("FindShiftGroupOwner", mock.Anything, "inst-1", "missing").Return(nil, pgx.ErrNoRows).Once()
+
+		err := uc.SetGracePeriod(ctx, "inst-1", gracePeriod)
+		appErr, ok := err.(*errors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.ErrorTypeNotFound, appErr.Type)
+
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "UpsertGracePeriod", mock.Anything, gracePeriod)
+	})
+
+	t.Run("SetGracePeriod_Shared", func(t *testing.T) {
+		ctx := context.Background()
+		gracePeriod := &domain.GracePeriod{ShiftGroupId: "sg-shared", LateGraceMinutes: 10}
+
+		mockRepo.On("FindShiftGroupOwner", mock.Anything, "inst-1", "sg-shared").Return(nil, nil).Once()
+
+		err := uc.SetGracePeriod(ctx, "inst-1", gracePeriod)
+		appErr, ok := err.(*errors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.ErrorTypeForbidden, appErr.Type)
+
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "UpsertGracePeriod", mock.Anything, gracePeriod)
+	})
+
+	t.Run("RequestCorrection_NotOwner", func(t *testing.T) {
+		ctx := context.Background()
+		clockIn := at(8, 0)
+
+		mockRepo.On("FindByID", mock.Anything, "a1").Return(&domain.Attendance{Id: "a1", UserId: "u1"}, nil).Once()
+
+		_, err := uc.RequestCorrection(ctx, domain.RequestCorrectionCommand{
+			AttendanceId: "a1",
+			ClockInAt:    &clockIn,
+			Reason:       "forgot",
+			RequestedBy:  "u2",
+		})
+		assert.Error(t, err)
+		appErr, ok := err.(*errors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.ErrorTypeForbidden, appErr.Type)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ReviewCorrection_Approve", func(t *testing.T) {
+		ctx := context.Background()
+		clockIn := at(8, 0)
+		correction := &domain.AttendanceCorrection{
+			Id:            "c1",
+			InstitutionId: "i1",
+			AttendanceId:  "a1",
+			ClockInAt:     &clockIn,
+			Status:        domain.CorrectionPending,
+			RequestedBy:   "u1",
+		}
+		attendance := &domain.Attendance{
+			Id:             "a1",
+			UserId:         "u1",
+			ShiftSessionId: "ss1",
+			WorkDate:       workDate,
+			ClockInAt:      at(9, 0),
+			LateMinutes:    60,
+			Status:         domain.StatusLate,
+		}
+
+		mockRepo.On("FindCorrectionByID", mock.Anything, "c1").Return(correction, nil).Once()
+		mockRepo.On("FindByID", mock.Anything, "a1").Return(attendance, nil).Once()
+		mockRepo.On("FindShiftWindow", mock.Anything, "ss1").Return(window, nil).Once()
+		mockRepo.On("ReviewCorrection", mock.Anything, correction, mock.MatchedBy(func(a *domain.Attendance) bool {
+			return a.Status == domain.StatusPresent && a.LateMinutes == 0
+		})).Return(nil).Once()
+
+		res, err := uc.ReviewCorrection(ctx, domain.ReviewCorrectionCommand{
+			CorrectionId:  "c1",
+			InstitutionId: "i1",
+			Approve:       true,
+			ReviewedBy:    "mgr",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.CorrectionApproved, res.Status)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ReviewCorrection_OwnRequest", func(t *testing.T) {
+		ctx := context.Background()
+		correction := &domain.AttendanceCorrection{
+			Id:            "c2",
+			InstitutionId: "i1",
+			Status:        domain.CorrectionPending,
+			RequestedBy:   "u1",
+		}
+
+		mockRepo.On("FindCorrectionByID", mock.Anything, "c2").Return(correction, nil).Once()
+
+		_, err := uc.ReviewCorrection(ctx, domain.ReviewCorrectionCommand{
+			CorrectionId:  "c2",
+			InstitutionId: "i1",
+			ReviewedBy:    "u1",
+		})
+		assert.Error(t, err)
+		appErr, ok := err.(*errors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, errors.ErrorTypeForbidden, appErr.Type)
+
+		mockRepo.AssertExpectations(t)
+	})
+}
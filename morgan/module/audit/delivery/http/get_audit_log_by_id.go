@@ -0,0 +1,23 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+)
+
+// GetAuditLogByID handles GET /audit-logs/:id
+func (h *AuditHandler) GetAuditLogByID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	log, err := h.useCase.Get(ctx, institutionId, c.Params("id"))
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success(toLogResponse(log), "Audit log retrieved"))
+}
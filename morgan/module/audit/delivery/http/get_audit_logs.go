@@ -0,0 +1,64 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/types"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+)
+
+// GetAuditLogs handles GET /audit-logs
+func (h *AuditHandler) GetAuditLogs(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	page, pageSize := parsePagination(c)
+
+	from, err := parseTime(c.Query("from"))
+	if err != nil {
+		return h.handleError(c, errors.BadRequest("from must be an RFC3339 timestamp"))
+	}
+	to, err := parseTime(c.Query("to"))
+	if err != nil {
+		return h.handleError(c, errors.BadRequest("to must be an RFC3339 timestamp"))
+	}
+
+	filter := domain.LogFilter{
+		Pagination: types.Pagination{
+			Page: page,
+			Size: pageSize,
+		},
+		InstitutionId: institutionId,
+		ActorId:       c.Query("actor_id"),
+		Entity:        c.Query("entity"),
+		EntityId:      c.Query("entity_id"),
+		Action:        c.Query("action"),
+		TraceId:       c.Query("trace_id"),
+		From:          from,
+		To:            to,
+	}
+
+	logs, total, err := h.useCase.FindAll(ctx, filter)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	result := make([]LogResponse, len(logs))
+	for i, l := range logs {
+		result[i] = toLogResponse(l)
+	}
+
+	meta := &responses.Meta{
+		Page:       page,
+		Size:       pageSize,
+		Total:      total,
+		TotalPages: (int(total) + pageSize - 1) / pageSize,
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.SuccessWithMeta(result, "Audit logs retrieved", meta))
+}
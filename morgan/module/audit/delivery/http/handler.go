@@ -0,0 +1,100 @@
+package http
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+)
+
+// Permission codes guarding the audit routes.
+const (
+	PermissionView = "audit.iam.audit_logs.view"
+)
+
+// Permissions lists every permission code required by the audit routes.
+var Permissions = []string{PermissionView}
+
+// AuditHandler handles HTTP requests for audit module.
+type AuditHandler struct {
+	useCase domain.UseCase
+	auth    *middleware.AuthorizationMiddleware
+}
+
+// NewAuditHandler creates a new AuditHandler.
+func NewAuditHandler(useCase domain.UseCase, auth *middleware.AuthorizationMiddleware) *AuditHandler {
+	return &AuditHandler{
+		useCase: useCase,
+		auth:    auth,
+	}
+}
+
+// RegisterRoutes registers the routes for the audit module.
+func (h *AuditHandler) RegisterRoutes(router fiber.Router) {
+	router.Use("/audit-logs", middleware.TraceMiddleware)
+	openapi.Mount(router, h.auth.Authenticate, h.Endpoints())
+}
+
+// handleError renders err with the standard error envelope.
+func (h *AuditHandler) handleError(c *fiber.Ctx, err error) error {
+	return responses.HandleError(c, err)
+}
+
+// maxPageSize caps the page_size query parameter.
+const maxPageSize = 100
+
+// parsePagination reads page and page_size from the query, clamping page to at least 1 and page_size to 1..maxPageSize.
+func parsePagination(c *fiber.Ctx) (int, int) {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size", "10"))
+
+	return max(page, 1), min(max(pageSize, 1), maxPageSize)
+}
+
+// parseTime parses an optional RFC3339 value.
+func parseTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// LogResponse is the JSON representation of an audit log.
+type LogResponse struct {
+	Id        string                  `json:"id"`
+	ActorId   *string                 `json:"actor_id"`
+	Entity    string                  `json:"entity"`
+	EntityId  string                  `json:"entity_id"`
+	Action    string                  `json:"action"`
+	Before    map[string]any          `json:"before"`
+	After     map[string]any          `json:"after"`
+	Diff      map[string]audit.Change `json:"diff"`
+	TraceId   *string                 `json:"trace_id"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+func toLogResponse(l *domain.Log) LogResponse {
+	return LogResponse{
+		Id:        l.Id,
+		ActorId:   l.ActorId,
+		Entity:    l.Entity,
+		EntityId:  l.EntityId,
+		Action:    l.Action,
+		Before:    l.Before,
+		After:     l.After,
+		Diff:      l.Diff,
+		TraceId:   l.TraceId,
+		CreatedAt: l.CreatedAt,
+	}
+}
@@ -0,0 +1,92 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	deliverhttp "github.com/siakup/morgan-be/morgan/module/audit/delivery/http"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+	"github.com/siakup/morgan-be/morgan/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupAuditApp(useCase domain.UseCase) *fiber.App {
+	handler := deliverhttp.NewAuditHandler(useCase, nil)
+
+	app := fiber.New()
+
+	// Mock middleware
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals(middleware.XUserIdKey, "user-1")
+		c.Locals(middleware.XInstitutionId, "inst-1")
+		return c.Next()
+	})
+
+	app.Get("/audit-logs", handler.GetAuditLogs)
+	app.Get("/audit-logs/:id", handler.GetAuditLogByID)
+
+	return app
+}
+
+func TestAuditHandler_GetAuditLogs(t *testing.T) {
+	mockUseCase := new(mocks.AuditUseCaseMock)
+	app := setupAuditApp(mockUseCase)
+
+	t.Run("PageSizeClamped", func(t *testing.T) {
+		for query, size := range map[string]int{"page_size=0": 1, "page_size=-5": 1, "page_size=1000": 100} {
+			mockUseCase.On("FindAll", mock.Anything, mock.MatchedBy(func(f domain.LogFilter) bool {
+				return f.Page == 1 && f.Size == size
+			})).Return([]*domain.Log{}, int64(3), nil).Once()
+
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/audit-logs?page=0&"+query, nil))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		mockUseCase.On("FindAll", mock.Anything, mock.MatchedBy(func(f domain.LogFilter) bool {
+			return f.InstitutionId == "inst-1" && f.Entity == "role" && f.From != nil && f.To == nil
+		})).Return([]*domain.Log{{Id: "l1", Entity: "role"}}, int64(1), nil).Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/audit-logs?entity=role&from=2026-02-01T00:00:00Z", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidFrom", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/audit-logs?from=yesterday", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestAuditHandler_GetAuditLogByID(t *testing.T) {
+	mockUseCase := new(mocks.AuditUseCaseMock)
+	app := setupAuditApp(mockUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		mockUseCase.On("Get", mock.Anything, "inst-1", "l1").Return(&domain.Log{Id: "l1"}, nil).Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/audit-logs/l1", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUseCase.On("Get", mock.Anything, "inst-1", "l2").Return(nil, errors.NotFound("audit log not found")).Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/audit-logs/l2", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
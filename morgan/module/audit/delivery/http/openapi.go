@@ -0,0 +1,26 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/openapi"
+)
+
+type listQuery struct {
+	Page     int    `query:"page" default:"1"`
+	PageSize int    `query:"page_size" default:"10"`
+	From     string `query:"from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To       string `query:"to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	ActorId  string `query:"actor_id"`
+	Entity   string `query:"entity"`
+	EntityId string `query:"entity_id"`
+	Action   string `query:"action"`
+	TraceId  string `query:"trace_id"`
+}
+
+// Endpoints lists the audit log routes: RegisterRoutes mounts them and the OpenAPI document describes them.
+func (h *AuditHandler) Endpoints() []openapi.Endpoint {
+	return []openapi.Endpoint{
+		{Method: fiber.MethodGet, Path: "/audit-logs", Summary: "List audit logs", Permissions: []string{PermissionView}, Query: listQuery{}, Response: []LogResponse{}, Paginated: true, Handlers: []fiber.Handler{h.GetAuditLogs}},
+		{Method: fiber.MethodGet, Path: "/audit-logs/:id", Summary: "Get an audit log", Permissions: []string{PermissionView}, Response: LogResponse{}, Handlers: []fiber.Handler{h.GetAuditLogByID}},
+	}
+}
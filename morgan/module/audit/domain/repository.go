@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/types"
+)
+
+// Log is a recorded mutation in the audit trail.
+type Log struct {
+	Id            string                  `object:"id"`
+	InstitutionId *string                 `object:"institution_id"` // Nullable for system actions
+	ActorId       *string                 `object:"actor_id"`       // Nullable for system actions
+	Entity        string                  `object:"entity"`
+	EntityId      string                  `object:"entity_id"`
+	Action        string                  `object:"action"`
+	Before        map[string]any          `object:"before"`
+	After         map[string]any          `object:"after"`
+	Diff          map[string]audit.Change `object:"diff"`
+	TraceId       *string                 `object:"trace_id"`
+	CreatedAt     time.Time               `object:"created_at"`
+}
+
+// LogFilter represents filter options for listing audit logs.
+type LogFilter struct {
+	types.Pagination
+	InstitutionId string
+	ActorId       string
+	Entity        string
+	EntityId      string
+	Action        string
+	TraceId       string
+	From          *time.Time
+	To            *time.Time
+}
+
+// LogRepository defines the persistence layer contract.
+type LogRepository interface {
+	FindAll(ctx context.Context, filter LogFilter) ([]*Log, int64, error)
+	FindByID(ctx context.Context, id string) (*Log, error)
+	Store(ctx context.Context, log *Log) error
+}
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/siakup/morgan-be/libraries/audit"
+)
+
+// UseCase defines the business logic contract for the audit module.
+// It records entries for the other modules through audit.Recorder.
+type UseCase interface {
+	audit.Recorder
+
+	FindAll(ctx context.Context, filter LogFilter) ([]*Log, int64, error)
+	Get(ctx context.Context, institutionId string, id string) (*Log, error)
+}
@@ -0,0 +1,46 @@
+package audit
+
+import (
+	"github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/registry"
+	"github.com/siakup/morgan-be/morgan/module/audit/delivery/http"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+	"github.com/siakup/morgan-be/morgan/module/audit/repository/postgresql"
+	"github.com/siakup/morgan-be/morgan/module/audit/usecase"
+	"go.uber.org/fx"
+)
+
+// Module exports the audit module for Fx. It also provides the audit.Recorder used by the other modules.
+var Module = fx.Options(
+	fx.Provide(
+		postgresql.NewRepository,
+		fx.Annotate(
+			postgresql.NewRepository,
+			fx.As(new(domain.LogRepository)),
+		),
+		usecase.NewUseCase,
+		fx.Annotate(
+			usecase.NewUseCase,
+			fx.As(new(domain.UseCase)),
+		),
+		fx.Annotate(
+			usecase.NewUseCase,
+			fx.As(new(audit.Recorder)),
+		),
+		http.NewAuditHandler,
+	),
+	registry.Provide(newModule),
+)
+
+func newModule(h *http.AuditHandler) registry.Module {
+	return registry.Module{
+		Name:        "audit",
+		Version:     fiber.V1,
+		BasePath:    "/audit-logs",
+		Permissions: http.Permissions,
+		Core:        true,
+		Endpoints:   h.Endpoints(),
+		Register:    h.RegisterRoutes,
+	}
+}
@@ -0,0 +1,86 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/object"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+)
+
+// FindAll retrieves a list of audit logs based on the provided filter, newest first.
+func (r *Repository) FindAll(ctx context.Context, filter domain.LogFilter) ([]*domain.Log, int64, error) {
+	baseQuery := `
+		FROM audit.logs
+		WHERE institution_id = @institution_id
+	`
+	args := pgx.NamedArgs{
+		"institution_id": filter.InstitutionId,
+	}
+
+	if filter.ActorId != "" {
+		baseQuery += " AND actor_id = @actor_id"
+		args["actor_id"] = filter.ActorId
+	}
+
+	if filter.Entity != "" {
+		baseQuery += " AND entity = @entity"
+		args["entity"] = filter.Entity
+	}
+
+	if filter.EntityId != "" {
+		baseQuery += " AND entity_id = @entity_id"
+		args["entity_id"] = filter.EntityId
+	}
+
+	if filter.Action != "" {
+		baseQuery += " AND action = @action"
+		args["action"] = filter.Action
+	}
+
+	if filter.TraceId != "" {
+		baseQuery += " AND trace_id = @trace_id"
+		args["trace_id"] = filter.TraceId
+	}
+
+	if filter.From != nil {
+		baseQuery += " AND created_at >= @from"
+		args["from"] = *filter.From
+	}
+
+	if filter.To != nil {
+		baseQuery += " AND created_at < @to"
+		args["to"] = *filter.To
+	}
+
+	// 1. Count Total
+	var total int64
+	countQuery := "SELECT count(id)" + baseQuery
+	if err := r.db.QueryRow(ctx, countQuery, args).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// 2. Select Data
+	selectQuery := "SELECT" + logColumns + baseQuery + " ORDER BY created_at DESC LIMIT @limit OFFSET @offset"
+
+	args["limit"] = filter.Pagination.GetLimit()
+	args["offset"] = filter.Pagination.GetOffset()
+
+	rows, err := r.db.Query(ctx, selectQuery, args)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	records, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[LogEntity])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	logs, err := object.ParseAll[*LogEntity, *domain.Log](object.TagDB, object.TagObject, records)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
@@ -0,0 +1,47 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/object"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+)
+
+const logColumns = `
+		id,
+		institution_id,
+		actor_id,
+		entity,
+		entity_id,
+		action,
+		before,
+		after,
+		diff,
+		trace_id,
+		created_at
+`
+
+var queryFindById = `
+	SELECT` + logColumns + `
+	FROM audit.logs
+	WHERE id = @id
+	LIMIT 1
+`
+
+// FindByID retrieves a single audit log by its ID.
+func (r *Repository) FindByID(ctx context.Context, id string) (*domain.Log, error) {
+	rows, err := r.db.Query(ctx, queryFindById, pgx.NamedArgs{
+		"id": id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[LogEntity])
+	if err != nil {
+		return nil, err
+	}
+
+	return object.Parse[*LogEntity, *domain.Log](object.TagDB, object.TagObject, record)
+}
@@ -0,0 +1,36 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+)
+
+var _ domain.LogRepository = (*Repository)(nil)
+
+// LogEntity maps to audit.logs table.
+type LogEntity struct {
+	Id            string                  `db:"id"`
+	InstitutionId *string                 `db:"institution_id"`
+	ActorId       *string                 `db:"actor_id"`
+	Entity        string                  `db:"entity"`
+	EntityId      string                  `db:"entity_id"`
+	Action        string                  `db:"action"`
+	Before        map[string]any          `db:"before"`
+	After         map[string]any          `db:"after"`
+	Diff          map[string]audit.Change `db:"diff"`
+	TraceId       *string                 `db:"trace_id"`
+	CreatedAt     time.Time               `db:"created_at"`
+}
+
+// Repository implements domain.LogRepository.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+// NewRepository creates a new Audit Log Repository.
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
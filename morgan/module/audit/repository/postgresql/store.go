@@ -0,0 +1,33 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/framework/postgres"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+)
+
+var queryStore = `
+	INSERT INTO audit.logs (
+		institution_id, actor_id, entity, entity_id, action, before, after, diff, trace_id
+	) VALUES (
+		@institution_id, @actor_id, @entity, @entity_id, @action, @before, @after, @diff, @trace_id
+	)
+	RETURNING id, created_at
+`
+
+// Store appends an entry to the audit trail, joining the transaction carried by ctx.
+func (r *Repository) Store(ctx context.Context, log *domain.Log) error {
+	return postgres.Conn(ctx, r.db).QueryRow(ctx, queryStore, pgx.NamedArgs{
+		"institution_id": log.InstitutionId,
+		"actor_id":       log.ActorId,
+		"entity":         log.Entity,
+		"entity_id":      log.EntityId,
+		"action":         log.Action,
+		"before":         log.Before,
+		"after":          log.After,
+		"diff":           log.Diff,
+		"trace_id":       log.TraceId,
+	}).Scan(&log.Id, &log.CreatedAt)
+}
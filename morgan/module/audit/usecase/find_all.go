@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+)
+
+// FindAll retrieves audit logs based on the filter.
+func (u *UseCase) FindAll(ctx context.Context, filter domain.LogFilter) ([]*domain.Log, int64, error) {
+	ctx, span := u.tracer.Start(ctx, "FindAll")
+	defer span.End()
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, 0, errors.BadRequest("from must be before to")
+	}
+
+	logs, total, err := u.repository.FindAll(ctx, filter)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.FindAll").
+			Err(err).
+			Msg("failed to find audit logs")
+		return nil, 0, errors.InternalServerError("failed to find audit logs")
+	}
+
+	return logs, total, nil
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+)
+
+// Get finds an audit log of the institution by its unique identifier.
+func (u *UseCase) Get(ctx context.Context, institutionId string, id string) (*domain.Log, error) {
+	ctx, span := u.tracer.Start(ctx, "Get")
+	defer span.End()
+
+	log, err := u.repository.FindByID(ctx, id)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("audit log not found")
+		}
+
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.FindByID").
+			Err(err).
+			Msg("failed to find audit log by id")
+		return nil, errors.InternalServerError("failed to find audit log by id")
+	}
+
+	if log.InstitutionId == nil || *log.InstitutionId != institutionId {
+		return nil, errors.NotFound("audit log not found")
+	}
+
+	return log, nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/helper"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+)
+
+// Record appends a mutation to the audit trail. Actor and institution come from the
+// authenticated request context, the trace ID from helper.GetTraceID.
+// The entry is written in the transaction carried by ctx, if any.
+func (u *UseCase) Record(ctx context.Context, entry audit.Entry) error {
+	ctx, span := u.tracer.Start(ctx, "Record")
+	defer span.End()
+
+	actor := helper.GetActor(ctx)
+	traceId := helper.GetTraceID(ctx)
+	before := audit.Snapshot(entry.Before)
+	after := audit.Snapshot(entry.After)
+
+	log := &domain.Log{
+		InstitutionId: optional(actor.InstitutionId),
+		ActorId:       optional(actor.UserId),
+		Entity:        entry.Entity,
+		EntityId:      entry.EntityId,
+		Action:        entry.Action,
+		Before:        before,
+		After:         after,
+		Diff:          audit.Diff(before, after),
+		TraceId:       optional(traceId),
+	}
+
+	if err := u.repository.Store(ctx, log); err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.Store").
+			Str("entity", entry.Entity).
+			Str("entity_id", entry.EntityId).
+			Str("action", entry.Action).
+			Err(err).
+			Msg("failed to record audit log")
+		return fmt.Errorf("record %s %s: %w", entry.Action, entry.Entity, err)
+	}
+
+	return nil
+}
+
+// optional maps an empty string to nil.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
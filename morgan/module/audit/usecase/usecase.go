@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ domain.UseCase = (*UseCase)(nil)
+
+// UseCase implements the logic for the audit trail.
+type UseCase struct {
+	repository domain.LogRepository
+	tracer     trace.Tracer
+}
+
+// NewUseCase creates a new instance of Audit UseCase.
+func NewUseCase(repository domain.LogRepository) *UseCase {
+	return &UseCase{
+		repository: repository,
+		tracer:     otel.Tracer("audit"),
+	}
+}
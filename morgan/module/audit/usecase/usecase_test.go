@@ -0,0 +1,98 @@
+package usecase_test
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/helper"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+	"github.com/siakup/morgan-be/morgan/module/audit/usecase"
+	"github.com/siakup/morgan-be/morgan/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type role struct {
+	Id   string `object:"id"`
+	Name string `object:"name"`
+}
+
+func TestUseCase_Audit(t *testing.T) {
+	mockRepo := new(mocks.AuditLogRepositoryMock)
+	uc := usecase.NewUseCase(mockRepo)
+
+	t.Run("Record_StoresDiffWithActor", func(t *testing.T) {
+		ctx := helper.WithActor(helper.WithTraceID(context.Background(), "trace-1"), helper.Actor{UserId: "u1", InstitutionId: "i1"})
+
+		mockRepo.On("Store", mock.Anything, mock.MatchedBy(func(l *domain.Log) bool {
+			return l.Entity == "role" && l.EntityId == "r1" && l.Action == audit.ActionUpdate &&
+				*l.ActorId == "u1" && *l.InstitutionId == "i1" && *l.TraceId == "trace-1" &&
+				len(l.Diff) == 1 && l.Diff["name"] == audit.Change{From: "Old", To: "New"}
+		})).Return(nil).Once()
+
+		err := uc.Record(ctx, audit.Entry{
+			Entity:   "role",
+			EntityId: "r1",
+			Action:   audit.ActionUpdate,
+			Before:   &role{Id: "r1", Name: "Old"},
+			After:    &role{Id: "r1", Name: "New"},
+		})
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Record_SystemAction", func(t *testing.T) {
+		mockRepo.On("Store", mock.Anything, mock.MatchedBy(func(l *domain.Log) bool {
+			return l.ActorId == nil && l.InstitutionId == nil && l.Before == nil && l.After["name"] == "New"
+		})).Return(nil).Once()
+
+		err := uc.Record(context.Background(), audit.Entry{Entity: "role", EntityId: "r1", Action: audit.ActionCreate, After: &role{Id: "r1", Name: "New"}})
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Record_StoreErrorIsReturned", func(t *testing.T) {
+		dbErr := stderrors.New("db down")
+		mockRepo.On("Store", mock.Anything, mock.Anything).Return(dbErr).Once()
+
+		err := uc.Record(context.Background(), audit.Entry{Entity: "role", EntityId: "r1", Action: audit.ActionDelete})
+		assert.ErrorIs(t, err, dbErr)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("FindAll_InvalidRange", func(t *testing.T) {
+		from := time.Now()
+		to := from.Add(-time.Hour)
+
+		_, _, err := uc.FindAll(context.Background(), domain.LogFilter{From: &from, To: &to})
+		assert.Error(t, err)
+		assert.Equal(t, errors.ErrorTypeValidation, err.(*errors.AppError).Type)
+	})
+
+	t.Run("Get_OtherInstitution", func(t *testing.T) {
+		other := "i2"
+		mockRepo.On("FindByID", mock.Anything, "l1").Return(&domain.Log{Id: "l1", InstitutionId: &other}, nil).Once()
+
+		_, err := uc.Get(context.Background(), "i1", "l1")
+		assert.Error(t, err)
+		assert.Equal(t, errors.ErrorTypeNotFound, err.(*errors.AppError).Type)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Get_NotFound", func(t *testing.T) {
+		mockRepo.On("FindByID", mock.Anything, "l2").Return(nil, pgx.ErrNoRows).Once()
+
+		_, err := uc.Get(context.Background(), "i1", "l2")
+		assert.Error(t, err)
+		assert.Equal(t, errors.ErrorTypeNotFound, err.(*errors.AppError).Type)
+		mockRepo.AssertExpectations(t)
+	})
+}
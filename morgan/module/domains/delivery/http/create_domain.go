@@ -5,9 +5,9 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/siakup/morgan-be/libraries/errors"
-	"github.com/siakup/morgan-be/libraries/validation"
 	"github.com/siakup/morgan-be/libraries/middleware"
 	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/validation"
 	"github.com/siakup/morgan-be/morgan/module/domains/domain"
 )
 
@@ -28,6 +28,8 @@ func (h *DomainHandler) CreateDomain(c *fiber.Ctx) error {
 		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
 	}
 
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
 	raw := c.Locals(validation.ValidatedBodyKey)
 	req, ok := raw.(*CreateDomainRequest)
 	if !ok || req == nil {
@@ -35,9 +37,10 @@ func (h *DomainHandler) CreateDomain(c *fiber.Ctx) error {
 	}
 
 	newDomain := domain.Domain{
-		Name:      req.Name,
-		CreatedBy: &userId,
-		UpdatedBy: &userId,
+		InstitutionId: &institutionId,
+		Name:          req.Name,
+		CreatedBy:     &userId,
+		UpdatedBy:     &userId,
 	}
 
 	if err := h.useCase.Create(ctx, &newDomain); err != nil {
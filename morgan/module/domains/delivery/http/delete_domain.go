@@ -23,7 +23,9 @@ func (h *DomainHandler) DeleteDomain(c *fiber.Ctx) error {
 		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
 	}
 
-	if err := h.useCase.Delete(ctx, id, deletedBy); err != nil {
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	if err := h.useCase.Delete(ctx, institutionId, id, deletedBy); err != nil {
 		return h.handleError(c, err)
 	}
 
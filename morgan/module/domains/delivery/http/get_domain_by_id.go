@@ -5,14 +5,16 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
 	"github.com/siakup/morgan-be/libraries/responses"
 )
 
 type (
 	GetDomainByIDResponse struct {
-		Id     string `json:"id"`
-		Name   string `json:"name"`
-		Status bool   `json:"status"`
+		Id            string  `json:"id"`
+		InstitutionId *string `json:"institution_id"`
+		Name          string  `json:"name"`
+		Status        bool    `json:"status"`
 	}
 )
 
@@ -25,14 +27,17 @@ func (h *DomainHandler) GetDomainByID(c *fiber.Ctx) error {
 		return h.handleError(c, errors.BadRequest("Invalid ID"))
 	}
 
-	domain, err := h.useCase.Get(ctx, id)
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	domain, err := h.useCase.Get(ctx, institutionId, id)
 	if err != nil {
 		return h.handleError(c, err)
 	}
 
 	return c.Status(http.StatusOK).JSON(responses.Success(GetDomainByIDResponse{
-		Id:     domain.Id,
-		Name:   domain.Name,
-		Status: domain.Status,
+		Id:            domain.Id,
+		InstitutionId: domain.InstitutionId,
+		Name:          domain.Name,
+		Status:        domain.Status,
 	}, "Domain retrieved"))
 }
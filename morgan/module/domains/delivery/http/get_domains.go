@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/middleware"
 	"github.com/siakup/morgan-be/libraries/responses"
 	"github.com/siakup/morgan-be/libraries/types"
 	"github.com/siakup/morgan-be/morgan/module/domains/domain"
@@ -12,9 +13,10 @@ import (
 
 type (
 	GetDomainsResponse struct {
-		Id     string `json:"id"`
-		Name   string `json:"name"`
-		Status bool   `json:"status"`
+		Id            string  `json:"id"`
+		InstitutionId *string `json:"institution_id"`
+		Name          string  `json:"name"`
+		Status        bool    `json:"status"`
 	}
 )
 
@@ -22,6 +24,8 @@ type (
 func (h *DomainHandler) GetDomains(c *fiber.Ctx) error {
 	ctx := c.UserContext()
 
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
 	page, _ := strconv.Atoi(c.Query("page", "1"))
 	pageSize, _ := strconv.Atoi(c.Query("page_size", "10"))
 
@@ -30,7 +34,8 @@ func (h *DomainHandler) GetDomains(c *fiber.Ctx) error {
 			Page: page,
 			Size: pageSize,
 		},
-		Search: c.Query("search"),
+		InstitutionId: institutionId,
+		Search:        c.Query("search"),
 	}
 
 	domains, total, err := h.useCase.FindAll(ctx, filter)
@@ -41,9 +46,10 @@ func (h *DomainHandler) GetDomains(c *fiber.Ctx) error {
 	result := make([]GetDomainsResponse, len(domains))
 	for i, d := range domains {
 		result[i] = GetDomainsResponse{
-			Id:     d.Id,
-			Name:   d.Name,
-			Status: d.Status,
+			Id:            d.Id,
+			InstitutionId: d.InstitutionId,
+			Name:          d.Name,
+			Status:        d.Status,
 		}
 	}
 
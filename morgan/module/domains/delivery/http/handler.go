@@ -1,16 +1,23 @@
 package http
 
 import (
-	"net/http"
-
 	"github.com/gofiber/fiber/v2"
-	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/libraries/middleware"
-	"github.com/siakup/morgan-be/libraries/validation"
+	"github.com/siakup/morgan-be/libraries/openapi"
 	"github.com/siakup/morgan-be/libraries/responses"
 	"github.com/siakup/morgan-be/morgan/module/domains/domain"
 )
 
+// Permission codes guarding the domains routes.
+const (
+	PermissionView   = "domains.organization.domains.view"
+	PermissionCreate = "domains.organization.domains.create"
+	PermissionEdit   = "domains.organization.domains.edit"
+)
+
+// Permissions lists every permission code required by the domains routes.
+var Permissions = []string{PermissionView, PermissionCreate, PermissionEdit}
+
 type DomainHandler struct {
 	useCase domain.UseCase
 	auth    *middleware.AuthorizationMiddleware
@@ -23,21 +30,12 @@ func NewDomainHandler(useCase domain.UseCase, auth *middleware.AuthorizationMidd
 	}
 }
 
-func (h *DomainHandler) RegisterRoutes(app *fiber.App) {
-	group := app.Group("/domains", middleware.TraceMiddleware)
-
-	group.Get("/", h.auth.Authenticate("domains.organization.domains.view"), h.GetDomains)
-	group.Get("/:id", h.auth.Authenticate("domains.organization.domains.view"), h.GetDomainByID)
-	group.Post("/", h.auth.Authenticate("domains.organization.domains.create"), validation.ValidateBody(func() interface{} { return &CreateDomainRequest{} }), h.CreateDomain)
-	group.Put("/:id", h.auth.Authenticate("domains.organization.domains.edit"), validation.ValidateBody(func() interface{} { return &UpdateDomainRequest{} }), h.UpdateDomain)
-	group.Delete("/:id", h.auth.Authenticate("domains.organization.domains.edit"), h.DeleteDomain)
-
+func (h *DomainHandler) RegisterRoutes(router fiber.Router) {
+	router.Use("/domains", middleware.TraceMiddleware)
+	openapi.Mount(router, h.auth.Authenticate, h.Endpoints())
 }
 
+// handleError renders err with the standard error envelope.
 func (h *DomainHandler) handleError(c *fiber.Ctx, err error) error {
-	if appErr, ok := err.(*errors.AppError); ok {
-		return c.Status(appErr.Code).JSON(responses.Fail(string(appErr.Type), appErr.Message))
-	}
-
-	return c.Status(http.StatusInternalServerError).JSON(responses.Fail("SYSTEM_ERROR", err.Error()))
+	return responses.HandleError(c, err)
 }
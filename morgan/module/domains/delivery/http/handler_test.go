@@ -10,6 +10,7 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/validation"
 	deliverhttp "github.com/siakup/morgan-be/morgan/module/domains/delivery/http"
 	"github.com/siakup/morgan-be/morgan/module/domains/domain"
 	"github.com/siakup/morgan-be/morgan/tests/mocks"
@@ -25,13 +26,14 @@ func setupDomainApp(useCase domain.UseCase) *fiber.App {
 	// Mock middleware
 	app.Use(func(c *fiber.Ctx) error {
 		c.Locals(middleware.XUserIdKey, "user-1")
+		c.Locals(middleware.XInstitutionId, "inst-1")
 		return c.Next()
 	})
 
 	app.Get("/domains", handler.GetDomains)
 	app.Get("/domains/:id", handler.GetDomainByID)
-	app.Post("/domains", handler.CreateDomain)
-	app.Put("/domains/:id", handler.UpdateDomain)
+	app.Post("/domains", validation.ValidateBody(func() interface{} { return &deliverhttp.CreateDomainRequest{} }), handler.CreateDomain)
+	app.Put("/domains/:id", validation.ValidateBody(func() interface{} { return &deliverhttp.UpdateDomainRequest{} }), handler.UpdateDomain)
 	app.Delete("/domains/:id", handler.DeleteDomain)
 
 	return app
@@ -45,7 +47,9 @@ func TestDomainHandler_GetDomains(t *testing.T) {
 		domains := []*domain.Domain{{Id: "d1", Name: "Domain 1"}}
 		count := int64(1)
 
-		mockUseCase.On("FindAll", mock.Anything, mock.Anything).Return(domains, count, nil).Once()
+		mockUseCase.On("FindAll", mock.Anything, mock.MatchedBy(func(f domain.DomainFilter) bool {
+			return f.InstitutionId == "inst-1"
+		})).Return(domains, count, nil).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/domains", nil)
 		resp, err := app.Test(req)
@@ -78,7 +82,7 @@ func TestDomainHandler_GetDomainByID(t *testing.T) {
 			Status: true,
 		}
 
-		mockUseCase.On("Get", mock.Anything, "d1").Return(domain, nil).Once()
+		mockUseCase.On("Get", mock.Anything, "inst-1", "d1").Return(domain, nil).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/domains/d1", nil)
 		resp, err := app.Test(req)
@@ -89,7 +93,7 @@ func TestDomainHandler_GetDomainByID(t *testing.T) {
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
-		mockUseCase.On("Get", mock.Anything, "d-invalid").Return((*domain.Domain)(nil), errors.New("not found")).Once()
+		mockUseCase.On("Get", mock.Anything, "inst-1", "d-invalid").Return((*domain.Domain)(nil), errors.New("not found")).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/domains/d-invalid", nil)
 		resp, err := app.Test(req)
@@ -111,7 +115,7 @@ func TestDomainHandler_CreateDomain(t *testing.T) {
 		reqBytes, _ := json.Marshal(reqBody)
 
 		mockUseCase.On("Create", mock.Anything, mock.MatchedBy(func(d *domain.Domain) bool {
-			return d.Name == "New Domain"
+			return d.Name == "New Domain" && d.InstitutionId != nil && *d.InstitutionId == "inst-1"
 		})).Return(nil).Once()
 
 		req := httptest.NewRequest(http.MethodPost, "/domains", bytes.NewReader(reqBytes))
@@ -159,7 +163,7 @@ func TestDomainHandler_UpdateDomain(t *testing.T) {
 		reqBytes, _ := json.Marshal(reqBody)
 
 		mockUseCase.On("Update", mock.Anything, mock.MatchedBy(func(d *domain.Domain) bool {
-			return d.Id == "d1" && d.Name == "Updated Domain"
+			return d.Id == "d1" && d.Name == "Updated Domain" && d.InstitutionId != nil && *d.InstitutionId == "inst-1"
 		})).Return(nil).Once()
 
 		req := httptest.NewRequest(http.MethodPut, "/domains/d1", bytes.NewReader(reqBytes))
@@ -191,7 +195,7 @@ func TestDomainHandler_DeleteDomain(t *testing.T) {
 	app := setupDomainApp(mockUseCase)
 
 	t.Run("Success", func(t *testing.T) {
-		mockUseCase.On("Delete", mock.Anything, "d1", "user-1").Return(nil).Once()
+		mockUseCase.On("Delete", mock.Anything, "inst-1", "d1", "user-1").Return(nil).Once()
 
 		req := httptest.NewRequest(http.MethodDelete, "/domains/d1", nil)
 		resp, err := app.Test(req)
@@ -202,7 +206,7 @@ func TestDomainHandler_DeleteDomain(t *testing.T) {
 	})
 
 	t.Run("Error", func(t *testing.T) {
-		mockUseCase.On("Delete", mock.Anything, "d1", "user-1").Return(errors.New("delete failed")).Once()
+		mockUseCase.On("Delete", mock.Anything, "inst-1", "d1", "user-1").Return(errors.New("delete failed")).Once()
 
 		req := httptest.NewRequest(http.MethodDelete, "/domains/d1", nil)
 		resp, err := app.Test(req)
@@ -0,0 +1,26 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"github.com/siakup/morgan-be/libraries/validation"
+)
+
+type listQuery struct {
+	Page     int    `query:"page" default:"1"`
+	PageSize int    `query:"page_size" default:"10"`
+	Search   string `query:"search"`
+}
+
+// Endpoints lists the domains routes: RegisterRoutes mounts them and the OpenAPI document describes them.
+func (h *DomainHandler) Endpoints() []openapi.Endpoint {
+	return []openapi.Endpoint{
+		{Method: fiber.MethodGet, Path: "/domains", Summary: "List domains", Permissions: []string{PermissionView}, Query: listQuery{}, Response: []GetDomainsResponse{}, Paginated: true, Handlers: []fiber.Handler{h.GetDomains}},
+		{Method: fiber.MethodGet, Path: "/domains/:id", Summary: "Get a domain", Permissions: []string{PermissionView}, Response: GetDomainByIDResponse{}, Handlers: []fiber.Handler{h.GetDomainByID}},
+		{Method: fiber.MethodPost, Path: "/domains", Summary: "Create a domain", Permissions: []string{PermissionCreate}, Request: CreateDomainRequest{}, Response: CreateDomainResponse{}, Status: http.StatusCreated, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &CreateDomainRequest{} }), h.CreateDomain}},
+		{Method: fiber.MethodPut, Path: "/domains/:id", Summary: "Update a domain", Permissions: []string{PermissionEdit}, Request: UpdateDomainRequest{}, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &UpdateDomainRequest{} }), h.UpdateDomain}},
+		{Method: fiber.MethodDelete, Path: "/domains/:id", Summary: "Delete a domain", Permissions: []string{PermissionEdit}, Handlers: []fiber.Handler{h.DeleteDomain}},
+	}
+}
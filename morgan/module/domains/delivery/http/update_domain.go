@@ -5,9 +5,9 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/siakup/morgan-be/libraries/errors"
-	"github.com/siakup/morgan-be/libraries/validation"
 	"github.com/siakup/morgan-be/libraries/middleware"
 	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/validation"
 	"github.com/siakup/morgan-be/morgan/module/domains/domain"
 )
 
@@ -31,6 +31,8 @@ func (h *DomainHandler) UpdateDomain(c *fiber.Ctx) error {
 		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
 	}
 
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
 	// DTO parsed/validated by middleware
 	raw := c.Locals(validation.ValidatedBodyKey)
 	req, ok := raw.(*UpdateDomainRequest)
@@ -39,10 +41,11 @@ func (h *DomainHandler) UpdateDomain(c *fiber.Ctx) error {
 	}
 
 	updatedDomain := domain.Domain{
-		Id:        id,
-		Name:      req.Name,
-		Status:    req.Status,
-		UpdatedBy: &userId,
+		Id:            id,
+		InstitutionId: &institutionId,
+		Name:          req.Name,
+		Status:        req.Status,
+		UpdatedBy:     &userId,
 	}
 
 	if err := h.useCase.Update(ctx, &updatedDomain); err != nil {
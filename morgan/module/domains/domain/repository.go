@@ -8,21 +8,23 @@ import (
 )
 
 type Domain struct {
-	Id        string     `object:"id"`
-	Name      string     `object:"name"`
-	Status    bool       `object:"status"`
-	CreatedAt time.Time  `object:"created_at"`
-	UpdatedAt time.Time  `object:"updated_at"`
-	DeletedAt *time.Time `object:"deleted_at"` // Pointer for nullable
-	CreatedBy *string    `object:"created_by"` // Nullable
-	UpdatedBy *string    `object:"updated_by"` // Nullable
-	DeletedBy *string    `object:"deleted_by"` // Nullable
+	Id            string     `object:"id"`
+	InstitutionId *string    `object:"institution_id"` // Nullable, shared by every institution
+	Name          string     `object:"name"`
+	Status        bool       `object:"status"`
+	CreatedAt     time.Time  `object:"created_at"`
+	UpdatedAt     time.Time  `object:"updated_at"`
+	DeletedAt     *time.Time `object:"deleted_at"` // Pointer for nullable
+	CreatedBy     *string    `object:"created_by"` // Nullable
+	UpdatedBy     *string    `object:"updated_by"` // Nullable
+	DeletedBy     *string    `object:"deleted_by"` // Nullable
 }
 
 type DomainFilter struct {
 	types.Pagination
-	Status string
-	Search string // Search in name
+	InstitutionId string // Lists the institution's own and the shared domains
+	Status        string
+	Search        string // Search in name
 }
 
 type DomainRepository interface {
@@ -30,5 +32,5 @@ type DomainRepository interface {
 	FindByID(ctx context.Context, id string) (*Domain, error)
 	Store(ctx context.Context, Domain *Domain) error
 	Update(ctx context.Context, Domain *Domain) error
-	Delete(ctx context.Context, id string, deletedBy string) error
+	Delete(ctx context.Context, institutionId string, id string, deletedBy string) error
 }
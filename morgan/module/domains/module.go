@@ -1,12 +1,13 @@
 package domains
 
 import (
-	gofiber "github.com/gofiber/fiber/v2"
-	"go.uber.org/fx"
+	"github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/registry"
 	"github.com/siakup/morgan-be/morgan/module/domains/delivery/http"
 	"github.com/siakup/morgan-be/morgan/module/domains/domain"
 	"github.com/siakup/morgan-be/morgan/module/domains/repository/postgresql"
 	"github.com/siakup/morgan-be/morgan/module/domains/usecase"
+	"go.uber.org/fx"
 )
 
 // Module exports the roles module for Fx.
@@ -24,9 +25,16 @@ var Module = fx.Options(
 		),
 		http.NewDomainHandler,
 	),
-	fx.Invoke(registerRoutes),
+	registry.Provide(newModule),
 )
 
-func registerRoutes(h *http.DomainHandler, app *gofiber.App) {
-	h.RegisterRoutes(app)
+func newModule(h *http.DomainHandler) registry.Module {
+	return registry.Module{
+		Name:        "domains",
+		Version:     fiber.V1,
+		BasePath:    "/domains",
+		Permissions: http.Permissions,
+		Endpoints:   h.Endpoints(),
+		Register:    h.RegisterRoutes,
+	}
 }
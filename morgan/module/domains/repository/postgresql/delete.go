@@ -11,15 +11,17 @@ SET
     deleted_at = NOW(),
     deleted_by = @deleted_by
 WHERE id = @id
+AND institution_id = @institution_id
 AND deleted_at IS NULL
 `
 
-// Delete soft removes a domain from the database.
-func (r *Repository) Delete(ctx context.Context, id string, deletedBy string) error {
+// Delete soft removes a domain of the institution from the database.
+func (r *Repository) Delete(ctx context.Context, institutionId string, id string, deletedBy string) error {
 
-	_, err := r.db.Exec(ctx, queryDelete, pgx.NamedArgs{
-		"id":         id,
-		"deleted_by": deletedBy,
+	_, err := r.conn(ctx).Exec(ctx, queryDelete, pgx.NamedArgs{
+		"id":             id,
+		"institution_id": institutionId,
+		"deleted_by":     deletedBy,
 	})
 
 	return err
@@ -16,6 +16,11 @@ func (r *Repository) FindAll(ctx context.Context, filter domain.DomainFilter) ([
 	`
 	args := pgx.NamedArgs{}
 
+	if filter.InstitutionId != "" {
+		baseQuery += " AND (institution_id IS NULL OR institution_id = @institution_id)"
+		args["institution_id"] = filter.InstitutionId
+	}
+
 	// Simple search implementation
 	if filter.Search != "" {
 		baseQuery += " AND (name ILIKE @search)"
@@ -33,6 +38,7 @@ func (r *Repository) FindAll(ctx context.Context, filter domain.DomainFilter) ([
 	selectQuery := `
 		SELECT
     id,
+    institution_id,
     name,
     status,
     created_at,
@@ -11,6 +11,7 @@ import (
 var queryFindById = `
 	SELECT
 		id,
+    institution_id,
     name,
     status,
     created_at,
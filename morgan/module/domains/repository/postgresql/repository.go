@@ -1,9 +1,11 @@
 package postgresql
 
 import (
+	"context"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/siakup/morgan-be/framework/postgres"
 	"github.com/siakup/morgan-be/morgan/module/domains/domain"
 )
 
@@ -11,15 +13,16 @@ var _ domain.DomainRepository = (*Repository)(nil)
 
 // DomainEntity maps to master.domains table.
 type DomainEntity struct {
-	Id        string     `db:"id" map:"Id"`
-	Name      string     `db:"name" map:"Name"`
-	Status    bool       `db:"status" map:"Status"`
-	CreatedAt time.Time  `db:"created_at" map:"CreatedAt"`
-	UpdatedAt time.Time  `db:"updated_at" map:"UpdatedAt"`
-	DeletedAt *time.Time `db:"deleted_at" map:"DeletedAt"`
-	CreatedBy *string    `db:"created_by" map:"CreatedBy"`
-	UpdatedBy *string    `db:"updated_by" map:"UpdatedBy"`
-	DeletedBy *string    `db:"deleted_by" map:"DeletedBy"`
+	Id            string     `db:"id" map:"Id"`
+	InstitutionId *string    `db:"institution_id" map:"InstitutionId"`
+	Name          string     `db:"name" map:"Name"`
+	Status        bool       `db:"status" map:"Status"`
+	CreatedAt     time.Time  `db:"created_at" map:"CreatedAt"`
+	UpdatedAt     time.Time  `db:"updated_at" map:"UpdatedAt"`
+	DeletedAt     *time.Time `db:"deleted_at" map:"DeletedAt"`
+	CreatedBy     *string    `db:"created_by" map:"CreatedBy"`
+	UpdatedBy     *string    `db:"updated_by" map:"UpdatedBy"`
+	DeletedBy     *string    `db:"deleted_by" map:"DeletedBy"`
 }
 
 // Repository implements domain.DomainRepository.
@@ -31,3 +34,8 @@ type Repository struct {
 func NewRepository(db *pgxpool.Pool) *Repository {
 	return &Repository{db: db}
 }
+
+// conn returns the transaction bound to ctx, so writes join the usecase's transaction.
+func (r *Repository) conn(ctx context.Context) postgres.Querier {
+	return postgres.Conn(ctx, r.db)
+}
@@ -9,19 +9,20 @@ import (
 
 var queryStore = `
     	INSERT INTO master.domains (
-    		name, created_by, updated_by
+    		institution_id, name, created_by, updated_by
     	) VALUES (
-    		@name, @created_by, @updated_by
+    		@institution_id, @name, @created_by, @updated_by
     	)
     	RETURNING id
 `
 
 // Store persists a new domain to the database.
 func (r *Repository) Store(ctx context.Context, domain *domain.Domain) error {
-	rows, err := r.db.Query(ctx, queryStore, pgx.NamedArgs{
-		"name":       domain.Name,
-		"created_by": domain.CreatedBy,
-		"updated_by": domain.UpdatedBy,
+	rows, err := r.conn(ctx).Query(ctx, queryStore, pgx.NamedArgs{
+		"institution_id": domain.InstitutionId,
+		"name":           domain.Name,
+		"created_by":     domain.CreatedBy,
+		"updated_by":     domain.UpdatedBy,
 	})
 	if err != nil {
 		return err
@@ -14,16 +14,17 @@ var queryUpdate = `
 		status = @status,
 		updated_by = @updated_by,
 		updated_at = now()
-	WHERE id = @id
+	WHERE id = @id AND institution_id = @institution_id
 `
 
 // Update modifies an existing domain record.
 func (r *Repository) Update(ctx context.Context, domain *domain.Domain) error {
-	_, err := r.db.Exec(ctx, queryUpdate, pgx.NamedArgs{
-		"id":         domain.Id,
-		"name":       domain.Name,
-		"status":     domain.Status,
-		"updated_by": domain.UpdatedBy,
+	_, err := r.conn(ctx).Exec(ctx, queryUpdate, pgx.NamedArgs{
+		"id":             domain.Id,
+		"institution_id": domain.InstitutionId,
+		"name":           domain.Name,
+		"status":         domain.Status,
+		"updated_by":     domain.UpdatedBy,
 	})
 	return err
 }
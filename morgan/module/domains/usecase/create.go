@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/morgan/module/domains/domain"
 )
@@ -15,7 +16,13 @@ func (u *UseCase) Create(ctx context.Context, domain *domain.Domain) error {
 
 	logger := zerolog.Ctx(ctx)
 
-	if err := u.repository.Store(ctx, domain); err != nil {
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.Store(ctx, domain); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entityDomain, EntityId: domain.Id, Action: audit.ActionCreate, After: domain})
+	}); err != nil {
 		logger.Error().
 			Str("func", "repository.Store").
 			Err(err).
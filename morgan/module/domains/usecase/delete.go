@@ -4,17 +4,29 @@ import (
 	"context"
 
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
 )
 
-// Delete soft removes a domain from the system.
-func (u *UseCase) Delete(ctx context.Context, id string, deletedBy string) error {
+// Delete soft removes a domain of the institution from the system.
+func (u *UseCase) Delete(ctx context.Context, institutionId string, id string, deletedBy string) error {
 	ctx, span := u.tracer.Start(ctx, "Delete")
 	defer span.End()
 
 	logger := zerolog.Ctx(ctx)
 
-	if err := u.repository.Delete(ctx, id, deletedBy); err != nil {
+	current, err := u.loadOwned(ctx, institutionId, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.Delete(ctx, institutionId, id, deletedBy); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entityDomain, EntityId: id, Action: audit.ActionDelete, Before: current})
+	}); err != nil {
 		logger.Error().
 			Str("func", "repository.Delete").
 			Err(err).
@@ -2,31 +2,37 @@ package usecase
 
 import (
 	"context"
-	errs "errors"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/morgan/module/domains/domain"
 )
 
-// Update modifies an existing domain.
+// Update modifies an existing domain of the institution in domain.InstitutionId.
 func (u *UseCase) Update(ctx context.Context, domain *domain.Domain) error {
 	ctx, span := u.tracer.Start(ctx, "Update")
 	defer span.End()
 
 	logger := zerolog.Ctx(ctx)
 
+	if domain.InstitutionId == nil {
+		return errors.BadRequest("missing institution")
+	}
+
 	// Verify exists
-	_, err := u.repository.FindByID(ctx, domain.Id)
+	current, err := u.loadOwned(ctx, *domain.InstitutionId, domain.Id)
 	if err != nil {
-		if errs.Is(err, pgx.ErrNoRows) {
-			return errors.NotFound("domain not found")
-		}
-		return errors.InternalServerError("failed to find domain")
+		return err
 	}
 
-	if err := u.repository.Update(ctx, domain); err != nil {
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.Update(ctx, domain); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entityDomain, EntityId: domain.Id, Action: audit.ActionUpdate, Before: current, After: domain})
+	}); err != nil {
 		logger.Error().
 			Str("func", "repository.Update").
 			Err(err).
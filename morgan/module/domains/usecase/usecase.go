@@ -1,9 +1,17 @@
 package usecase
 
 import (
+	"context"
+	errs "errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/framework/postgres"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/domains/domain"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
-	"github.com/siakup/morgan-be/morgan/module/domains/domain"
 )
 
 var _ domain.UseCase = (*UseCase)(nil)
@@ -11,13 +19,57 @@ var _ domain.UseCase = (*UseCase)(nil)
 // UseCase implements the logic for domains management.
 type UseCase struct {
 	repository domain.DomainRepository
+	tx         postgres.Transactor
+	recorder   audit.Recorder
 	tracer     trace.Tracer
 }
 
 // NewUseCase creates a new instance of Domains UseCase.
-func NewUseCase(repository domain.DomainRepository) *UseCase {
+func NewUseCase(repository domain.DomainRepository, tx postgres.Transactor, recorder audit.Recorder) *UseCase {
 	return &UseCase{
 		repository: repository,
+		tx:         tx,
+		recorder:   recorder,
 		tracer:     otel.Tracer("domains"),
 	}
 }
+
+// entityDomain is the audit entity name of a domain.
+const entityDomain = "domain"
+
+// load finds a domain the institution can see: one of its own or a shared one.
+func (u *UseCase) load(ctx context.Context, institutionId string, id string) (*domain.Domain, error) {
+	domain, err := u.repository.FindByID(ctx, id)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("domain not found")
+		}
+
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.FindByID").
+			Err(err).
+			Msg("failed to find domain by id")
+		return nil, errors.InternalServerError("failed to find domain by id")
+	}
+
+	if domain.InstitutionId != nil && *domain.InstitutionId != institutionId {
+		return nil, errors.NotFound("domain not found")
+	}
+
+	return domain, nil
+}
+
+// loadOwned finds a domain of the institution. Shared domains are not owned by any institution,
+// so none can change them.
+func (u *UseCase) loadOwned(ctx context.Context, institutionId string, id string) (*domain.Domain, error) {
+	domain, err := u.load(ctx, institutionId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if domain.InstitutionId == nil {
+		return nil, errors.Forbidden("shared domains cannot be modified")
+	}
+
+	return domain, nil
+}
@@ -6,16 +6,22 @@ import (
 	"testing"
 
 	"github.com/jackc/pgx/v5"
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/mock"
+	"github.com/siakup/morgan-be/libraries/audit"
+	liberrors "github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/morgan/module/domains/domain"
 	"github.com/siakup/morgan-be/morgan/module/domains/usecase"
 	"github.com/siakup/morgan-be/morgan/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestUseCase_Domains(t *testing.T) {
 	mockRepo := new(mocks.DomainsRepositoryMock)
-	uc := usecase.NewUseCase(mockRepo)
+	mockRecorder := new(mocks.AuditRecorderMock)
+	mockRecorder.On("Record", mock.Anything, mock.Anything).Return(nil).Maybe()
+	uc := usecase.NewUseCase(mockRepo, new(mocks.TransactorMock), mockRecorder)
+	institutionId := "inst-1"
+	otherInstitutionId := "inst-2"
 
 	t.Run("FindAll", func(t *testing.T) {
 		ctx := context.Background()
@@ -36,11 +42,11 @@ func TestUseCase_Domains(t *testing.T) {
 	t.Run("Get", func(t *testing.T) {
 		ctx := context.Background()
 		id := "d1"
-		domain := &domain.Domain{Id: id, Name: "Domain 1"}
+		domain := &domain.Domain{Id: id, InstitutionId: &institutionId, Name: "Domain 1"}
 
 		mockRepo.On("FindByID", mock.Anything, id).Return(domain, nil).Once()
 
-		res, err := uc.Get(ctx, id)
+		res, err := uc.Get(ctx, institutionId, id)
 		assert.NoError(t, err)
 		assert.Equal(t, domain, res)
 
@@ -67,9 +73,10 @@ func TestUseCase_Domains(t *testing.T) {
 	t.Run("Update", func(t *testing.T) {
 		ctx := context.Background()
 		domain := &domain.Domain{
-			Id:     "d1",
-			Name:   "Updated Domain",
-			Status: true,
+			Id:            "d1",
+			InstitutionId: &institutionId,
+			Name:          "Updated Domain",
+			Status:        true,
 		}
 
 		mockRepo.On("FindByID", mock.Anything, "d1").Return(domain, nil).Once()
@@ -86,28 +93,77 @@ func TestUseCase_Domains(t *testing.T) {
 		id := "d1"
 		deletedBy := "user-1"
 
-		mockRepo.On("Delete", mock.Anything, id, deletedBy).Return(nil).Once()
+		current := &domain.Domain{Id: id, InstitutionId: &institutionId, Name: "Domain 1"}
+
+		mockRepo.On("FindByID", mock.Anything, id).Return(current, nil).Once()
+		mockRepo.On("Delete", mock.Anything, institutionId, id, deletedBy).Return(nil).Once()
 
-		err := uc.Delete(ctx, id, deletedBy)
+		err := uc.Delete(ctx, institutionId, id, deletedBy)
 		assert.NoError(t, err)
 
 		mockRepo.AssertExpectations(t)
+		mockRecorder.AssertCalled(t, "Record", mock.Anything, audit.Entry{Entity: "domain", EntityId: id, Action: audit.ActionDelete, Before: current})
 	})
 
 	t.Run("Get_NotFound", func(t *testing.T) {
 		ctx := context.Background()
 		mockRepo.On("FindByID", mock.Anything, "d1").Return((*domain.Domain)(nil), pgx.ErrNoRows).Once()
 
-		res, err := uc.Get(ctx, "d1")
+		res, err := uc.Get(ctx, institutionId, "d1")
 		assert.Error(t, err)
 		assert.Nil(t, res)
 		assert.Contains(t, err.Error(), "not found")
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("Get_Shared", func(t *testing.T) {
+		ctx := context.Background()
+		shared := &domain.Domain{Id: "d2", Name: "Shared"}
+		mockRepo.On("FindByID", mock.Anything, "d2").Return(shared, nil).Once()
+
+		res, err := uc.Get(ctx, institutionId, "d2")
+		assert.NoError(t, err)
+		assert.Equal(t, shared, res)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Get_OtherInstitution", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepo.On("FindByID", mock.Anything, "d3").Return(&domain.Domain{Id: "d3", InstitutionId: &otherInstitutionId}, nil).Once()
+
+		res, err := uc.Get(ctx, institutionId, "d3")
+		assert.Nil(t, res)
+		appErr, ok := err.(*liberrors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, liberrors.ErrorTypeNotFound, appErr.Type)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Update_Shared", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepo.On("FindByID", mock.Anything, "d2").Return(&domain.Domain{Id: "d2", Name: "Shared"}, nil).Once()
+
+		err := uc.Update(ctx, &domain.Domain{Id: "d2", InstitutionId: &institutionId, Name: "Renamed"})
+		appErr, ok := err.(*liberrors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, liberrors.ErrorTypeForbidden, appErr.Type)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Delete_OtherInstitution", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepo.On("FindByID", mock.Anything, "d3").Return(&domain.Domain{Id: "d3", InstitutionId: &otherInstitutionId}, nil).Once()
+
+		err := uc.Delete(ctx, institutionId, "d3", "user-1")
+		appErr, ok := err.(*liberrors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, liberrors.ErrorTypeNotFound, appErr.Type)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, institutionId, "d3", "user-1")
+	})
+
 	t.Run("Update_NotFound", func(t *testing.T) {
 		ctx := context.Background()
-		updateDomain := &domain.Domain{Id: "d1"}
+		updateDomain := &domain.Domain{Id: "d1", InstitutionId: &institutionId}
 
 		mockRepo.On("FindByID", mock.Anything, "d1").Return((*domain.Domain)(nil), pgx.ErrNoRows).Once()
 
@@ -138,9 +194,10 @@ func TestUseCase_Domains(t *testing.T) {
 
 	t.Run("Delete_Error", func(t *testing.T) {
 		ctx := context.Background()
-		mockRepo.On("Delete", mock.Anything, "d1", "user-1").Return(errors.New("delete failed")).Once()
+		mockRepo.On("FindByID", mock.Anything, "d1").Return(&domain.Domain{Id: "d1", InstitutionId: &institutionId}, nil).Once()
+		mockRepo.On("Delete", mock.Anything, institutionId, "d1", "user-1").Return(errors.New("delete failed")).Once()
 
-		err := uc.Delete(ctx, "d1", "user-1")
+		err := uc.Delete(ctx, institutionId, "d1", "user-1")
 		assert.Error(t, err)
 		mockRepo.AssertExpectations(t)
 	})
@@ -1,12 +1,11 @@
 package http
 
 import (
-	"net/http"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/openapi"
 	"github.com/siakup/morgan-be/libraries/responses"
 	"github.com/siakup/morgan-be/morgan/module/redirect/domain"
 )
@@ -21,8 +20,8 @@ func NewHandler(useCase domain.RedirectUseCase) *RedirectHandler {
 	}
 }
 
-func (h *RedirectHandler) RegisterRoutes(app *fiber.App) {
-	app.Get("/redirect/:institution_id", h.Redirect)
+func (h *RedirectHandler) RegisterRoutes(router fiber.Router) {
+	openapi.Mount(router, nil, h.Endpoints())
 }
 
 func (h *RedirectHandler) Redirect(c *fiber.Ctx) error {
@@ -31,19 +30,12 @@ func (h *RedirectHandler) Redirect(c *fiber.Ctx) error {
 	token := c.Query("token")
 
 	if institutionId == "" || token == "" {
-		return c.Status(http.StatusBadRequest).JSON(responses.Fail(strconv.Itoa(http.StatusBadRequest), "institution_id and token are required"))
+		return responses.HandleError(c, errors.BadRequest("institution_id and token are required"))
 	}
 
 	redirectUrl, sessionId, err := h.useCase.Redirect(ctx, institutionId, token)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return c.Status(http.StatusNotFound).JSON(responses.Fail(strconv.Itoa(http.StatusNotFound), err.Error()))
-		}
-		if strings.Contains(err.Error(), "invalid token") {
-			return c.Status(http.StatusForbidden).JSON(responses.Fail(strconv.Itoa(http.StatusForbidden), err.Error()))
-		}
-
-		return c.Status(http.StatusInternalServerError).JSON(responses.Fail(strconv.Itoa(http.StatusInternalServerError), err.Error()))
+		return responses.HandleError(c, err)
 	}
 
 	// Set Cookie
@@ -1,6 +1,7 @@
 package http_test
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +10,8 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	libErrors "github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/responses"
 	deliverhttp "github.com/siakup/morgan-be/morgan/module/redirect/delivery/http"
 	"github.com/siakup/morgan-be/morgan/tests/mocks"
 )
@@ -61,7 +64,7 @@ func TestRedirectHandler_Redirect(t *testing.T) {
 		instId := "inst-2"
 		token := "token"
 
-		mockUseCase.On("Redirect", mock.Anything, instId, token).Return("", "", errors.New("not found")).Once()
+		mockUseCase.On("Redirect", mock.Anything, instId, token).Return("", "", libErrors.NotFound("institution not found")).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/redirect/"+instId+"?token="+token, nil)
 		resp, err := app.Test(req)
@@ -75,7 +78,7 @@ func TestRedirectHandler_Redirect(t *testing.T) {
 		instId := "inst-3"
 		token := "invalid"
 
-		mockUseCase.On("Redirect", mock.Anything, instId, token).Return("", "", errors.New("invalid token")).Once()
+		mockUseCase.On("Redirect", mock.Anything, instId, token).Return("", "", libErrors.Forbidden("invalid token")).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/redirect/"+instId+"?token="+token, nil)
 		resp, err := app.Test(req)
@@ -96,6 +99,13 @@ func TestRedirectHandler_Redirect(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+		// Internal error text must not reach the client
+		var body responses.Response[any]
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.False(t, body.Success)
+		assert.Equal(t, "SYSTEM_ERROR", body.Error.Code)
+		assert.NotContains(t, body.Error.Message, "db error")
 		mockUseCase.AssertExpectations(t)
 	})
 }
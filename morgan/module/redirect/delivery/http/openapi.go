@@ -0,0 +1,28 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/openapi"
+)
+
+type redirectQuery struct {
+	Token string `query:"token" validate:"required" doc:"One-time token issued by the identity provider"`
+}
+
+// Endpoints lists the redirect route: RegisterRoutes mounts it and the OpenAPI document describes it.
+func (h *RedirectHandler) Endpoints() []openapi.Endpoint {
+	return []openapi.Endpoint{
+		{
+			Method:      fiber.MethodGet,
+			Path:        "/redirect/:institution_id",
+			Summary:     "Start a session",
+			Description: "Exchanges the token for a session, sets the session_id cookie and redirects to the institution.",
+			Public:      true,
+			Query:       redirectQuery{},
+			Status:      http.StatusFound,
+			Handlers:    []fiber.Handler{h.Redirect},
+		},
+	}
+}
@@ -1,12 +1,15 @@
 package redirect
 
 import (
-	"github.com/gofiber/fiber/v2"
-	"go.uber.org/fx"
+	"time"
+
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/registry"
 	"github.com/siakup/morgan-be/morgan/module/redirect/delivery/http"
 	"github.com/siakup/morgan-be/morgan/module/redirect/domain"
 	"github.com/siakup/morgan-be/morgan/module/redirect/repository/postgresql"
 	"github.com/siakup/morgan-be/morgan/module/redirect/usecase"
+	"go.uber.org/fx"
 )
 
 var Module = fx.Module(
@@ -24,11 +27,17 @@ var Module = fx.Module(
 		),
 		http.NewHandler,
 	),
-	fx.Invoke(
-		registerRoutes,
-	),
+	registry.Provide(newModule),
 )
 
-func registerRoutes(h *http.RedirectHandler, app *fiber.App) {
-	h.RegisterRoutes(app)
+func newModule(h *http.RedirectHandler) registry.Module {
+	return registry.Module{
+		Name:     "redirect",
+		BasePath: "/redirect",
+		Core:     true,
+		// Every attempt checks a token against the IdP; slow down guessing
+		RateLimit: &middleware.RateLimitRule{Limit: 10, Window: time.Minute, By: middleware.RateLimitByIP},
+		Endpoints: h.Endpoints(),
+		Register:  h.RegisterRoutes,
+	}
 }
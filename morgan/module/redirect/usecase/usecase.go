@@ -47,7 +47,7 @@ func (u *UseCase) Redirect(ctx context.Context, institutionId string, token stri
 
 	authSession, err := idpClient.Check(ctx, token)
 	if err != nil {
-		return "", "", errors.New(errors.ErrorTypeUnauthorized, 403, "invalid token", nil)
+		return "", "", errors.Forbidden("invalid token")
 	}
 
 	user, err := u.repository.FindUserBySub(ctx, institutionId, authSession.Sub)
@@ -1,15 +1,24 @@
 package http
 
 import (
-	"net/http"
-
 	"github.com/gofiber/fiber/v2"
-	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/openapi"
 	"github.com/siakup/morgan-be/libraries/responses"
 	"github.com/siakup/morgan-be/morgan/module/roles/domain"
 )
 
+// Permission codes guarding the roles routes.
+const (
+	PermissionView   = "roles.iam.roles.view"
+	PermissionCreate = "roles.iam.roles.create"
+	PermissionEdit   = "roles.iam.roles.edit"
+	PermissionDelete = "roles.iam.roles.delete"
+)
+
+// Permissions lists every permission code required by the roles routes.
+var Permissions = []string{PermissionView, PermissionCreate, PermissionEdit, PermissionDelete}
+
 // RoleHandler handles HTTP requests for roles module.
 type RoleHandler struct {
 	useCase domain.UseCase
@@ -25,22 +34,12 @@ func NewRoleHandler(useCase domain.UseCase, auth *middleware.AuthorizationMiddle
 }
 
 // RegisterRoutes registers the routes for the roles module.
-func (h *RoleHandler) RegisterRoutes(app *fiber.App) {
-	group := app.Group("/roles", middleware.TraceMiddleware)
-
-	group.Get("/permissions", h.auth.Authenticate("roles.iam.roles.view"), h.GetPermissions)
-	group.Get("/", h.auth.Authenticate("roles.iam.roles.view"), h.GetRoles)
-	group.Get("/:id", h.auth.Authenticate("roles.iam.roles.view"), h.GetRoleByID)
-	group.Post("/", h.auth.Authenticate("roles.iam.roles.create"), h.CreateRole)
-	group.Put("/:id", h.auth.Authenticate("roles.iam.roles.edit"), h.UpdateRole)
-	group.Delete("/:id", h.auth.Authenticate("roles.iam.roles.delete"), h.DeleteRole)
+func (h *RoleHandler) RegisterRoutes(router fiber.Router) {
+	router.Use("/roles", middleware.TraceMiddleware)
+	openapi.Mount(router, h.auth.Authenticate, h.Endpoints())
 }
 
-// handleError handles errors by mapping them to standardized responses.
+// handleError renders err with the standard error envelope.
 func (h *RoleHandler) handleError(c *fiber.Ctx, err error) error {
-	if appErr, ok := err.(*errors.AppError); ok {
-		return c.Status(appErr.Code).JSON(responses.Fail(string(appErr.Type), appErr.Message))
-	}
-
-	return c.Status(http.StatusInternalServerError).JSON(responses.Fail("SYSTEM_ERROR", err.Error()))
+	return responses.HandleError(c, err)
 }
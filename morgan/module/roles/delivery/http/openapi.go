@@ -0,0 +1,34 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/openapi"
+)
+
+type (
+	listQuery struct {
+		Page     int    `query:"page" default:"1"`
+		PageSize int    `query:"page_size" default:"10"`
+		Search   string `query:"search"`
+	}
+
+	permissionsQuery struct {
+		Page     int    `query:"page" default:"1"`
+		PageSize int    `query:"page_size" default:"100"`
+		Search   string `query:"search"`
+	}
+)
+
+// Endpoints lists the roles routes: RegisterRoutes mounts them and the OpenAPI document describes them.
+func (h *RoleHandler) Endpoints() []openapi.Endpoint {
+	return []openapi.Endpoint{
+		{Method: fiber.MethodGet, Path: "/roles/permissions", Summary: "List permissions", Permissions: []string{PermissionView}, Query: permissionsQuery{}, Response: []GetPermissionsResponse{}, Handlers: []fiber.Handler{h.GetPermissions}},
+		{Method: fiber.MethodGet, Path: "/roles", Summary: "List roles", Permissions: []string{PermissionView}, Query: listQuery{}, Response: []GetRolesResponse{}, Paginated: true, Handlers: []fiber.Handler{h.GetRoles}},
+		{Method: fiber.MethodGet, Path: "/roles/:id", Summary: "Get a role", Permissions: []string{PermissionView}, Response: GetRoleByIDResponse{}, Handlers: []fiber.Handler{h.GetRoleByID}},
+		{Method: fiber.MethodPost, Path: "/roles", Summary: "Create a role", Permissions: []string{PermissionCreate}, Request: CreateRoleRequest{}, Response: CreateRoleResponse{}, Status: http.StatusCreated, Handlers: []fiber.Handler{h.CreateRole}},
+		{Method: fiber.MethodPut, Path: "/roles/:id", Summary: "Update a role", Permissions: []string{PermissionEdit}, Request: UpdateRoleRequest{}, Handlers: []fiber.Handler{h.UpdateRole}},
+		{Method: fiber.MethodDelete, Path: "/roles/:id", Summary: "Delete a role", Permissions: []string{PermissionDelete}, Handlers: []fiber.Handler{h.DeleteRole}},
+	}
+}
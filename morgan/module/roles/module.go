@@ -1,12 +1,13 @@
 package roles
 
 import (
-	gofiber "github.com/gofiber/fiber/v2"
-	"go.uber.org/fx"
+	"github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/registry"
 	"github.com/siakup/morgan-be/morgan/module/roles/delivery/http"
 	"github.com/siakup/morgan-be/morgan/module/roles/domain"
 	"github.com/siakup/morgan-be/morgan/module/roles/repository/postgresql"
 	"github.com/siakup/morgan-be/morgan/module/roles/usecase"
+	"go.uber.org/fx"
 )
 
 // Module exports the roles module for Fx.
@@ -24,9 +25,17 @@ var Module = fx.Options(
 		),
 		http.NewRoleHandler,
 	),
-	fx.Invoke(registerRoutes),
+	registry.Provide(newModule),
 )
 
-func registerRoutes(h *http.RoleHandler, app *gofiber.App) {
-	h.RegisterRoutes(app)
+func newModule(h *http.RoleHandler) registry.Module {
+	return registry.Module{
+		Name:        "roles",
+		Version:     fiber.V1,
+		BasePath:    "/roles",
+		Permissions: http.Permissions,
+		Core:        true,
+		Endpoints:   h.Endpoints(),
+		Register:    h.RegisterRoutes,
+	}
 }
@@ -15,7 +15,7 @@ func (r *Repository) Delete(ctx context.Context, institutionId string, id string
 		return err
 	}
 
-	_, err := r.db.Exec(ctx, queryDelete, pgx.NamedArgs{
+	_, err := r.conn(ctx).Exec(ctx, queryDelete, pgx.NamedArgs{
 		"id":             id,
 		"institution_id": institutionId,
 	})
@@ -38,7 +38,7 @@ func (r *Repository) FindAll(ctx context.Context, filter domain.RoleFilter) ([]*
 	// 1. Count Total
 	var total int64
 	countQuery := "SELECT count(id)" + baseQuery
-	if err := r.db.QueryRow(ctx, countQuery, args).Scan(&total); err != nil {
+	if err := r.conn(ctx).QueryRow(ctx, countQuery, args).Scan(&total); err != nil {
 		return nil, 0, err
 	}
 
@@ -51,7 +51,7 @@ func (r *Repository) FindAll(ctx context.Context, filter domain.RoleFilter) ([]*
 	args["limit"] = filter.Pagination.GetLimit()
 	args["offset"] = filter.Pagination.GetOffset()
 
-	rows, err := r.db.Query(ctx, selectQuery, args)
+	rows, err := r.conn(ctx).Query(ctx, selectQuery, args)
 	if err != nil {
 		return nil, 0, err
 	}
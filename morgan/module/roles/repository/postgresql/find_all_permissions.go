@@ -45,7 +45,7 @@ func (r *Repository) FindAllPermissions(ctx context.Context, filter domain.Permi
 
 	sql += " ORDER BY module, sub_module, page, action"
 
-	rows, err := r.db.Query(ctx, sql, args)
+	rows, err := r.conn(ctx).Query(ctx, sql, args)
 	if err != nil {
 		return nil, err
 	}
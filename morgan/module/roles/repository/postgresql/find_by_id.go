@@ -18,7 +18,7 @@ var queryFindById = `
 
 // FindByID retrieves a single role by their ID.
 func (r *Repository) FindByID(ctx context.Context, id string) (*domain.Role, error) {
-	rows, err := r.db.Query(ctx, queryFindById, pgx.NamedArgs{
+	rows, err := r.conn(ctx).Query(ctx, queryFindById, pgx.NamedArgs{
 		"id": id,
 	})
 	if err != nil {
@@ -55,7 +55,7 @@ var queryFindByName = `
 
 // FindByName retrieves a single role by name and institution.
 func (r *Repository) FindByName(ctx context.Context, institutionId string, name string) (*domain.Role, error) {
-	rows, err := r.db.Query(ctx, queryFindByName, pgx.NamedArgs{
+	rows, err := r.conn(ctx).Query(ctx, queryFindByName, pgx.NamedArgs{
 		"institution_id": institutionId,
 		"name":           name,
 	})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/siakup/morgan-be/framework/postgres"
 	"github.com/siakup/morgan-be/morgan/module/roles/domain"
 )
 
@@ -35,6 +36,11 @@ func NewRepository(db *pgxpool.Pool) *Repository {
 	return &Repository{db: db}
 }
 
+// conn returns the transaction bound to ctx, so writes join the usecase's transaction.
+func (r *Repository) conn(ctx context.Context) postgres.Querier {
+	return postgres.Conn(ctx, r.db)
+}
+
 // AddPermissions adds permissions to a role.
 func (r *Repository) AddPermissions(ctx context.Context, roleId string, permissions []string) error {
 	if len(permissions) == 0 {
@@ -43,7 +49,7 @@ func (r *Repository) AddPermissions(ctx context.Context, roleId string, permissi
 
 	// 1. Resolve codes to IDs
 	sql := `SELECT id, code FROM iam.permissions WHERE code = ANY(@codes)`
-	rows, err := r.db.Query(ctx, sql, pgx.NamedArgs{"codes": permissions})
+	rows, err := r.conn(ctx).Query(ctx, sql, pgx.NamedArgs{"codes": permissions})
 	if err != nil {
 		return err
 	}
@@ -81,7 +87,7 @@ func (r *Repository) AddPermissions(ctx context.Context, roleId string, permissi
 		insertRows = append(insertRows, []interface{}{roleId, pid})
 	}
 
-	_, err = r.db.CopyFrom(
+	_, err = r.conn(ctx).CopyFrom(
 		ctx,
 		pgx.Identifier{"iam", "role_permissions"},
 		[]string{"role_id", "permission_id"},
@@ -94,7 +100,7 @@ func (r *Repository) AddPermissions(ctx context.Context, roleId string, permissi
 // RemovePermissions removes all permissions for a role.
 func (r *Repository) RemovePermissions(ctx context.Context, roleId string) error {
 	query := `DELETE FROM iam.role_permissions WHERE role_id = @role_id`
-	_, err := r.db.Exec(ctx, query, pgx.NamedArgs{"role_id": roleId})
+	_, err := r.conn(ctx).Exec(ctx, query, pgx.NamedArgs{"role_id": roleId})
 	return err
 }
 
@@ -106,7 +112,7 @@ func (r *Repository) GetPermissions(ctx context.Context, roleId string) ([]strin
 		JOIN iam.permissions p ON rp.permission_id = p.id 
 		WHERE rp.role_id = @role_id
 	`
-	rows, err := r.db.Query(ctx, query, pgx.NamedArgs{"role_id": roleId})
+	rows, err := r.conn(ctx).Query(ctx, query, pgx.NamedArgs{"role_id": roleId})
 	if err != nil {
 		return nil, err
 	}
@@ -18,7 +18,7 @@ var queryStore = `
 
 // Store persists a new role to the database.
 func (r *Repository) Store(ctx context.Context, role *domain.Role) error {
-	rows, err := r.db.Query(ctx, queryStore, pgx.NamedArgs{
+	rows, err := r.conn(ctx).Query(ctx, queryStore, pgx.NamedArgs{
 		"institution_id": role.InstitutionId,
 		"name":           role.Name,
 		"description":    role.Description,
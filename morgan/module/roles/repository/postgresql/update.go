@@ -19,7 +19,7 @@ var queryUpdate = `
 
 // Update modifies an existing role record.
 func (r *Repository) Update(ctx context.Context, role *domain.Role) error {
-	_, err := r.db.Exec(ctx, queryUpdate, pgx.NamedArgs{
+	_, err := r.conn(ctx).Exec(ctx, queryUpdate, pgx.NamedArgs{
 		"id":             role.Id,
 		"institution_id": role.InstitutionId,
 		"name":           role.Name,
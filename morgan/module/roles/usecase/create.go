@@ -6,7 +6,9 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/events"
 	"github.com/siakup/morgan-be/morgan/module/roles/domain"
 )
 
@@ -27,7 +29,24 @@ func (u *UseCase) Create(ctx context.Context, role *domain.Role) error {
 		return errors.BadRequest("role name already exists in this institution")
 	}
 
-	if err := u.repository.Store(ctx, role); err != nil {
+	// The role and its role.created event commit together; the outbox relay publishes the event.
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.Store(ctx, role); err != nil {
+			return err
+		}
+
+		if err := u.recorder.Record(ctx, audit.Entry{Entity: entityRole, EntityId: role.Id, Action: audit.ActionCreate, After: role}); err != nil {
+			return err
+		}
+
+		return events.Emit(ctx, u.publisher, role.InstitutionId, events.RoleCreated{
+			RoleId:      role.Id,
+			Name:        role.Name,
+			Description: role.Description,
+			IsActive:    role.IsActive,
+			Permissions: role.Permissions,
+		})
+	}); err != nil {
 		logger.Error().
 			Str("func", "repository.Store").
 			Err(err).
@@ -2,9 +2,13 @@ package usecase
 
 import (
 	"context"
+	errs "errors"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/events"
 )
 
 // Delete removes a role from the system.
@@ -14,7 +18,25 @@ func (u *UseCase) Delete(ctx context.Context, institutionId string, id string) e
 
 	logger := zerolog.Ctx(ctx)
 
-	if err := u.repository.Delete(ctx, institutionId, id); err != nil {
+	current, err := u.repository.FindByID(ctx, id)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return errors.NotFound("role not found")
+		}
+		return errors.InternalServerError("failed to find role")
+	}
+
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.Delete(ctx, institutionId, id); err != nil {
+			return err
+		}
+
+		if err := u.recorder.Record(ctx, audit.Entry{Entity: entityRole, EntityId: id, Action: audit.ActionDelete, Before: current}); err != nil {
+			return err
+		}
+
+		return events.Emit(ctx, u.publisher, institutionId, events.RoleDeleted{RoleId: id})
+	}); err != nil {
 		logger.Error().
 			Str("func", "repository.Delete").
 			Err(err).
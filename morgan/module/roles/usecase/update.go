@@ -6,7 +6,9 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/events"
 	"github.com/siakup/morgan-be/morgan/module/roles/domain"
 )
 
@@ -37,7 +39,35 @@ func (u *UseCase) Update(ctx context.Context, role *domain.Role) error {
 		}
 	}
 
-	if err := u.repository.Update(ctx, role); err != nil {
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.Update(ctx, role); err != nil {
+			return err
+		}
+
+		if err := u.recorder.Record(ctx, audit.Entry{Entity: entityRole, EntityId: role.Id, Action: audit.ActionUpdate, Before: current, After: role}); err != nil {
+			return err
+		}
+
+		if err := events.Emit(ctx, u.publisher, role.InstitutionId, events.RoleUpdated{
+			RoleId:      role.Id,
+			Name:        role.Name,
+			Description: role.Description,
+			IsActive:    role.IsActive,
+		}); err != nil {
+			return err
+		}
+
+		added, removed := events.PermissionDiff(current.Permissions, role.Permissions)
+		if len(added) == 0 && len(removed) == 0 {
+			return nil
+		}
+		return events.Emit(ctx, u.publisher, role.InstitutionId, events.RolePermissionsChanged{
+			RoleId:      role.Id,
+			Added:       added,
+			Removed:     removed,
+			Permissions: role.Permissions,
+		})
+	}); err != nil {
 		logger.Error().
 			Str("func", "repository.Update").
 			Err(err).
@@ -11,6 +11,9 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"github.com/siakup/morgan-be/framework/postgres"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/events"
 	"github.com/siakup/morgan-be/morgan/module/roles/domain"
 )
 
@@ -19,13 +22,22 @@ var _ domain.UseCase = (*UseCase)(nil)
 // UseCase implements the logic for roles management.
 type UseCase struct {
 	repository domain.RoleRepository
+	tx         postgres.Transactor
+	recorder   audit.Recorder
+	publisher  events.Publisher
 	tracer     trace.Tracer
 }
 
 // NewUseCase creates a new instance of Roles UseCase.
-func NewUseCase(repository domain.RoleRepository) *UseCase {
+func NewUseCase(repository domain.RoleRepository, tx postgres.Transactor, recorder audit.Recorder, publisher events.Publisher) *UseCase {
 	return &UseCase{
 		repository: repository,
+		tx:         tx,
+		recorder:   recorder,
+		publisher:  publisher,
 		tracer:     otel.Tracer("roles"),
 	}
 }
+
+// entityRole is the audit entity name of a role.
+const entityRole = "role"
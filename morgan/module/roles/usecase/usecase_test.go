@@ -8,6 +8,8 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/siakup/morgan-be/libraries/events"
+	"github.com/siakup/morgan-be/libraries/publisher"
 	"github.com/siakup/morgan-be/morgan/module/roles/domain"
 	"github.com/siakup/morgan-be/morgan/module/roles/usecase"
 	"github.com/siakup/morgan-be/morgan/tests/mocks"
@@ -15,7 +17,11 @@ import (
 
 func TestUseCase_Roles(t *testing.T) {
 	mockRepo := new(mocks.RolesRepositoryMock)
-	uc := usecase.NewUseCase(mockRepo)
+	mockRecorder := new(mocks.AuditRecorderMock)
+	mockRecorder.On("Record", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockPublisher := new(mocks.EventPublisherMock)
+	mockPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	uc := usecase.NewUseCase(mockRepo, new(mocks.TransactorMock), mockRecorder, mockPublisher)
 
 	t.Run("FindAll", func(t *testing.T) {
 		ctx := context.Background()
@@ -80,6 +86,12 @@ func TestUseCase_Roles(t *testing.T) {
 		assert.NoError(t, err)
 
 		mockRepo.AssertExpectations(t)
+		mockPublisher.AssertCalled(t, "Publish", mock.Anything, mock.MatchedBy(func(e publisher.Event) bool {
+			event, err := events.Decode[events.RolePermissionsChanged](e.Body())
+			return err == nil && event.InstitutionId == "inst-1" &&
+				assert.ObjectsAreEqual([]string{"p2"}, event.Data.Added) &&
+				assert.ObjectsAreEqual([]string{"p1"}, event.Data.Removed)
+		}))
 	})
 
 	t.Run("Delete", func(t *testing.T) {
@@ -87,6 +99,7 @@ func TestUseCase_Roles(t *testing.T) {
 		instId := "inst-1"
 		id := "r1"
 
+		mockRepo.On("FindByID", mock.Anything, id).Return(&domain.Role{Id: id, InstitutionId: instId}, nil).Once()
 		mockRepo.On("Delete", mock.Anything, instId, id).Return(nil).Once()
 
 		err := uc.Delete(ctx, instId, id)
@@ -138,12 +151,22 @@ func TestUseCase_Roles(t *testing.T) {
 
 	t.Run("Delete_Error", func(t *testing.T) {
 		ctx := context.Background()
+		mockRepo.On("FindByID", mock.Anything, "r1").Return(&domain.Role{Id: "r1"}, nil).Once()
 		mockRepo.On("Delete", mock.Anything, "inst-1", "r1").Return(errors.New("fail")).Once()
 		err := uc.Delete(ctx, "inst-1", "r1")
 		assert.Error(t, err)
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("Delete_NotFound", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepo.On("FindByID", mock.Anything, "r1").Return((*domain.Role)(nil), pgx.ErrNoRows).Once()
+		err := uc.Delete(ctx, "inst-1", "r1")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+		mockRepo.AssertExpectations(t)
+	})
+
 	t.Run("FindAll_Error", func(t *testing.T) {
 		ctx := context.Background()
 		mockRepo.On("FindAll", mock.Anything, mock.Anything).Return(([]*domain.Role)(nil), int64(0), errors.New("fail")).Once()
@@ -172,3 +195,47 @@ func TestUseCase_Roles(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestUseCase_Roles_EventNotStored(t *testing.T) {
+	mockRepo := new(mocks.RolesRepositoryMock)
+	mockRecorder := new(mocks.AuditRecorderMock)
+	mockPublisher := new(mocks.EventPublisherMock)
+	uc := usecase.NewUseCase(mockRepo, new(mocks.TransactorMock), mockRecorder, mockPublisher)
+
+	ctx := context.Background()
+	role := &domain.Role{InstitutionId: "inst-1", Name: "Role"}
+
+	mockRepo.On("FindByName", mock.Anything, role.InstitutionId, role.Name).Return((*domain.Role)(nil), pgx.ErrNoRows).Once()
+	mockRepo.On("Store", mock.Anything, role).Return(nil).Once()
+	mockRecorder.On("Record", mock.Anything, mock.Anything).Return(nil).Once()
+	mockPublisher.On("Publish", mock.Anything, mock.Anything).Return(errors.New("outbox unavailable")).Once()
+
+	// The audit entry shares the transaction, so it is rolled back with the role
+	err := uc.Create(ctx, role)
+	assert.Error(t, err)
+
+	mockRepo.AssertExpectations(t)
+	mockRecorder.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestUseCase_Roles_AuditNotStored(t *testing.T) {
+	mockRepo := new(mocks.RolesRepositoryMock)
+	mockRecorder := new(mocks.AuditRecorderMock)
+	mockPublisher := new(mocks.EventPublisherMock)
+	uc := usecase.NewUseCase(mockRepo, new(mocks.TransactorMock), mockRecorder, mockPublisher)
+
+	ctx := context.Background()
+	role := &domain.Role{InstitutionId: "inst-1", Name: "Role"}
+
+	mockRepo.On("FindByName", mock.Anything, role.InstitutionId, role.Name).Return((*domain.Role)(nil), pgx.ErrNoRows).Once()
+	mockRepo.On("Store", mock.Anything, role).Return(nil).Once()
+	mockRecorder.On("Record", mock.Anything, mock.Anything).Return(errors.New("audit unavailable")).Once()
+
+	err := uc.Create(ctx, role)
+	assert.Error(t, err)
+
+	mockRepo.AssertExpectations(t)
+	mockRecorder.AssertExpectations(t)
+	mockPublisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/siakup/morgan-be/libraries/middleware"
@@ -12,8 +13,12 @@ import (
 )
 
 type CreateSeverityLevelRequest struct {
-	Name   string `json:"name" validate:"required"`
-	Status bool   `json:"status"`
+	Name                 string  `json:"name" validate:"required"`
+	Status               bool    `json:"status"`
+	ResponseSLAMinutes   int     `json:"response_sla_minutes" validate:"min=0"`
+	ResolutionSLAMinutes int     `json:"resolution_sla_minutes" validate:"min=0"`
+	Color                *string `json:"color"`
+	Icon                 *string `json:"icon"`
 }
 
 func (h *SeverityLevelHandler) CreateSeverityLevel(c *fiber.Ctx) error {
@@ -26,16 +31,20 @@ func (h *SeverityLevelHandler) CreateSeverityLevel(c *fiber.Ctx) error {
 	userId, _ := c.Locals(middleware.XUserIdKey).(string)
 
 	severityLevel := domain.SeverityLevel{
-		Name:      req.Name,
-		Status:    req.Status,
-		CreatedBy: &userId,
-		UpdatedBy: &userId,
+		Name:          req.Name,
+		Status:        req.Status,
+		ResponseSLA:   time.Duration(req.ResponseSLAMinutes) * time.Minute,
+		ResolutionSLA: time.Duration(req.ResolutionSLAMinutes) * time.Minute,
+		Color:         req.Color,
+		Icon:          req.Icon,
+		CreatedBy:     &userId,
+		UpdatedBy:     &userId,
 	}
 
-	err := h.useCase.Create(c.Context(), &severityLevel)
+	err := h.useCase.Create(c.UserContext(), &severityLevel)
 	if err != nil {
 		return h.handleError(c, err)
 	}
 
-	return c.Status(http.StatusCreated).JSON(responses.Success(severityLevel, "Severity Level created"))
+	return c.Status(http.StatusCreated).JSON(responses.Success(toResponse(&severityLevel), "Severity Level created"))
 }
@@ -19,11 +19,16 @@ func (h *SeverityLevelHandler) GetSeverityLevels(c *fiber.Ctx) error {
 		Search: c.Query("search"),
 	}
 
-	severityLevels, total, err := h.useCase.FindAll(c.Context(), filter)
+	severityLevels, total, err := h.useCase.FindAll(c.UserContext(), filter)
 	if err != nil {
 		return h.handleError(c, err)
 	}
 
+	result := make([]SeverityLevelResponse, len(severityLevels))
+	for i, sl := range severityLevels {
+		result[i] = toResponse(sl)
+	}
+
 	meta := &responses.Meta{
 		Page:       page,
 		Size:       size,
@@ -31,5 +36,5 @@ func (h *SeverityLevelHandler) GetSeverityLevels(c *fiber.Ctx) error {
 		TotalPages: (int(total) + size - 1) / size,
 	}
 
-	return c.JSON(responses.SuccessWithMeta(severityLevels, "Severity Levels retrieved", meta))
+	return c.JSON(responses.SuccessWithMeta(result, "Severity Levels retrieved", meta))
 }
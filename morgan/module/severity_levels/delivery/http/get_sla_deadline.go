@@ -0,0 +1,42 @@
+package http
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/responses"
+)
+
+type SLADeadlineResponse struct {
+	SeverityLevelId string     `json:"severity_level_id"`
+	StartAt         time.Time  `json:"start_at"`
+	ResponseDueAt   *time.Time `json:"response_due_at"`
+	ResolutionDueAt *time.Time `json:"resolution_due_at"`
+}
+
+// GetSLADeadline handles GET /severity-levels/:id/sla-deadline?start=RFC3339. start defaults to now.
+func (h *SeverityLevelHandler) GetSLADeadline(c *fiber.Ctx) error {
+	id := c.Params("id")
+
+	start := time.Now()
+	if raw := c.Query("start"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return h.handleError(c, errors.BadRequest("start must be an RFC3339 timestamp"))
+		}
+		start = parsed
+	}
+
+	deadline, err := h.useCase.SLADeadline(c.UserContext(), id, start)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(responses.Success(SLADeadlineResponse{
+		SeverityLevelId: deadline.SeverityLevelId,
+		StartAt:         deadline.StartAt,
+		ResponseDueAt:   deadline.ResponseDueAt,
+		ResolutionDueAt: deadline.ResolutionDueAt,
+	}, "SLA deadline retrieved"))
+}
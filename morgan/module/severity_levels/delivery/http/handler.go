@@ -1,16 +1,24 @@
 package http
 
 import (
-	"net/http"
-
 	"github.com/gofiber/fiber/v2"
-	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/openapi"
 	"github.com/siakup/morgan-be/libraries/responses"
-	"github.com/siakup/morgan-be/libraries/validation"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
 )
 
+// Permission codes guarding the severity_levels routes.
+const (
+	PermissionView   = "severity_levels.hr.severity_levels.view"
+	PermissionCreate = "severity_levels.hr.severity_levels.create"
+	PermissionEdit   = "severity_levels.hr.severity_levels.edit"
+	PermissionDelete = "severity_levels.hr.severity_levels.delete"
+)
+
+// Permissions lists every permission code required by the severity_levels routes.
+var Permissions = []string{PermissionView, PermissionCreate, PermissionEdit, PermissionDelete}
+
 // SeverityLevelHandler handles HTTP requests for severity levels module.
 type SeverityLevelHandler struct {
 	useCase domain.UseCase
@@ -26,22 +34,12 @@ func NewSeverityLevelHandler(useCase domain.UseCase, auth *middleware.Authorizat
 }
 
 // RegisterRoutes registers the routes for the severity levels module.
-func (h *SeverityLevelHandler) RegisterRoutes(app *fiber.App) {
-	group := app.Group("/severity-levels", middleware.TraceMiddleware)
-
-	// Adjust permissions as needed
-	group.Get("/", h.auth.Authenticate("severity_levels.view"), h.GetSeverityLevels)
-	group.Get(":id", h.auth.Authenticate("severity_levels.view"), h.GetSeverityLevelByID)
-	group.Post("/", h.auth.Authenticate("severity_levels.edit"), validation.ValidateBody(func() interface{} { return &CreateSeverityLevelRequest{} }), h.CreateSeverityLevel)
-	group.Put(":id", h.auth.Authenticate("severity_levels.edit"), validation.ValidateBody(func() interface{} { return &UpdateSeverityLevelRequest{} }), h.UpdateSeverityLevel)
-	group.Delete(":id", h.auth.Authenticate("severity_levels.edit"), h.DeleteSeverityLevel)
+func (h *SeverityLevelHandler) RegisterRoutes(router fiber.Router) {
+	router.Use("/severity-levels", middleware.TraceMiddleware)
+	openapi.Mount(router, h.auth.Authenticate, h.Endpoints())
 }
 
-// handleError handles errors by mapping them to standardized responses.
+// handleError renders err with the standard error envelope.
 func (h *SeverityLevelHandler) handleError(c *fiber.Ctx, err error) error {
-	if appErr, ok := err.(*errors.AppError); ok {
-		return c.Status(appErr.Code).JSON(responses.Fail(string(appErr.Type), appErr.Message))
-	}
-
-	return c.Status(http.StatusInternalServerError).JSON(responses.Fail("SYSTEM_ERROR", err.Error()))
+	return responses.HandleError(c, err)
 }
@@ -7,11 +7,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/validation"
 	deliverhttp "github.com/siakup/morgan-be/morgan/module/severity_levels/delivery/http"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
 	"github.com/siakup/morgan-be/morgan/tests/mocks"
@@ -28,9 +30,11 @@ func setupSeverityLevelApp(useCase domain.UseCase) *fiber.App {
 	})
 
 	app.Get("/severity-levels", handler.GetSeverityLevels)
+	app.Put("/severity-levels/order", validation.ValidateBody(func() interface{} { return &deliverhttp.ReorderSeverityLevelsRequest{} }), handler.ReorderSeverityLevels)
 	app.Get("/severity-levels/:id", handler.GetSeverityLevelByID)
-	app.Post("/severity-levels", handler.CreateSeverityLevel)
-	app.Put("/severity-levels/:id", handler.UpdateSeverityLevel)
+	app.Get("/severity-levels/:id/sla-deadline", handler.GetSLADeadline)
+	app.Post("/severity-levels", validation.ValidateBody(func() interface{} { return &deliverhttp.CreateSeverityLevelRequest{} }), handler.CreateSeverityLevel)
+	app.Put("/severity-levels/:id", validation.ValidateBody(func() interface{} { return &deliverhttp.UpdateSeverityLevelRequest{} }), handler.UpdateSeverityLevel)
 	app.Delete("/severity-levels/:id", handler.DeleteSeverityLevel)
 
 	return app
@@ -219,3 +223,66 @@ func TestSeverityLevelHandler_DeleteSeverityLevel(t *testing.T) {
 		mockUseCase.AssertExpectations(t)
 	})
 }
+
+func TestSeverityLevelHandler_ReorderSeverityLevels(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockUseCase := new(mocks.SeverityLevelsUseCaseMock)
+		app := setupSeverityLevelApp(mockUseCase)
+
+		reqBytes, _ := json.Marshal(map[string]interface{}{"ids": []string{"sl2", "sl1"}})
+
+		mockUseCase.On("Reorder", mock.Anything, []string{"sl2", "sl1"}, "admin-user").Return(nil).Once()
+
+		req := httptest.NewRequest(http.MethodPut, "/severity-levels/order", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("BadRequest", func(t *testing.T) {
+		mockUseCase := new(mocks.SeverityLevelsUseCaseMock)
+		app := setupSeverityLevelApp(mockUseCase)
+
+		reqBytes, _ := json.Marshal(map[string]interface{}{"ids": []string{}})
+
+		req := httptest.NewRequest(http.MethodPut, "/severity-levels/order", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestSeverityLevelHandler_GetSLADeadline(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockUseCase := new(mocks.SeverityLevelsUseCaseMock)
+		app := setupSeverityLevelApp(mockUseCase)
+
+		start := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+		response, resolution := start.Add(time.Hour), start.Add(8*time.Hour)
+		deadline := &domain.SLADeadline{SeverityLevelId: "sl1", StartAt: start, ResponseDueAt: &response, ResolutionDueAt: &resolution}
+		mockUseCase.On("SLADeadline", mock.Anything, "sl1", start).Return(deadline, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/severity-levels/sl1/sla-deadline?start=2026-01-01T08:00:00Z", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidStart", func(t *testing.T) {
+		mockUseCase := new(mocks.SeverityLevelsUseCaseMock)
+		app := setupSeverityLevelApp(mockUseCase)
+
+		req := httptest.NewRequest(http.MethodGet, "/severity-levels/sl1/sla-deadline?start=yesterday", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
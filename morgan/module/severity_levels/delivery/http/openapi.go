@@ -0,0 +1,34 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"github.com/siakup/morgan-be/libraries/validation"
+)
+
+type (
+	listQuery struct {
+		Page   int    `query:"page" default:"1"`
+		Size   int    `query:"size" default:"10"`
+		Search string `query:"search"`
+	}
+
+	slaDeadlineQuery struct {
+		Start string `query:"start" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" doc:"Start of the SLA, now by default"`
+	}
+)
+
+// Endpoints lists the severity levels routes: RegisterRoutes mounts them and the OpenAPI document describes them.
+func (h *SeverityLevelHandler) Endpoints() []openapi.Endpoint {
+	return []openapi.Endpoint{
+		{Method: fiber.MethodGet, Path: "/severity-levels", Summary: "List severity levels", Permissions: []string{PermissionView}, Query: listQuery{}, Response: []SeverityLevelResponse{}, Paginated: true, Handlers: []fiber.Handler{h.GetSeverityLevels}},
+		{Method: fiber.MethodPut, Path: "/severity-levels/order", Summary: "Reorder severity levels", Permissions: []string{PermissionEdit}, Request: ReorderSeverityLevelsRequest{}, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &ReorderSeverityLevelsRequest{} }), h.ReorderSeverityLevels}},
+		{Method: fiber.MethodGet, Path: "/severity-levels/:id", Summary: "Get a severity level", Permissions: []string{PermissionView}, Response: SeverityLevelResponse{}, Handlers: []fiber.Handler{h.GetSeverityLevelByID}},
+		{Method: fiber.MethodGet, Path: "/severity-levels/:id/sla-deadline", Summary: "Compute the SLA deadline", Permissions: []string{PermissionView}, Query: slaDeadlineQuery{}, Response: SLADeadlineResponse{}, Handlers: []fiber.Handler{h.GetSLADeadline}},
+		{Method: fiber.MethodPost, Path: "/severity-levels", Summary: "Create a severity level", Permissions: []string{PermissionCreate}, Request: CreateSeverityLevelRequest{}, Response: SeverityLevelResponse{}, Status: http.StatusCreated, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &CreateSeverityLevelRequest{} }), h.CreateSeverityLevel}},
+		{Method: fiber.MethodPut, Path: "/severity-levels/:id", Summary: "Update a severity level", Permissions: []string{PermissionEdit}, Request: UpdateSeverityLevelRequest{}, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &UpdateSeverityLevelRequest{} }), h.UpdateSeverityLevel}},
+		{Method: fiber.MethodDelete, Path: "/severity-levels/:id", Summary: "Delete a severity level", Permissions: []string{PermissionDelete}, Handlers: []fiber.Handler{h.DeleteSeverityLevel}},
+	}
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/validation"
+)
+
+// ReorderSeverityLevelsRequest lists every severity level id from most to least severe.
+type ReorderSeverityLevelsRequest struct {
+	Ids []string `json:"ids" validate:"required,min=1"`
+}
+
+func (h *SeverityLevelHandler) ReorderSeverityLevels(c *fiber.Ctx) error {
+	raw := c.Locals(validation.ValidatedBodyKey)
+	req, ok := raw.(*ReorderSeverityLevelsRequest)
+	if !ok || req == nil {
+		return h.handleError(c, errors.BadRequest("invalid request body"))
+	}
+
+	userId, _ := c.Locals(middleware.XUserIdKey).(string)
+
+	if err := h.useCase.Reorder(c.UserContext(), req.Ids, userId); err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(responses.Success[any](nil, "Severity Levels reordered"))
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"time"
+
+	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
+)
+
+// SeverityLevelResponse is the JSON representation of a severity level. SLA durations are in minutes.
+type SeverityLevelResponse struct {
+	Id                   string    `json:"id"`
+	Name                 string    `json:"name"`
+	Status               bool      `json:"status"`
+	Rank                 int       `json:"rank"`
+	ResponseSLAMinutes   int       `json:"response_sla_minutes"`
+	ResolutionSLAMinutes int       `json:"resolution_sla_minutes"`
+	Color                *string   `json:"color"`
+	Icon                 *string   `json:"icon"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+func toResponse(sl *domain.SeverityLevel) SeverityLevelResponse {
+	return SeverityLevelResponse{
+		Id:                   sl.Id,
+		Name:                 sl.Name,
+		Status:               sl.Status,
+		Rank:                 sl.Rank,
+		ResponseSLAMinutes:   int(sl.ResponseSLA / time.Minute),
+		ResolutionSLAMinutes: int(sl.ResolutionSLA / time.Minute),
+		Color:                sl.Color,
+		Icon:                 sl.Icon,
+		CreatedAt:            sl.CreatedAt,
+		UpdatedAt:            sl.UpdatedAt,
+	}
+}
@@ -1,6 +1,8 @@
 package http
 
 import (
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/siakup/morgan-be/libraries/middleware"
 	"github.com/siakup/morgan-be/libraries/responses"
@@ -10,8 +12,12 @@ import (
 )
 
 type UpdateSeverityLevelRequest struct {
-	Name   string `json:"name" validate:"required"`
-	Status bool   `json:"status"`
+	Name                 string  `json:"name" validate:"required"`
+	Status               bool    `json:"status"`
+	ResponseSLAMinutes   int     `json:"response_sla_minutes" validate:"min=0"`
+	ResolutionSLAMinutes int     `json:"resolution_sla_minutes" validate:"min=0"`
+	Color                *string `json:"color"`
+	Icon                 *string `json:"icon"`
 }
 
 func (h *SeverityLevelHandler) UpdateSeverityLevel(c *fiber.Ctx) error {
@@ -25,13 +31,17 @@ func (h *SeverityLevelHandler) UpdateSeverityLevel(c *fiber.Ctx) error {
 	userId, _ := c.Locals(middleware.XUserIdKey).(string)
 
 	severityLevel := domain.SeverityLevel{
-		Id:        id,
-		Name:      req.Name,
-		Status:    req.Status,
-		UpdatedBy: &userId,
+		Id:            id,
+		Name:          req.Name,
+		Status:        req.Status,
+		ResponseSLA:   time.Duration(req.ResponseSLAMinutes) * time.Minute,
+		ResolutionSLA: time.Duration(req.ResolutionSLAMinutes) * time.Minute,
+		Color:         req.Color,
+		Icon:          req.Icon,
+		UpdatedBy:     &userId,
 	}
 
-	err := h.useCase.Update(c.Context(), &severityLevel)
+	err := h.useCase.Update(c.UserContext(), &severityLevel)
 	if err != nil {
 		return h.handleError(c, err)
 	}
@@ -2,24 +2,61 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/siakup/morgan-be/libraries/types"
 )
 
 // SeverityLevel represents the domain object for a Severity Level.
+// Rank orders levels by severity, 1 being the most severe; ranks are unique among active levels.
 type SeverityLevel struct {
-	Id        string     `object:"id"`
-	Name      string     `object:"name"`
-	Status    bool       `object:"status"`
-	CreatedAt time.Time  `object:"created_at"`
-	CreatedBy *string    `object:"created_by"`
-	UpdatedAt time.Time  `object:"updated_at"`
-	UpdatedBy *string    `object:"updated_by"`
-	DeletedAt *time.Time `object:"deleted_at"`
-	DeletedBy *string    `object:"deleted_by"`
+	Id            string        `object:"id"`
+	Name          string        `object:"name"`
+	Status        bool          `object:"status"`
+	Rank          int           `object:"rank"`
+	ResponseSLA   time.Duration `object:"response_sla"`
+	ResolutionSLA time.Duration `object:"resolution_sla"`
+	Color         *string       `object:"color"`
+	Icon          *string       `object:"icon"`
+	CreatedAt     time.Time     `object:"created_at"`
+	CreatedBy     *string       `object:"created_by"`
+	UpdatedAt     time.Time     `object:"updated_at"`
+	UpdatedBy     *string       `object:"updated_by"`
+	DeletedAt     *time.Time    `object:"deleted_at"`
+	DeletedBy     *string       `object:"deleted_by"`
 }
 
+// SLADeadline holds the response and resolution due times of a severity level for a start time.
+// A due time is nil when the level has no SLA for it.
+type SLADeadline struct {
+	SeverityLevelId string
+	StartAt         time.Time
+	ResponseDueAt   *time.Time
+	ResolutionDueAt *time.Time
+}
+
+// Deadline computes the SLA due times counted from start. A zero SLA means no deadline.
+func (s *SeverityLevel) Deadline(start time.Time) SLADeadline {
+	return SLADeadline{
+		SeverityLevelId: s.Id,
+		StartAt:         start,
+		ResponseDueAt:   dueAt(start, s.ResponseSLA),
+		ResolutionDueAt: dueAt(start, s.ResolutionSLA),
+	}
+}
+
+func dueAt(start time.Time, sla time.Duration) *time.Time {
+	if sla <= 0 {
+		return nil
+	}
+	due := start.Add(sla)
+	return &due
+}
+
+// ErrRankTaken is returned by Store when another active severity level already holds the rank.
+var ErrRankTaken = errors.New("severity level rank is already taken")
+
 // SeverityLevelFilter represents the filter options for fetching severity levels.
 type SeverityLevelFilter struct {
 	types.Pagination
@@ -30,8 +67,17 @@ type SeverityLevelFilter struct {
 type SeverityLevelRepository interface {
 	FindAll(ctx context.Context, filter SeverityLevelFilter) ([]*SeverityLevel, int64, error)
 	FindByID(ctx context.Context, id string) (*SeverityLevel, error)
+	// Store persists a new severity level. It returns ErrRankTaken when its rank is in use.
 	Store(ctx context.Context, severityLevel *SeverityLevel) error
 	Update(ctx context.Context, severityLevel *SeverityLevel) error
 	// Delete removes a severity level from storage.
 	Delete(ctx context.Context, id string, deletedBy string) error
+	// FindActiveIDs returns the ids of all non-deleted severity levels ordered by rank,
+	// locking them until the caller's transaction ends.
+	FindActiveIDs(ctx context.Context) ([]string, error)
+	// NextRank returns the rank following the least severe active level, locking that level
+	// until the caller's transaction ends.
+	NextRank(ctx context.Context) (int, error)
+	// Reorder assigns rank i+1 to ids[i]; callers run it inside a transaction.
+	Reorder(ctx context.Context, ids []string, updatedBy string) error
 }
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // UseCase defines the business logic for severity levels.
@@ -11,4 +12,8 @@ type UseCase interface {
 	Create(ctx context.Context, severityLevel *SeverityLevel) error
 	Update(ctx context.Context, severityLevel *SeverityLevel) error
 	Delete(ctx context.Context, id string, deletedBy string) error
+	// Reorder ranks the given ids from most to least severe. It must list every active level once.
+	Reorder(ctx context.Context, ids []string, updatedBy string) error
+	// SLADeadline returns the SLA due times of a severity level for the given start time.
+	SLADeadline(ctx context.Context, id string, start time.Time) (*SLADeadline, error)
 }
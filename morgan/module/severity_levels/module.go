@@ -1,12 +1,13 @@
 package severity_levels
 
 import (
-	gofiber "github.com/gofiber/fiber/v2"
-	"go.uber.org/fx"
+	"github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/registry"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/delivery/http"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/repository/postgresql"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/usecase"
+	"go.uber.org/fx"
 )
 
 // Module exports the severity_levels module for Fx.
@@ -24,9 +25,16 @@ var Module = fx.Options(
 		),
 		http.NewSeverityLevelHandler,
 	),
-	fx.Invoke(registerRoutes),
+	registry.Provide(newModule),
 )
 
-func registerRoutes(h *http.SeverityLevelHandler, app *gofiber.App) {
-	h.RegisterRoutes(app)
+func newModule(h *http.SeverityLevelHandler) registry.Module {
+	return registry.Module{
+		Name:        "severity_levels",
+		Version:     fiber.V1,
+		BasePath:    "/severity-levels",
+		Permissions: http.Permissions,
+		Endpoints:   h.Endpoints(),
+		Register:    h.RegisterRoutes,
+	}
 }
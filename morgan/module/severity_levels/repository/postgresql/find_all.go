@@ -24,14 +24,14 @@ func (r *Repository) FindAll(ctx context.Context, filter domain.SeverityLevelFil
 		whereClause = "WHERE " + strings.Join(where, " AND ")
 	}
 
-	countQuery := "SELECT COUNT(*) FROM hr.severity_levels " + whereClause
+	countQuery := "SELECT COUNT(*) FROM master.severity_levels " + whereClause
 	var total int64
 	err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	query := "SELECT id, name, status, created_at, created_by, updated_at, updated_by FROM hr.severity_levels " + whereClause + " ORDER BY created_at DESC LIMIT $" + fmt.Sprint(len(args)+1) + " OFFSET $" + fmt.Sprint(len(args)+2)
+	query := "SELECT " + selectColumns + " FROM master.severity_levels " + whereClause + " ORDER BY rank ASC LIMIT $" + fmt.Sprint(len(args)+1) + " OFFSET $" + fmt.Sprint(len(args)+2)
 	args = append(args, filter.GetLimit(), filter.GetOffset())
 
 	rows, err := r.db.Query(ctx, query, args...)
@@ -42,19 +42,14 @@ func (r *Repository) FindAll(ctx context.Context, filter domain.SeverityLevelFil
 
 	var severityLevels []*domain.SeverityLevel
 	for rows.Next() {
-		var e SeverityLevelEntity
-		if err := rows.Scan(&e.Id, &e.Name, &e.Status, &e.CreatedAt, &e.CreatedBy, &e.UpdatedAt, &e.UpdatedBy); err != nil {
+		severityLevel, err := scanSeverityLevel(rows)
+		if err != nil {
 			return nil, 0, err
 		}
-		severityLevels = append(severityLevels, &domain.SeverityLevel{
-			Id:        e.Id,
-			Name:      e.Name,
-			Status:    e.Status,
-			CreatedAt: e.CreatedAt,
-			CreatedBy: nullStringToPointer(e.CreatedBy),
-			UpdatedAt: e.UpdatedAt,
-			UpdatedBy: nullStringToPointer(e.UpdatedBy),
-		})
+		severityLevels = append(severityLevels, severityLevel)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
 	}
 
 	return severityLevels, total, nil
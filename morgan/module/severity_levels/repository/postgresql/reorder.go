@@ -0,0 +1,63 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// FindActiveIDs returns the ids of all non-deleted severity levels ordered by rank.
+// The rows stay locked until the caller's transaction ends, so concurrent reorders run one after the other.
+func (r *Repository) FindActiveIDs(ctx context.Context) ([]string, error) {
+	rows, err := r.conn(ctx).Query(ctx, "SELECT id FROM master.severity_levels WHERE deleted_at IS NULL ORDER BY rank ASC FOR UPDATE")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// NextRank returns the rank following the least severe active level, which stays locked until the
+// caller's transaction ends. Concurrent creates may still compute the same rank; Store then reports
+// domain.ErrRankTaken.
+func (r *Repository) NextRank(ctx context.Context) (int, error) {
+	var rank int
+	err := r.conn(ctx).QueryRow(ctx, "SELECT rank FROM master.severity_levels WHERE deleted_at IS NULL ORDER BY rank DESC LIMIT 1 FOR UPDATE").Scan(&rank)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 1, nil
+	}
+	return rank + 1, err
+}
+
+// Reorder assigns rank i+1 to ids[i]. It must run within the caller's transaction.
+// Ranks are first shifted above the current maximum so the unique rank index is never violated mid-way.
+func (r *Repository) Reorder(ctx context.Context, ids []string, updatedBy string) error {
+	shift := `
+		UPDATE master.severity_levels
+		SET rank = rank + (SELECT MAX(rank) FROM master.severity_levels WHERE deleted_at IS NULL)
+		WHERE deleted_at IS NULL
+	`
+	if _, err := r.conn(ctx).Exec(ctx, shift); err != nil {
+		return err
+	}
+
+	assign := `
+		UPDATE master.severity_levels sl
+		SET rank = o.rank, updated_at = NOW(), updated_by = $2
+		FROM unnest($1::uuid[]) WITH ORDINALITY AS o(id, rank)
+		WHERE sl.id = o.id AND sl.deleted_at IS NULL
+	`
+	_, err := r.conn(ctx).Exec(ctx, assign, ids, updatedBy)
+	return err
+}
@@ -1,10 +1,13 @@
 package postgresql
 
 import (
+	"context"
 	"database/sql"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/siakup/morgan-be/framework/postgres"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
 )
 
@@ -12,15 +15,46 @@ var _ domain.SeverityLevelRepository = (*Repository)(nil)
 
 // SeverityLevelEntity represents the schema in the database.
 type SeverityLevelEntity struct {
-	Id        string         `db:"id"`
-	Name      string         `db:"name"`
-	Status    bool           `db:"status"`
-	CreatedAt time.Time      `db:"created_at"`
-	CreatedBy sql.NullString `db:"created_by"`
-	UpdatedAt time.Time      `db:"updated_at"`
-	UpdatedBy sql.NullString `db:"updated_by"`
-	DeletedAt sql.NullTime   `db:"deleted_at"`
-	DeletedBy sql.NullString `db:"deleted_by"`
+	Id                   string         `db:"id"`
+	Name                 string         `db:"name"`
+	Status               bool           `db:"status"`
+	Rank                 int            `db:"rank"`
+	ResponseSLAMinutes   int            `db:"response_sla_minutes"`
+	ResolutionSLAMinutes int            `db:"resolution_sla_minutes"`
+	Color                sql.NullString `db:"color"`
+	Icon                 sql.NullString `db:"icon"`
+	CreatedAt            time.Time      `db:"created_at"`
+	CreatedBy            sql.NullString `db:"created_by"`
+	UpdatedAt            time.Time      `db:"updated_at"`
+	UpdatedBy            sql.NullString `db:"updated_by"`
+	DeletedAt            sql.NullTime   `db:"deleted_at"`
+	DeletedBy            sql.NullString `db:"deleted_by"`
+}
+
+// selectColumns lists the columns scanned by scanSeverityLevel, in order.
+const selectColumns = "id, name, status, rank, response_sla_minutes, resolution_sla_minutes, color, icon, created_at, created_by, updated_at, updated_by"
+
+// scanSeverityLevel scans a row selected with selectColumns into a domain object.
+func scanSeverityLevel(row pgx.Row) (*domain.SeverityLevel, error) {
+	var e SeverityLevelEntity
+	if err := row.Scan(&e.Id, &e.Name, &e.Status, &e.Rank, &e.ResponseSLAMinutes, &e.ResolutionSLAMinutes, &e.Color, &e.Icon, &e.CreatedAt, &e.CreatedBy, &e.UpdatedAt, &e.UpdatedBy); err != nil {
+		return nil, err
+	}
+
+	return &domain.SeverityLevel{
+		Id:            e.Id,
+		Name:          e.Name,
+		Status:        e.Status,
+		Rank:          e.Rank,
+		ResponseSLA:   time.Duration(e.ResponseSLAMinutes) * time.Minute,
+		ResolutionSLA: time.Duration(e.ResolutionSLAMinutes) * time.Minute,
+		Color:         nullStringToPointer(e.Color),
+		Icon:          nullStringToPointer(e.Icon),
+		CreatedAt:     e.CreatedAt,
+		CreatedBy:     nullStringToPointer(e.CreatedBy),
+		UpdatedAt:     e.UpdatedAt,
+		UpdatedBy:     nullStringToPointer(e.UpdatedBy),
+	}, nil
 }
 
 // Repository implements the domain.SeverityLevelRepository interface for PostgreSQL.
@@ -33,6 +67,11 @@ func NewRepository(db *pgxpool.Pool) *Repository {
 	return &Repository{db: db}
 }
 
+// conn returns the transaction bound to ctx, so writes join the usecase's transaction.
+func (r *Repository) conn(ctx context.Context) postgres.Querier {
+	return postgres.Conn(ctx, r.db)
+}
+
 func nullStringToPointer(ns sql.NullString) *string {
 	if ns.Valid {
 		return &ns.String
@@ -2,12 +2,24 @@ package postgresql
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
 )
 
+// uniqueViolation is the SQLSTATE of a unique index violation.
+const uniqueViolation = "23505"
+
+// Store inserts a severity level. It returns domain.ErrRankTaken when a concurrent insert took its rank.
 func (r *Repository) Store(ctx context.Context, s *domain.SeverityLevel) error {
-	query := "INSERT INTO hr.severity_levels (id, name, status, created_at, created_by, updated_at, updated_by) VALUES ($1, $2, $3, $4, $5, $6, $7)"
-	_, err := r.db.Exec(ctx, query, s.Id, s.Name, s.Status, s.CreatedAt, s.CreatedBy, s.UpdatedAt, s.UpdatedBy)
+	query := "INSERT INTO master.severity_levels (id, name, status, rank, response_sla_minutes, resolution_sla_minutes, color, icon, created_at, created_by, updated_at, updated_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)"
+	_, err := r.conn(ctx).Exec(ctx, query, s.Id, s.Name, s.Status, s.Rank, int(s.ResponseSLA/time.Minute), int(s.ResolutionSLA/time.Minute), s.Color, s.Icon, s.CreatedAt, s.CreatedBy, s.UpdatedAt, s.UpdatedBy)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "idx_severity_levels_rank" {
+		return domain.ErrRankTaken
+	}
 	return err
 }
@@ -2,12 +2,14 @@ package postgresql
 
 import (
 	"context"
+	"time"
 
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
 )
 
+// Update modifies a severity level. The rank is only changed through Reorder.
 func (r *Repository) Update(ctx context.Context, s *domain.SeverityLevel) error {
-	query := "UPDATE hr.severity_levels SET name = $2, status = $3, updated_at = $4, updated_by = $5 WHERE id = $1"
-	_, err := r.db.Exec(ctx, query, s.Id, s.Name, s.Status, s.UpdatedAt, s.UpdatedBy)
+	query := "UPDATE master.severity_levels SET name = $2, status = $3, response_sla_minutes = $4, resolution_sla_minutes = $5, color = $6, icon = $7, updated_at = $8, updated_by = $9 WHERE id = $1 AND deleted_at IS NULL"
+	_, err := r.conn(ctx).Exec(ctx, query, s.Id, s.Name, s.Status, int(s.ResponseSLA/time.Minute), int(s.ResolutionSLA/time.Minute), s.Color, s.Icon, s.UpdatedAt, s.UpdatedBy)
 	return err
 }
@@ -2,16 +2,47 @@ package usecase
 
 import (
 	"context"
+	errs "errors"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
 )
 
+// createAttempts bounds how often Create retries when a concurrent create took the same rank.
+const createAttempts = 3
+
+// Create stores a new severity level ranked after the least severe one.
 func (u *UseCase) Create(ctx context.Context, sl *domain.SeverityLevel) error {
+	if err := validate(sl); err != nil {
+		return err
+	}
+
 	sl.Id = uuid.NewString()
 	now := time.Now()
 	sl.CreatedAt = now
 	sl.UpdatedAt = now
-	return u.repo.Store(ctx, sl)
+
+	var err error
+	for attempt := 0; attempt < createAttempts; attempt++ {
+		err = u.tx.WithinTx(ctx, func(ctx context.Context) error {
+			rank, err := u.repo.NextRank(ctx)
+			if err != nil {
+				return err
+			}
+			sl.Rank = rank
+
+			if err := u.repo.Store(ctx, sl); err != nil {
+				return err
+			}
+
+			return u.recorder.Record(ctx, audit.Entry{Entity: entitySeverityLevel, EntityId: sl.Id, Action: audit.ActionCreate, After: sl})
+		})
+		if !errs.Is(err, domain.ErrRankTaken) {
+			return err
+		}
+	}
+	return errors.Conflict("severity levels are being changed concurrently, try again")
 }
@@ -2,8 +2,28 @@ package usecase
 
 import (
 	"context"
+
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/errors"
 )
 
 func (u *UseCase) Delete(ctx context.Context, id string, deletedBy string) error {
-	return u.repo.Delete(ctx, id, deletedBy)
+	existing, err := u.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return errors.NotFound("Severity Level not found")
+	}
+
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repo.Delete(ctx, id, deletedBy); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entitySeverityLevel, EntityId: id, Action: audit.ActionDelete, Before: existing})
+	}); err != nil {
+		return err
+	}
+	return nil
 }
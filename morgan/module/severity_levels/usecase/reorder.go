@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/errors"
+)
+
+// Reorder ranks the given ids from most to least severe.
+// The list must contain every active severity level exactly once so ranks stay unique and gapless.
+func (u *UseCase) Reorder(ctx context.Context, ids []string, updatedBy string) error {
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			return errors.BadRequest("severity level " + id + " is listed more than once")
+		}
+		seen[id] = struct{}{}
+	}
+
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		// The active levels stay locked until commit, so the check holds while ranks are rewritten.
+		active, err := u.repo.FindActiveIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(active) != len(ids) {
+			return errors.BadRequest("order must list every severity level exactly once")
+		}
+		for _, id := range active {
+			if !slices.Contains(ids, id) {
+				return errors.BadRequest("order must list every severity level exactly once")
+			}
+		}
+
+		if err := u.repo.Reorder(ctx, ids, updatedBy); err != nil {
+			return err
+		}
+
+		// Reorder touches every severity level, so it is recorded once against the whole set.
+		return u.recorder.Record(ctx, audit.Entry{
+			Entity:   entitySeverityLevel,
+			EntityId: "*",
+			Action:   audit.ActionReorder,
+			Before:   map[string]any{"order": active},
+			After:    map[string]any{"order": ids},
+		})
+	}); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
+)
+
+// SLADeadline returns the response and resolution due times of a severity level for the given start time.
+func (u *UseCase) SLADeadline(ctx context.Context, id string, start time.Time) (*domain.SLADeadline, error) {
+	severityLevel, err := u.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if severityLevel == nil {
+		return nil, errors.NotFound("Severity Level not found")
+	}
+
+	deadline := severityLevel.Deadline(start)
+	return &deadline, nil
+}
@@ -4,11 +4,34 @@ import (
 	"context"
 	"time"
 
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
 )
 
 func (u *UseCase) Update(ctx context.Context, sl *domain.SeverityLevel) error {
+	if err := validate(sl); err != nil {
+		return err
+	}
+
+	existing, err := u.repo.FindByID(ctx, sl.Id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return errors.NotFound("Severity Level not found")
+	}
+
+	sl.Rank = existing.Rank
 	sl.UpdatedAt = time.Now()
-	// Should we check ID empty? Usually redundant if passed in struct.
-	return u.repo.Update(ctx, sl)
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repo.Update(ctx, sl); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entitySeverityLevel, EntityId: sl.Id, Action: audit.ActionUpdate, Before: existing, After: sl})
+	}); err != nil {
+		return err
+	}
+	return nil
 }
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"github.com/siakup/morgan-be/framework/postgres"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
 )
 
@@ -8,10 +10,15 @@ var _ domain.UseCase = (*UseCase)(nil)
 
 // UseCase implements the domain.UseCase interface.
 type UseCase struct {
-	repo domain.SeverityLevelRepository
+	repo     domain.SeverityLevelRepository
+	tx       postgres.Transactor
+	recorder audit.Recorder
 }
 
 // NewUseCase creates a new instance of the UseCase.
-func NewUseCase(repo domain.SeverityLevelRepository) *UseCase {
-	return &UseCase{repo: repo}
+func NewUseCase(repo domain.SeverityLevelRepository, tx postgres.Transactor, recorder audit.Recorder) *UseCase {
+	return &UseCase{repo: repo, tx: tx, recorder: recorder}
 }
+
+// entitySeverityLevel is the audit entity name of a severity level.
+const entitySeverityLevel = "severity_level"
@@ -0,0 +1,174 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
+	"github.com/siakup/morgan-be/morgan/module/severity_levels/usecase"
+	"github.com/siakup/morgan-be/morgan/tests/mocks"
+)
+
+func TestUseCase_SeverityLevels(t *testing.T) {
+	mockRepo := new(mocks.SeverityLevelsRepositoryMock)
+	mockRecorder := new(mocks.AuditRecorderMock)
+	mockRecorder.On("Record", mock.Anything, mock.Anything).Return(nil).Maybe()
+	uc := usecase.NewUseCase(mockRepo, new(mocks.TransactorMock), mockRecorder)
+
+	t.Run("Create", func(t *testing.T) {
+		ctx := context.Background()
+		sl := &domain.SeverityLevel{
+			Name:          "Critical",
+			ResponseSLA:   15 * time.Minute,
+			ResolutionSLA: 4 * time.Hour,
+		}
+
+		mockRepo.On("NextRank", mock.Anything).Return(3, nil).Once()
+		mockRepo.On("Store", mock.Anything, mock.MatchedBy(func(s *domain.SeverityLevel) bool {
+			return s.Name == "Critical" && s.Rank == 3 && s.Id != ""
+		})).Return(nil).Once()
+
+		err := uc.Create(ctx, sl)
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Create_RetriesWhenRankTaken", func(t *testing.T) {
+		ctx := context.Background()
+		sl := &domain.SeverityLevel{Name: "Low"}
+
+		mockRepo.On("NextRank", mock.Anything).Return(4, nil).Once()
+		mockRepo.On("Store", mock.Anything, mock.MatchedBy(func(s *domain.SeverityLevel) bool { return s.Rank == 4 })).Return(domain.ErrRankTaken).Once()
+		mockRepo.On("NextRank", mock.Anything).Return(5, nil).Once()
+		mockRepo.On("Store", mock.Anything, mock.MatchedBy(func(s *domain.SeverityLevel) bool { return s.Rank == 5 })).Return(nil).Once()
+
+		err := uc.Create(ctx, sl)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, sl.Rank)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Create_InvalidSLA", func(t *testing.T) {
+		ctx := context.Background()
+		sl := &domain.SeverityLevel{
+			Name:          "Critical",
+			ResponseSLA:   time.Hour,
+			ResolutionSLA: 15 * time.Minute,
+		}
+
+		err := uc.Create(ctx, sl)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "resolution SLA")
+	})
+
+	t.Run("Create_InvalidColor", func(t *testing.T) {
+		ctx := context.Background()
+		color := "red"
+		sl := &domain.SeverityLevel{Name: "Critical", Color: &color}
+
+		err := uc.Create(ctx, sl)
+		assert.Error(t, err)
+	})
+
+	t.Run("Update_KeepsRank", func(t *testing.T) {
+		ctx := context.Background()
+		sl := &domain.SeverityLevel{Id: "sl1", Name: "High", Rank: 9}
+
+		mockRepo.On("FindByID", mock.Anything, "sl1").Return(&domain.SeverityLevel{Id: "sl1", Rank: 2}, nil).Once()
+		mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(s *domain.SeverityLevel) bool {
+			return s.Rank == 2
+		})).Return(nil).Once()
+
+		err := uc.Update(ctx, sl)
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Reorder", func(t *testing.T) {
+		ctx := context.Background()
+		ids := []string{"sl2", "sl1"}
+
+		mockRepo.On("FindActiveIDs", mock.Anything).Return([]string{"sl1", "sl2"}, nil).Once()
+		mockRepo.On("Reorder", mock.Anything, ids, "user-1").Return(nil).Once()
+
+		err := uc.Reorder(ctx, ids, "user-1")
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Reorder_Duplicate", func(t *testing.T) {
+		ctx := context.Background()
+
+		err := uc.Reorder(ctx, []string{"sl1", "sl1"}, "user-1")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "more than once")
+	})
+
+	t.Run("Reorder_Incomplete", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindActiveIDs", mock.Anything).Return([]string{"sl1", "sl2", "sl3"}, nil).Once()
+
+		err := uc.Reorder(ctx, []string{"sl2", "sl1"}, "user-1")
+		assert.Error(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("SLADeadline", func(t *testing.T) {
+		ctx := context.Background()
+		start := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+		sl := &domain.SeverityLevel{Id: "sl1", ResponseSLA: 15 * time.Minute, ResolutionSLA: 4 * time.Hour}
+
+		mockRepo.On("FindByID", mock.Anything, "sl1").Return(sl, nil).Once()
+
+		res, err := uc.SLADeadline(ctx, "sl1", start)
+		assert.NoError(t, err)
+		assert.Equal(t, start.Add(15*time.Minute), *res.ResponseDueAt)
+		assert.Equal(t, start.Add(4*time.Hour), *res.ResolutionDueAt)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("SLADeadline_ZeroSLANoDeadline", func(t *testing.T) {
+		ctx := context.Background()
+		sl := &domain.SeverityLevel{Id: "sl1", ResolutionSLA: 4 * time.Hour}
+
+		mockRepo.On("FindByID", mock.Anything, "sl1").Return(sl, nil).Once()
+
+		res, err := uc.SLADeadline(ctx, "sl1", time.Now())
+		assert.NoError(t, err)
+		assert.Nil(t, res.ResponseDueAt)
+		assert.NotNil(t, res.ResolutionDueAt)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("SLADeadline_NotFound", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", mock.Anything, "sl1").Return((*domain.SeverityLevel)(nil), nil).Once()
+
+		res, err := uc.SLADeadline(ctx, "sl1", time.Now())
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Create_Error", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("NextRank", mock.Anything).Return(0, errors.New("query failed")).Once()
+
+		err := uc.Create(ctx, &domain.SeverityLevel{Name: "Test"})
+		assert.Error(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"regexp"
+
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
+)
+
+var hexColor = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// validate checks the SLA targets and display metadata of a severity level.
+func validate(sl *domain.SeverityLevel) error {
+	if sl.ResponseSLA < 0 || sl.ResolutionSLA < 0 {
+		return errors.BadRequest("SLA durations cannot be negative")
+	}
+	if sl.ResolutionSLA > 0 && sl.ResolutionSLA < sl.ResponseSLA {
+		return errors.BadRequest("resolution SLA cannot be shorter than response SLA")
+	}
+	if sl.Color != nil && !hexColor.MatchString(*sl.Color) {
+		return errors.BadRequest("color must be a hex value like #RRGGBB")
+	}
+
+	return nil
+}
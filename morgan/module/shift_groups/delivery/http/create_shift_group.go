@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/libraries/middleware"
 	"github.com/siakup/morgan-be/libraries/responses"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
@@ -17,19 +18,21 @@ type CreateShiftGroupRequest struct {
 func (h *ShiftGroupHandler) CreateShiftGroup(c *fiber.Ctx) error {
 	var req CreateShiftGroupRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(http.StatusBadRequest).JSON(responses.Fail("BAD_REQUEST", "Invalid request body"))
+		return h.handleError(c, errors.BadRequest("Invalid request body"))
 	}
 
 	userId, _ := c.Locals(middleware.XUserIdKey).(string)
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
 
 	shiftGroup := domain.ShiftGroup{
-		Name:      req.Name,
-		Status:    req.Status,
-		CreatedBy: &userId,
-		UpdatedBy: &userId,
+		InstitutionId: &institutionId,
+		Name:          req.Name,
+		Status:        req.Status,
+		CreatedBy:     &userId,
+		UpdatedBy:     &userId,
 	}
 
-	err := h.useCase.Create(c.Context(), &shiftGroup)
+	err := h.useCase.Create(c.UserContext(), &shiftGroup)
 	if err != nil {
 		return h.handleError(c, err)
 	}
@@ -11,8 +11,9 @@ import (
 func (h *ShiftGroupHandler) DeleteShiftGroup(c *fiber.Ctx) error {
 	id := c.Params("id")
 	userId, _ := c.Locals(middleware.XUserIdKey).(string)
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
 
-	err := h.useCase.Delete(c.Context(), id, userId)
+	err := h.useCase.Delete(c.UserContext(), institutionId, id, userId)
 	if err != nil {
 		return h.handleError(c, err)
 	}
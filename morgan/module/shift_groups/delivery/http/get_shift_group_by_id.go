@@ -1,20 +1,22 @@
 package http
 
 import (
-	"net/http"
-
 	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
 	"github.com/siakup/morgan-be/libraries/responses"
 )
 
 func (h *ShiftGroupHandler) GetShiftGroupByID(c *fiber.Ctx) error {
 	id := c.Params("id")
-	shiftGroup, err := h.useCase.FindByID(c.Context(), id)
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	shiftGroup, err := h.useCase.FindByID(c.UserContext(), institutionId, id)
 	if err != nil {
 		return h.handleError(c, err)
 	}
 	if shiftGroup == nil {
-		return c.Status(http.StatusNotFound).JSON(responses.Fail("NOT_FOUND", "Shift Group not found"))
+		return h.handleError(c, errors.NotFound("Shift Group not found"))
 	}
 
 	return c.JSON(responses.Success(shiftGroup, "Shift Group retrieved"))
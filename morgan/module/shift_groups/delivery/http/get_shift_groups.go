@@ -2,6 +2,7 @@ package http
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/middleware"
 	"github.com/siakup/morgan-be/libraries/responses"
 	"github.com/siakup/morgan-be/libraries/types"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
@@ -10,16 +11,18 @@ import (
 func (h *ShiftGroupHandler) GetShiftGroups(c *fiber.Ctx) error {
 	page := c.QueryInt("page", 1)
 	size := c.QueryInt("size", 10)
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
 
 	filter := domain.ShiftGroupFilter{
 		Pagination: types.Pagination{
 			Page: page,
 			Size: size,
 		},
-		Search: c.Query("search"),
+		InstitutionId: institutionId,
+		Search:        c.Query("search"),
 	}
 
-	shiftGroups, total, err := h.useCase.FindAll(c.Context(), filter)
+	shiftGroups, total, err := h.useCase.FindAll(c.UserContext(), filter)
 	if err != nil {
 		return h.handleError(c, err)
 	}
@@ -1,15 +1,24 @@
 package http
 
 import (
-	"net/http"
-
 	"github.com/gofiber/fiber/v2"
-	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/openapi"
 	"github.com/siakup/morgan-be/libraries/responses"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
 )
 
+// Permission codes guarding the shift_groups routes.
+const (
+	PermissionView   = "shift_groups.hr.shift_groups.view"
+	PermissionCreate = "shift_groups.hr.shift_groups.create"
+	PermissionEdit   = "shift_groups.hr.shift_groups.edit"
+	PermissionDelete = "shift_groups.hr.shift_groups.delete"
+)
+
+// Permissions lists every permission code required by the shift_groups routes.
+var Permissions = []string{PermissionView, PermissionCreate, PermissionEdit, PermissionDelete}
+
 // ShiftGroupHandler handles HTTP requests for shift groups module.
 type ShiftGroupHandler struct {
 	useCase domain.UseCase
@@ -25,22 +34,12 @@ func NewShiftGroupHandler(useCase domain.UseCase, auth *middleware.Authorization
 }
 
 // RegisterRoutes registers the routes for the shift groups module.
-func (h *ShiftGroupHandler) RegisterRoutes(app *fiber.App) {
-	group := app.Group("/shift-groups", middleware.TraceMiddleware)
-
-	// Adjust permissions as needed
-	group.Get("/", h.auth.Authenticate("shift_groups.view"), h.GetShiftGroups)
-	group.Get("/:id", h.auth.Authenticate("shift_groups.view"), h.GetShiftGroupByID)
-	group.Post("/", h.auth.Authenticate("shift_groups.edit"), h.CreateShiftGroup)
-	group.Put("/:id", h.auth.Authenticate("shift_groups.edit"), h.UpdateShiftGroup)
-	group.Delete("/:id", h.auth.Authenticate("shift_groups.edit"), h.DeleteShiftGroup)
+func (h *ShiftGroupHandler) RegisterRoutes(router fiber.Router) {
+	router.Use("/shift-groups", middleware.TraceMiddleware)
+	openapi.Mount(router, h.auth.Authenticate, h.Endpoints())
 }
 
-// handleError handles errors by mapping them to standardized responses.
+// handleError renders err with the standard error envelope.
 func (h *ShiftGroupHandler) handleError(c *fiber.Ctx, err error) error {
-	if appErr, ok := err.(*errors.AppError); ok {
-		return c.Status(appErr.Code).JSON(responses.Fail(string(appErr.Type), appErr.Message))
-	}
-
-	return c.Status(http.StatusInternalServerError).JSON(responses.Fail("SYSTEM_ERROR", err.Error()))
+	return responses.HandleError(c, err)
 }
@@ -9,12 +9,13 @@ import (
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/mock"
+	apperrors "github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/libraries/middleware"
 	deliverhttp "github.com/siakup/morgan-be/morgan/module/shift_groups/delivery/http"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
 	"github.com/siakup/morgan-be/morgan/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func setupShiftGroupApp(useCase domain.UseCase) *fiber.App {
@@ -38,6 +39,8 @@ func setupShiftGroupApp(useCase domain.UseCase) *fiber.App {
 	app.Post("/shift-groups", handler.CreateShiftGroup)
 	app.Put("/shift-groups/:id", handler.UpdateShiftGroup)
 	app.Delete("/shift-groups/:id", handler.DeleteShiftGroup)
+	app.Get("/shift-groups/:id/members", handler.GetMembers)
+	app.Put("/shift-groups/:id/members", handler.SetMembers)
 
 	return app
 }
@@ -50,7 +53,9 @@ func TestShiftGroupHandler_GetShiftGroups(t *testing.T) {
 		shiftGroups := []*domain.ShiftGroup{{Id: "sg1", Name: "Morning Shift"}}
 		count := int64(1)
 
-		mockUseCase.On("FindAll", mock.Anything, mock.Anything).Return(shiftGroups, count, nil).Once()
+		mockUseCase.On("FindAll", mock.Anything, mock.MatchedBy(func(f domain.ShiftGroupFilter) bool {
+			return f.InstitutionId == "inst-1"
+		})).Return(shiftGroups, count, nil).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/shift-groups", nil)
 		resp, err := app.Test(req)
@@ -81,7 +86,7 @@ func TestShiftGroupHandler_GetShiftGroupByID(t *testing.T) {
 		app := setupShiftGroupApp(mockUseCase)
 
 		sg := &domain.ShiftGroup{Id: "sg1", Name: "Morning Shift"}
-		mockUseCase.On("FindByID", mock.Anything, "sg1").Return(sg, nil).Once()
+		mockUseCase.On("FindByID", mock.Anything, "inst-1", "sg1").Return(sg, nil).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/shift-groups/sg1", nil)
 		resp, err := app.Test(req)
@@ -95,7 +100,7 @@ func TestShiftGroupHandler_GetShiftGroupByID(t *testing.T) {
 		mockUseCase := new(mocks.ShiftGroupsUseCaseMock)
 		app := setupShiftGroupApp(mockUseCase)
 
-		mockUseCase.On("FindByID", mock.Anything, "sg1").Return((*domain.ShiftGroup)(nil), errors.New("fail")).Once()
+		mockUseCase.On("FindByID", mock.Anything, "inst-1", "sg1").Return((*domain.ShiftGroup)(nil), errors.New("fail")).Once()
 
 		req := httptest.NewRequest(http.MethodGet, "/shift-groups/sg1", nil)
 		resp, err := app.Test(req)
@@ -117,7 +122,7 @@ func TestShiftGroupHandler_CreateShiftGroup(t *testing.T) {
 		reqBytes, _ := json.Marshal(reqBody)
 
 		mockUseCase.On("Create", mock.Anything, mock.MatchedBy(func(sg *domain.ShiftGroup) bool {
-			return sg.Name == "Evening Shift"
+			return sg.Name == "Evening Shift" && sg.InstitutionId != nil && *sg.InstitutionId == "inst-1"
 		})).Return(nil).Once()
 
 		req := httptest.NewRequest(http.MethodPost, "/shift-groups", bytes.NewReader(reqBytes))
@@ -171,7 +176,7 @@ func TestShiftGroupHandler_UpdateShiftGroup(t *testing.T) {
 		reqBytes, _ := json.Marshal(reqBody)
 
 		mockUseCase.On("Update", mock.Anything, mock.MatchedBy(func(sg *domain.ShiftGroup) bool {
-			return sg.Id == "sg1" && sg.Name == "Updated Shift"
+			return sg.Id == "sg1" && sg.Name == "Updated Shift" && sg.InstitutionId != nil && *sg.InstitutionId == "inst-1"
 		})).Return(nil).Once()
 
 		req := httptest.NewRequest(http.MethodPut, "/shift-groups/sg1", bytes.NewReader(reqBytes))
@@ -201,7 +206,7 @@ func TestShiftGroupHandler_DeleteShiftGroup(t *testing.T) {
 		mockUseCase := new(mocks.ShiftGroupsUseCaseMock)
 		app := setupShiftGroupApp(mockUseCase)
 
-		mockUseCase.On("Delete", mock.Anything, "sg1", "admin-user").Return(nil).Once()
+		mockUseCase.On("Delete", mock.Anything, "inst-1", "sg1", "admin-user").Return(nil).Once()
 
 		req := httptest.NewRequest(http.MethodDelete, "/shift-groups/sg1", nil)
 		resp, err := app.Test(req)
@@ -215,7 +220,7 @@ func TestShiftGroupHandler_DeleteShiftGroup(t *testing.T) {
 		mockUseCase := new(mocks.ShiftGroupsUseCaseMock)
 		app := setupShiftGroupApp(mockUseCase)
 
-		mockUseCase.On("Delete", mock.Anything, "sg1", "admin-user").Return(errors.New("fail")).Once()
+		mockUseCase.On("Delete", mock.Anything, "inst-1", "sg1", "admin-user").Return(errors.New("fail")).Once()
 
 		req := httptest.NewRequest(http.MethodDelete, "/shift-groups/sg1", nil)
 		resp, err := app.Test(req)
@@ -225,3 +230,73 @@ func TestShiftGroupHandler_DeleteShiftGroup(t *testing.T) {
 		mockUseCase.AssertExpectations(t)
 	})
 }
+
+func TestShiftGroupHandler_Members(t *testing.T) {
+	const userId = "5b1f6c3e-7a0b-4d1e-9f7a-2c4b8e6d1a90"
+
+	t.Run("Get", func(t *testing.T) {
+		mockUseCase := new(mocks.ShiftGroupsUseCaseMock)
+		app := setupShiftGroupApp(mockUseCase)
+
+		mockUseCase.On("FindMembers", mock.Anything, "sg1", "inst-1").Return([]string{userId}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/shift-groups/sg1/members", nil)
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		mockUseCase := new(mocks.ShiftGroupsUseCaseMock)
+		app := setupShiftGroupApp(mockUseCase)
+
+		mockUseCase.On("SetMembers", mock.Anything, domain.SetMembersCommand{
+			ShiftGroupId:  "sg1",
+			InstitutionId: "inst-1",
+			UserIds:       []string{userId},
+			UpdatedBy:     "admin-user",
+		}).Return([]string{userId}, nil).Once()
+
+		reqBytes, _ := json.Marshal(map[string]interface{}{"user_ids": []string{userId}})
+		req := httptest.NewRequest(http.MethodPut, "/shift-groups/sg1/members", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidUserId", func(t *testing.T) {
+		mockUseCase := new(mocks.ShiftGroupsUseCaseMock)
+		app := setupShiftGroupApp(mockUseCase)
+
+		reqBytes, _ := json.Marshal(map[string]interface{}{"user_ids": []string{"not-a-uuid"}})
+		req := httptest.NewRequest(http.MethodPut, "/shift-groups/sg1/members", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		mockUseCase.AssertNotCalled(t, "SetMembers", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ForeignUser", func(t *testing.T) {
+		mockUseCase := new(mocks.ShiftGroupsUseCaseMock)
+		app := setupShiftGroupApp(mockUseCase)
+
+		mockUseCase.On("SetMembers", mock.Anything, mock.Anything).
+			Return(nil, apperrors.FromStatus(http.StatusUnprocessableEntity, "Members must be users of the institution")).Once()
+
+		reqBytes, _ := json.Marshal(map[string]interface{}{"user_ids": []string{userId}})
+		req := httptest.NewRequest(http.MethodPut, "/shift-groups/sg1/members", bytes.NewReader(reqBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
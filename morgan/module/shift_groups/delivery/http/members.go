@@ -0,0 +1,53 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/validation"
+	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
+)
+
+// SetMembersRequest replaces the members of a shift group, an empty list removes them all.
+type SetMembersRequest struct {
+	UserIds []string `json:"user_ids" validate:"max=500,dive,required,uuid"`
+}
+
+// GetMembers handles GET /shift-groups/:id/members
+func (h *ShiftGroupHandler) GetMembers(c *fiber.Ctx) error {
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	userIds, err := h.useCase.FindMembers(c.UserContext(), c.Params("id"), institutionId)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(responses.Success(userIds, "Shift Group members retrieved"))
+}
+
+// SetMembers handles PUT /shift-groups/:id/members
+func (h *ShiftGroupHandler) SetMembers(c *fiber.Ctx) error {
+	var req SetMembersRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.handleError(c, errors.BadRequest("Invalid request body"))
+	}
+	if err := validation.ValidateStruct(&req, c.Get(fiber.HeaderAcceptLanguage)); err != nil {
+		return h.handleError(c, err)
+	}
+
+	userId, _ := c.Locals(middleware.XUserIdKey).(string)
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	userIds, err := h.useCase.SetMembers(c.UserContext(), domain.SetMembersCommand{
+		ShiftGroupId:  c.Params("id"),
+		InstitutionId: institutionId,
+		UserIds:       req.UserIds,
+		UpdatedBy:     userId,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.JSON(responses.Success(userIds, "Shift Group members updated"))
+}
@@ -0,0 +1,28 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
+)
+
+type listQuery struct {
+	Page   int    `query:"page" default:"1"`
+	Size   int    `query:"size" default:"10"`
+	Search string `query:"search"`
+}
+
+// Endpoints lists the shift groups routes: RegisterRoutes mounts them and the OpenAPI document describes them.
+func (h *ShiftGroupHandler) Endpoints() []openapi.Endpoint {
+	return []openapi.Endpoint{
+		{Method: fiber.MethodGet, Path: "/shift-groups", Summary: "List shift groups", Permissions: []string{PermissionView}, Query: listQuery{}, Response: []domain.ShiftGroup{}, Paginated: true, Handlers: []fiber.Handler{h.GetShiftGroups}},
+		{Method: fiber.MethodGet, Path: "/shift-groups/:id", Summary: "Get a shift group", Permissions: []string{PermissionView}, Response: domain.ShiftGroup{}, Handlers: []fiber.Handler{h.GetShiftGroupByID}},
+		{Method: fiber.MethodPost, Path: "/shift-groups", Summary: "Create a shift group", Permissions: []string{PermissionCreate}, Request: CreateShiftGroupRequest{}, Response: domain.ShiftGroup{}, Status: http.StatusCreated, Handlers: []fiber.Handler{h.CreateShiftGroup}},
+		{Method: fiber.MethodPut, Path: "/shift-groups/:id", Summary: "Update a shift group", Permissions: []string{PermissionEdit}, Request: UpdateShiftGroupRequest{}, Response: domain.ShiftGroup{}, Handlers: []fiber.Handler{h.UpdateShiftGroup}},
+		{Method: fiber.MethodDelete, Path: "/shift-groups/:id", Summary: "Delete a shift group", Permissions: []string{PermissionDelete}, Handlers: []fiber.Handler{h.DeleteShiftGroup}},
+		{Method: fiber.MethodGet, Path: "/shift-groups/:id/members", Summary: "List the members of a shift group", Permissions: []string{PermissionView}, Response: []string{}, Handlers: []fiber.Handler{h.GetMembers}},
+		{Method: fiber.MethodPut, Path: "/shift-groups/:id/members", Summary: "Replace the members of a shift group", Description: "Members must be users of the caller's institution; clock-in resolves the grace period from this membership.", Permissions: []string{PermissionEdit}, Request: SetMembersRequest{}, Response: []string{}, Handlers: []fiber.Handler{h.SetMembers}},
+	}
+}
@@ -1,9 +1,8 @@
 package http
 
 import (
-	"net/http"
-
 	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/libraries/middleware"
 	"github.com/siakup/morgan-be/libraries/responses"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
@@ -18,19 +17,21 @@ func (h *ShiftGroupHandler) UpdateShiftGroup(c *fiber.Ctx) error {
 	id := c.Params("id")
 	var req UpdateShiftGroupRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(http.StatusBadRequest).JSON(responses.Fail("BAD_REQUEST", "Invalid request body"))
+		return h.handleError(c, errors.BadRequest("Invalid request body"))
 	}
 
 	userId, _ := c.Locals(middleware.XUserIdKey).(string)
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
 
 	shiftGroup := domain.ShiftGroup{
-		Id:        id,
-		Name:      req.Name,
-		Status:    req.Status,
-		UpdatedBy: &userId,
+		Id:            id,
+		InstitutionId: &institutionId,
+		Name:          req.Name,
+		Status:        req.Status,
+		UpdatedBy:     &userId,
 	}
 
-	err := h.useCase.Update(c.Context(), &shiftGroup)
+	err := h.useCase.Update(c.UserContext(), &shiftGroup)
 	if err != nil {
 		return h.handleError(c, err)
 	}
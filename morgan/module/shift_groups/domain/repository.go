@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/siakup/morgan-be/libraries/types"
@@ -10,31 +11,41 @@ import (
 // ShiftGroup represents the domain object for a Shift Group.
 // ShiftGroup represents the domain object for a Shift Group.
 type ShiftGroup struct {
-	Id        string     `object:"id"`
-	Name      string     `object:"name"` // Enum: FM, IT, HK
-	Status    bool       `object:"status"`
-	CreatedAt time.Time  `object:"created_at"`
-	CreatedBy *string    `object:"created_by"`
-	UpdatedAt time.Time  `object:"updated_at"`
-	UpdatedBy *string    `object:"updated_by"`
-	DeletedAt *time.Time `object:"deleted_at"`
-	DeletedBy *string    `object:"deleted_by"`
+	Id            string     `object:"id"`
+	InstitutionId *string    `object:"institution_id"` // Nil for shift groups shared by every institution
+	Name          string     `object:"name"`           // Enum: FM, IT, HK
+	Status        bool       `object:"status"`
+	CreatedAt     time.Time  `object:"created_at"`
+	CreatedBy     *string    `object:"created_by"`
+	UpdatedAt     time.Time  `object:"updated_at"`
+	UpdatedBy     *string    `object:"updated_by"`
+	DeletedAt     *time.Time `object:"deleted_at"`
+	DeletedBy     *string    `object:"deleted_by"`
 }
 
 // ShiftGroupFilter represents the filter options for fetching shift groups.
 type ShiftGroupFilter struct {
 	types.Pagination
-	Search string
+	InstitutionId string // Lists the institution's own and the shared shift groups
+	Search        string
 }
 
+// ErrUnknownMember is returned when a member is not an active user of the institution.
+var ErrUnknownMember = errors.New("member is not a user of the institution")
+
 // ShiftGroupRepository defines the methods for interacting with the shift groups storage.
 type ShiftGroupRepository interface {
 	FindAll(ctx context.Context, filter ShiftGroupFilter) ([]*ShiftGroup, int64, error)
 	FindByID(ctx context.Context, id string) (*ShiftGroup, error)
 	Store(ctx context.Context, shiftGroup *ShiftGroup) error
 	Update(ctx context.Context, shiftGroup *ShiftGroup) error
-	// Delete removes a shift group from storage.
-	Delete(ctx context.Context, id string, deletedBy string) error
+	// Delete removes a shift group of the institution from storage.
+	Delete(ctx context.Context, institutionId string, id string, deletedBy string) error
+	// FindMemberIDs lists the users of an institution that belong to a shift group.
+	FindMemberIDs(ctx context.Context, id string, institutionId string) ([]string, error)
+	// ReplaceMembers sets the members of a shift group within an institution, inside the caller's transaction.
+	// It returns ErrUnknownMember when a user does not belong to the institution.
+	ReplaceMembers(ctx context.Context, id string, institutionId string, userIds []string, updatedBy string) error
 }
 
 // ShiftGroupUseCase defines the business logic for shift groups.
@@ -7,8 +7,18 @@ import (
 // UseCase defines the business logic methods for shift groups.
 type UseCase interface {
 	FindAll(ctx context.Context, filter ShiftGroupFilter) ([]*ShiftGroup, int64, error)
-	FindByID(ctx context.Context, id string) (*ShiftGroup, error)
+	FindByID(ctx context.Context, institutionId string, id string) (*ShiftGroup, error)
 	Create(ctx context.Context, shiftGroup *ShiftGroup) error
 	Update(ctx context.Context, shiftGroup *ShiftGroup) error
-	Delete(ctx context.Context, id string, deletedBy string) error
+	Delete(ctx context.Context, institutionId string, id string, deletedBy string) error
+	FindMembers(ctx context.Context, id string, institutionId string) ([]string, error)
+	SetMembers(ctx context.Context, cmd SetMembersCommand) ([]string, error)
+}
+
+// SetMembersCommand replaces the members of a shift group within an institution.
+type SetMembersCommand struct {
+	ShiftGroupId  string
+	InstitutionId string
+	UserIds       []string
+	UpdatedBy     string
 }
@@ -1,12 +1,13 @@
 package shift_groups
 
 import (
-	gofiber "github.com/gofiber/fiber/v2"
-	"go.uber.org/fx"
+	"github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/registry"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/delivery/http"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/repository/postgresql"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/usecase"
+	"go.uber.org/fx"
 )
 
 // Module exports the shift_groups module for Fx.
@@ -24,9 +25,16 @@ var Module = fx.Options(
 		),
 		http.NewShiftGroupHandler,
 	),
-	fx.Invoke(registerRoutes),
+	registry.Provide(newModule),
 )
 
-func registerRoutes(h *http.ShiftGroupHandler, app *gofiber.App) {
-	h.RegisterRoutes(app)
+func newModule(h *http.ShiftGroupHandler) registry.Module {
+	return registry.Module{
+		Name:        "shift_groups",
+		Version:     fiber.V1,
+		BasePath:    "/shift-groups",
+		Permissions: http.Permissions,
+		Endpoints:   h.Endpoints(),
+		Register:    h.RegisterRoutes,
+	}
 }
@@ -4,9 +4,9 @@ import (
 	"context"
 )
 
-func (r *Repository) Delete(ctx context.Context, id string, deletedBy string) error {
+func (r *Repository) Delete(ctx context.Context, institutionId string, id string, deletedBy string) error {
 	// Soft delete
-	query := "UPDATE hr.shift_groups SET deleted_at = NOW(), deleted_by = $2 WHERE id = $1"
-	_, err := r.db.Exec(ctx, query, id, deletedBy)
+	query := "UPDATE hr.shift_groups SET deleted_at = NOW(), deleted_by = $2 WHERE id = $1 AND institution_id = $3"
+	_, err := r.conn(ctx).Exec(ctx, query, id, deletedBy, institutionId)
 	return err
 }
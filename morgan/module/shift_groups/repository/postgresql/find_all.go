@@ -12,7 +12,10 @@ func (r *Repository) FindAll(ctx context.Context, filter domain.ShiftGroupFilter
 	var where []string
 	var args []interface{}
 
-	// InstitutionId filter removed as per ERD alignment
+	if filter.InstitutionId != "" {
+		where = append(where, "(institution_id IS NULL OR institution_id = $"+fmt.Sprint(len(args)+1)+")")
+		args = append(args, filter.InstitutionId)
+	}
 
 	if filter.Search != "" {
 		where = append(where, "name ILIKE $"+fmt.Sprint(len(args)+1))
@@ -33,7 +36,7 @@ func (r *Repository) FindAll(ctx context.Context, filter domain.ShiftGroupFilter
 		return nil, 0, err
 	}
 
-	query := "SELECT id, name, status, created_at, created_by, updated_at, updated_by FROM hr.shift_groups " + whereClause + " ORDER BY created_at DESC LIMIT $" + fmt.Sprint(len(args)+1) + " OFFSET $" + fmt.Sprint(len(args)+2)
+	query := "SELECT id, institution_id, name, status, created_at, created_by, updated_at, updated_by FROM hr.shift_groups " + whereClause + " ORDER BY created_at DESC LIMIT $" + fmt.Sprint(len(args)+1) + " OFFSET $" + fmt.Sprint(len(args)+2)
 	args = append(args, filter.GetLimit(), filter.GetOffset())
 
 	rows, err := r.db.Query(ctx, query, args...)
@@ -45,17 +48,18 @@ func (r *Repository) FindAll(ctx context.Context, filter domain.ShiftGroupFilter
 	var shiftGroups []*domain.ShiftGroup
 	for rows.Next() {
 		var e ShiftGroupEntity
-		if err := rows.Scan(&e.Id, &e.Name, &e.Status, &e.CreatedAt, &e.CreatedBy, &e.UpdatedAt, &e.UpdatedBy); err != nil {
+		if err := rows.Scan(&e.Id, &e.InstitutionId, &e.Name, &e.Status, &e.CreatedAt, &e.CreatedBy, &e.UpdatedAt, &e.UpdatedBy); err != nil {
 			return nil, 0, err
 		}
 		shiftGroups = append(shiftGroups, &domain.ShiftGroup{
-			Id:        e.Id,
-			Name:      e.Name,
-			Status:    e.Status,
-			CreatedAt: e.CreatedAt,
-			CreatedBy: nullStringToPointer(e.CreatedBy),
-			UpdatedAt: e.UpdatedAt,
-			UpdatedBy: nullStringToPointer(e.UpdatedBy),
+			Id:            e.Id,
+			InstitutionId: nullStringToPointer(e.InstitutionId),
+			Name:          e.Name,
+			Status:        e.Status,
+			CreatedAt:     e.CreatedAt,
+			CreatedBy:     nullStringToPointer(e.CreatedBy),
+			UpdatedAt:     e.UpdatedAt,
+			UpdatedBy:     nullStringToPointer(e.UpdatedBy),
 		})
 	}
 
@@ -8,9 +8,9 @@ import (
 )
 
 func (r *Repository) FindByID(ctx context.Context, id string) (*domain.ShiftGroup, error) {
-	query := "SELECT id, name, status, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by FROM hr.shift_groups WHERE id = $1 AND deleted_at IS NULL"
+	query := "SELECT id, institution_id, name, status, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by FROM hr.shift_groups WHERE id = $1 AND deleted_at IS NULL"
 	var e ShiftGroupEntity
-	err := r.db.QueryRow(ctx, query, id).Scan(&e.Id, &e.Name, &e.Status, &e.CreatedAt, &e.CreatedBy, &e.UpdatedAt, &e.UpdatedBy, &e.DeletedAt, &e.DeletedBy)
+	err := r.db.QueryRow(ctx, query, id).Scan(&e.Id, &e.InstitutionId, &e.Name, &e.Status, &e.CreatedAt, &e.CreatedBy, &e.UpdatedAt, &e.UpdatedBy, &e.DeletedAt, &e.DeletedBy)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, nil // Or custom error
@@ -18,14 +18,15 @@ func (r *Repository) FindByID(ctx context.Context, id string) (*domain.ShiftGrou
 		return nil, err
 	}
 	return &domain.ShiftGroup{
-		Id:        e.Id,
-		Name:      e.Name,
-		Status:    e.Status,
-		CreatedAt: e.CreatedAt,
-		CreatedBy: nullStringToPointer(e.CreatedBy),
-		UpdatedAt: e.UpdatedAt,
-		UpdatedBy: nullStringToPointer(e.UpdatedBy),
-		DeletedAt: nullTimeToPointer(e.DeletedAt),
-		DeletedBy: nullStringToPointer(e.DeletedBy),
+		Id:            e.Id,
+		InstitutionId: nullStringToPointer(e.InstitutionId),
+		Name:          e.Name,
+		Status:        e.Status,
+		CreatedAt:     e.CreatedAt,
+		CreatedBy:     nullStringToPointer(e.CreatedBy),
+		UpdatedAt:     e.UpdatedAt,
+		UpdatedBy:     nullStringToPointer(e.UpdatedBy),
+		DeletedAt:     nullTimeToPointer(e.DeletedAt),
+		DeletedBy:     nullStringToPointer(e.DeletedBy),
 	}, nil
 }
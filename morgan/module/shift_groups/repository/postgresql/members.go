@@ -0,0 +1,73 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
+)
+
+var queryFindMemberIDs = `
+	SELECT m.user_id
+	FROM hr.shift_group_members m
+	JOIN auth.users u ON u.id = m.user_id
+	WHERE m.shift_group_id = @shift_group_id
+		AND u.institution_id = @institution_id
+		AND u.deleted_at IS NULL
+	ORDER BY m.user_id
+`
+
+var queryDeleteMembers = `
+	DELETE FROM hr.shift_group_members m
+	USING auth.users u
+	WHERE u.id = m.user_id
+		AND m.shift_group_id = @shift_group_id
+		AND u.institution_id = @institution_id
+`
+
+var queryInsertMembers = `
+	INSERT INTO hr.shift_group_members (shift_group_id, user_id, created_by)
+	SELECT @shift_group_id, u.id, @created_by
+	FROM auth.users u
+	WHERE u.id = ANY(@user_ids::uuid[])
+		AND u.institution_id = @institution_id
+		AND u.deleted_at IS NULL
+`
+
+// FindMemberIDs lists the users of an institution that belong to a shift group.
+func (r *Repository) FindMemberIDs(ctx context.Context, id string, institutionId string) ([]string, error) {
+	rows, err := r.db.Query(ctx, queryFindMemberIDs, pgx.NamedArgs{
+		"shift_group_id": id,
+		"institution_id": institutionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// ReplaceMembers swaps the institution's members of a shift group. It must run within the
+// caller's transaction. Members from other institutions are left untouched.
+func (r *Repository) ReplaceMembers(ctx context.Context, id string, institutionId string, userIds []string, updatedBy string) error {
+	args := pgx.NamedArgs{
+		"shift_group_id": id,
+		"institution_id": institutionId,
+		"user_ids":       userIds,
+		"created_by":     updatedBy,
+	}
+
+	if _, err := r.conn(ctx).Exec(ctx, queryDeleteMembers, args); err != nil {
+		return err
+	}
+
+	tag, err := r.conn(ctx).Exec(ctx, queryInsertMembers, args)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() != int64(len(userIds)) {
+		return domain.ErrUnknownMember
+	}
+
+	return nil
+}
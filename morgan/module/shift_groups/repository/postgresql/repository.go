@@ -1,10 +1,12 @@
 package postgresql
 
 import (
+	"context"
 	"database/sql"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/siakup/morgan-be/framework/postgres"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
 )
 
@@ -12,15 +14,16 @@ var _ domain.ShiftGroupRepository = (*Repository)(nil)
 
 // ShiftGroupEntity represents the schema in the database.
 type ShiftGroupEntity struct {
-	Id        string         `db:"id"`
-	Name      string         `db:"name"`
-	Status    bool           `db:"status"`
-	CreatedAt time.Time      `db:"created_at"`
-	CreatedBy sql.NullString `db:"created_by"`
-	UpdatedAt time.Time      `db:"updated_at"`
-	UpdatedBy sql.NullString `db:"updated_by"`
-	DeletedAt sql.NullTime   `db:"deleted_at"`
-	DeletedBy sql.NullString `db:"deleted_by"`
+	Id            string         `db:"id"`
+	InstitutionId sql.NullString `db:"institution_id"`
+	Name          string         `db:"name"`
+	Status        bool           `db:"status"`
+	CreatedAt     time.Time      `db:"created_at"`
+	CreatedBy     sql.NullString `db:"created_by"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+	UpdatedBy     sql.NullString `db:"updated_by"`
+	DeletedAt     sql.NullTime   `db:"deleted_at"`
+	DeletedBy     sql.NullString `db:"deleted_by"`
 }
 
 // Repository implements the domain.ShiftGroupRepository interface for PostgreSQL.
@@ -33,6 +36,11 @@ func NewRepository(db *pgxpool.Pool) *Repository {
 	return &Repository{db: db}
 }
 
+// conn returns the transaction bound to ctx, so writes join the usecase's transaction.
+func (r *Repository) conn(ctx context.Context) postgres.Querier {
+	return postgres.Conn(ctx, r.db)
+}
+
 func nullStringToPointer(ns sql.NullString) *string {
 	if ns.Valid {
 		return &ns.String
@@ -7,7 +7,7 @@ import (
 )
 
 func (r *Repository) Store(ctx context.Context, s *domain.ShiftGroup) error {
-	query := "INSERT INTO hr.shift_groups (id, name, status, created_at, created_by, updated_at, updated_by) VALUES ($1, $2, $3, $4, $5, $6, $7)"
-	_, err := r.db.Exec(ctx, query, s.Id, s.Name, s.Status, s.CreatedAt, s.CreatedBy, s.UpdatedAt, s.UpdatedBy)
+	query := "INSERT INTO hr.shift_groups (id, institution_id, name, status, created_at, created_by, updated_at, updated_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+	_, err := r.conn(ctx).Exec(ctx, query, s.Id, s.InstitutionId, s.Name, s.Status, s.CreatedAt, s.CreatedBy, s.UpdatedAt, s.UpdatedBy)
 	return err
 }
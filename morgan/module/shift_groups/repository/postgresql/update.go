@@ -7,7 +7,7 @@ import (
 )
 
 func (r *Repository) Update(ctx context.Context, s *domain.ShiftGroup) error {
-	query := "UPDATE hr.shift_groups SET name = $1, status = $2, updated_at = $3, updated_by = $4 WHERE id = $5"
-	_, err := r.db.Exec(ctx, query, s.Name, s.Status, s.UpdatedAt, s.UpdatedBy, s.Id)
+	query := "UPDATE hr.shift_groups SET name = $1, status = $2, updated_at = $3, updated_by = $4 WHERE id = $5 AND institution_id = $6"
+	_, err := r.conn(ctx).Exec(ctx, query, s.Name, s.Status, s.UpdatedAt, s.UpdatedBy, s.Id, s.InstitutionId)
 	return err
 }
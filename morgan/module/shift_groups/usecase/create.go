@@ -5,18 +5,28 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
 )
 
 func (u *UseCase) Create(ctx context.Context, shiftGroup *domain.ShiftGroup) error {
-	if len(shiftGroup.Name) > 2 {
-		return &errors.AppError{Code: 400, Type: "BAD_REQUEST", Message: "Name too long, max 2 characters"}
+	if len(shiftGroup.Name) > 100 {
+		return &errors.AppError{Code: 400, Type: "BAD_REQUEST", Message: "Name too long, max 100 characters"}
 	}
 	shiftGroup.Id = uuid.NewString()
 	now := time.Now()
 	shiftGroup.CreatedAt = now
 	shiftGroup.UpdatedAt = now
 	// Add validation here if needed
-	return u.repo.Store(ctx, shiftGroup)
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repo.Store(ctx, shiftGroup); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entityShiftGroup, EntityId: shiftGroup.Id, Action: audit.ActionCreate, After: shiftGroup})
+	}); err != nil {
+		return err
+	}
+	return nil
 }
@@ -2,8 +2,24 @@ package usecase
 
 import (
 	"context"
+
+	"github.com/siakup/morgan-be/libraries/audit"
 )
 
-func (u *UseCase) Delete(ctx context.Context, id string, deletedBy string) error {
-	return u.repo.Delete(ctx, id, deletedBy)
+func (u *UseCase) Delete(ctx context.Context, institutionId string, id string, deletedBy string) error {
+	existing, err := u.findOwned(ctx, institutionId, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repo.Delete(ctx, institutionId, id, deletedBy); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entityShiftGroup, EntityId: id, Action: audit.ActionDelete, Before: existing})
+	}); err != nil {
+		return err
+	}
+	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
 )
 
-func (u *UseCase) FindByID(ctx context.Context, id string) (*domain.ShiftGroup, error) {
-	return u.repo.FindByID(ctx, id)
+// FindByID returns a shift group of the institution or a shared one.
+func (u *UseCase) FindByID(ctx context.Context, institutionId string, id string) (*domain.ShiftGroup, error) {
+	return u.find(ctx, institutionId, id)
 }
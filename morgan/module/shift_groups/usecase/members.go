@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+	"net/http"
+	"sort"
+
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
+)
+
+// FindMembers lists the users of the institution that belong to a shift group it can see.
+func (u *UseCase) FindMembers(ctx context.Context, id string, institutionId string) ([]string, error) {
+	if _, err := u.find(ctx, institutionId, id); err != nil {
+		return nil, err
+	}
+
+	return u.repo.FindMemberIDs(ctx, id, institutionId)
+}
+
+// SetMembers replaces the members of a shift group within the institution.
+// Users from another institution are rejected.
+func (u *UseCase) SetMembers(ctx context.Context, cmd domain.SetMembersCommand) ([]string, error) {
+	before, err := u.FindMembers(ctx, cmd.ShiftGroupId, cmd.InstitutionId)
+	if err != nil {
+		return nil, err
+	}
+
+	userIds := unique(cmd.UserIds)
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repo.ReplaceMembers(ctx, cmd.ShiftGroupId, cmd.InstitutionId, userIds, cmd.UpdatedBy); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{
+			Entity:   entityShiftGroup,
+			EntityId: cmd.ShiftGroupId,
+			Action:   audit.ActionUpdate,
+			Before:   map[string]any{"members": before},
+			After:    map[string]any{"members": userIds},
+		})
+	}); err != nil {
+		if errs.Is(err, domain.ErrUnknownMember) {
+			return nil, errors.FromStatus(http.StatusUnprocessableEntity, "Members must be users of the institution")
+		}
+		return nil, err
+	}
+	return userIds, nil
+}
+
+// unique returns the sorted distinct values of ids.
+func unique(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
@@ -4,14 +4,34 @@ import (
 	"context"
 	"time"
 
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
 )
 
 func (u *UseCase) Update(ctx context.Context, shiftGroup *domain.ShiftGroup) error {
-	if len(shiftGroup.Name) > 2 {
-		return &errors.AppError{Code: 400, Type: "BAD_REQUEST", Message: "Name too long, max 2 characters"}
+	if len(shiftGroup.Name) > 100 {
+		return &errors.AppError{Code: 400, Type: "BAD_REQUEST", Message: "Name too long, max 100 characters"}
 	}
+
+	if shiftGroup.InstitutionId == nil {
+		return errors.BadRequest("Missing institution")
+	}
+
+	existing, err := u.findOwned(ctx, *shiftGroup.InstitutionId, shiftGroup.Id)
+	if err != nil {
+		return err
+	}
+
 	shiftGroup.UpdatedAt = time.Now()
-	return u.repo.Update(ctx, shiftGroup)
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repo.Update(ctx, shiftGroup); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entityShiftGroup, EntityId: shiftGroup.Id, Action: audit.ActionUpdate, Before: existing, After: shiftGroup})
+	}); err != nil {
+		return err
+	}
+	return nil
 }
@@ -1,6 +1,11 @@
 package usecase
 
 import (
+	"context"
+
+	"github.com/siakup/morgan-be/framework/postgres"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
 )
 
@@ -8,10 +13,42 @@ var _ domain.UseCase = (*UseCase)(nil)
 
 // UseCase implements the domain.UseCase interface.
 type UseCase struct {
-	repo domain.ShiftGroupRepository
+	repo     domain.ShiftGroupRepository
+	tx       postgres.Transactor
+	recorder audit.Recorder
 }
 
 // NewUseCase creates a new instance of the UseCase.
-func NewUseCase(repo domain.ShiftGroupRepository) *UseCase {
-	return &UseCase{repo: repo}
+func NewUseCase(repo domain.ShiftGroupRepository, tx postgres.Transactor, recorder audit.Recorder) *UseCase {
+	return &UseCase{repo: repo, tx: tx, recorder: recorder}
+}
+
+// entityShiftGroup is the audit entity name of a shift group.
+const entityShiftGroup = "shift_group"
+
+// find returns a shift group the institution can see: one of its own or a shared one.
+func (u *UseCase) find(ctx context.Context, institutionId string, id string) (*domain.ShiftGroup, error) {
+	existing, err := u.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || (existing.InstitutionId != nil && *existing.InstitutionId != institutionId) {
+		return nil, errors.NotFound("Shift Group not found")
+	}
+
+	return existing, nil
+}
+
+// findOwned returns a shift group of the institution. Shared shift groups are not owned by any
+// institution, so none can change them.
+func (u *UseCase) findOwned(ctx context.Context, institutionId string, id string) (*domain.ShiftGroup, error) {
+	existing, err := u.find(ctx, institutionId, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing.InstitutionId == nil {
+		return nil, errors.Forbidden("Shared shift groups cannot be modified")
+	}
+
+	return existing, nil
 }
@@ -0,0 +1,168 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	liberrors "github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
+	"github.com/siakup/morgan-be/morgan/module/shift_groups/usecase"
+	"github.com/siakup/morgan-be/morgan/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUseCase_ShiftGroups(t *testing.T) {
+	mockRepo := new(mocks.ShiftGroupsRepositoryMock)
+	mockRecorder := new(mocks.AuditRecorderMock)
+	mockRecorder.On("Record", mock.Anything, mock.Anything).Return(nil).Maybe()
+	uc := usecase.NewUseCase(mockRepo, new(mocks.TransactorMock), mockRecorder)
+
+	institutionId := "inst-1"
+	otherInstitutionId := "inst-2"
+
+	assertErrorType := func(t *testing.T, err error, want liberrors.ErrorType) {
+		t.Helper()
+		appErr, ok := err.(*liberrors.AppError)
+		if assert.True(t, ok, "error %v is not an AppError", err) {
+			assert.Equal(t, want, appErr.Type)
+		}
+	}
+
+	t.Run("FindByID", func(t *testing.T) {
+		ctx := context.Background()
+		shiftGroup := &domain.ShiftGroup{Id: "sg1", InstitutionId: &institutionId}
+
+		mockRepo.On("FindByID", mock.Anything, "sg1").Return(shiftGroup, nil).Once()
+
+		res, err := uc.FindByID(ctx, institutionId, "sg1")
+		assert.NoError(t, err)
+		assert.Equal(t, shiftGroup, res)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("FindByID_Shared", func(t *testing.T) {
+		ctx := context.Background()
+		shiftGroup := &domain.ShiftGroup{Id: "sg1"}
+
+		mockRepo.On("FindByID", mock.Anything, "sg1").Return(shiftGroup, nil).Once()
+
+		res, err := uc.FindByID(ctx, institutionId, "sg1")
+		assert.NoError(t, err)
+		assert.Equal(t, shiftGroup, res)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("FindByID_OtherInstitution", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", mock.Anything, "sg1").Return(&domain.ShiftGroup{Id: "sg1", InstitutionId: &otherInstitutionId}, nil).Once()
+
+		res, err := uc.FindByID(ctx, institutionId, "sg1")
+		assert.Nil(t, res)
+		assertErrorType(t, err, liberrors.ErrorTypeNotFound)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("FindByID_NotFound", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", mock.Anything, "missing").Return(nil, nil).Once()
+
+		res, err := uc.FindByID(ctx, institutionId, "missing")
+		assert.Nil(t, res)
+		assertErrorType(t, err, liberrors.ErrorTypeNotFound)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		ctx := context.Background()
+		shiftGroup := &domain.ShiftGroup{InstitutionId: &institutionId, Name: "IT", Status: true}
+
+		mockRepo.On("Store", mock.Anything, mock.MatchedBy(func(sg *domain.ShiftGroup) bool {
+			return sg.Id != "" && sg.InstitutionId == &institutionId
+		})).Return(nil).Once()
+
+		err := uc.Create(ctx, shiftGroup)
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		ctx := context.Background()
+		shiftGroup := &domain.ShiftGroup{Id: "sg1", InstitutionId: &institutionId, Name: "IT Updated"}
+
+		mockRepo.On("FindByID", mock.Anything, "sg1").Return(&domain.ShiftGroup{Id: "sg1", InstitutionId: &institutionId, Name: "IT"}, nil).Once()
+		mockRepo.On("Update", mock.Anything, shiftGroup).Return(nil).Once()
+
+		err := uc.Update(ctx, shiftGroup)
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Update_Shared", func(t *testing.T) {
+		ctx := context.Background()
+		shiftGroup := &domain.ShiftGroup{Id: "sg1", InstitutionId: &institutionId, Name: "IT Updated"}
+
+		mockRepo.On("FindByID", mock.Anything, "sg1").Return(&domain.ShiftGroup{Id: "sg1", Name: "IT"}, nil).Once()
+
+		err := uc.Update(ctx, shiftGroup)
+		assertErrorType(t, err, liberrors.ErrorTypeForbidden)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", mock.Anything, "sg1").Return(&domain.ShiftGroup{Id: "sg1", InstitutionId: &institutionId}, nil).Once()
+		mockRepo.On("Delete", mock.Anything, institutionId, "sg1", "user-1").Return(nil).Once()
+
+		err := uc.Delete(ctx, institutionId, "sg1", "user-1")
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Delete_OtherInstitution", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", mock.Anything, "sg1").Return(&domain.ShiftGroup{Id: "sg1", InstitutionId: &otherInstitutionId}, nil).Once()
+
+		err := uc.Delete(ctx, institutionId, "sg1", "user-1")
+		assertErrorType(t, err, liberrors.ErrorTypeNotFound)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("FindMembers_Shared", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", mock.Anything, "sg1").Return(&domain.ShiftGroup{Id: "sg1"}, nil).Once()
+		mockRepo.On("FindMemberIDs", mock.Anything, "sg1", institutionId).Return([]string{"u1"}, nil).Once()
+
+		res, err := uc.FindMembers(ctx, "sg1", institutionId)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u1"}, res)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("SetMembers_OtherInstitution", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", mock.Anything, "sg1").Return(&domain.ShiftGroup{Id: "sg1", InstitutionId: &otherInstitutionId}, nil).Once()
+
+		res, err := uc.SetMembers(ctx, domain.SetMembersCommand{ShiftGroupId: "sg1", InstitutionId: institutionId, UserIds: []string{"u1"}})
+		assert.Nil(t, res)
+		assertErrorType(t, err, liberrors.ErrorTypeNotFound)
+
+		mockRepo.AssertExpectations(t)
+	})
+}
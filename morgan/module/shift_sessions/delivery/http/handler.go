@@ -1,16 +1,24 @@
 package http
 
 import (
-	"net/http"
-
 	"github.com/gofiber/fiber/v2"
-	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/openapi"
 	"github.com/siakup/morgan-be/libraries/responses"
-	"github.com/siakup/morgan-be/libraries/validation"
 	"github.com/siakup/morgan-be/morgan/module/shift_sessions/domain"
 )
 
+// Permission codes guarding the shift_sessions routes.
+const (
+	PermissionView   = "shift_sessions.schedule.shift_sessions.view"
+	PermissionCreate = "shift_sessions.schedule.shift_sessions.create"
+	PermissionEdit   = "shift_sessions.schedule.shift_sessions.edit"
+	PermissionDelete = "shift_sessions.schedule.shift_sessions.delete"
+)
+
+// Permissions lists every permission code required by the shift_sessions routes.
+var Permissions = []string{PermissionView, PermissionCreate, PermissionEdit, PermissionDelete}
+
 // ShiftSessionHandler handles HTTP requests for shift sessions module.
 type ShiftSessionHandler struct {
 	useCase domain.UseCase
@@ -26,21 +34,12 @@ func NewShiftSessionHandler(useCase domain.UseCase, auth *middleware.Authorizati
 }
 
 // RegisterRoutes registers the routes for the shift sessions module.
-func (h *ShiftSessionHandler) RegisterRoutes(app *fiber.App) {
-	group := app.Group("/shift-sessions", middleware.TraceMiddleware)
-
-	group.Get("/", h.auth.Authenticate("shift_sessions.schedule.shift_sessions.view"), h.GetShiftSessions)
-	group.Get("/:id", h.auth.Authenticate("shift_sessions.schedule.shift_sessions.view"), h.GetShiftSessionByID)
-	group.Post("/", h.auth.Authenticate("shift_sessions.schedule.shift_sessions.create"), validation.ValidateBody(func() interface{} { return &CreateShiftSessionRequest{} }), h.CreateShiftSession)
-	group.Put("/:id", h.auth.Authenticate("shift_sessions.schedule.shift_sessions.edit"), validation.ValidateBody(func() interface{} { return &UpdateShiftSessionRequest{} }), h.UpdateShiftSession)
-	group.Delete("/:id", h.auth.Authenticate("shift_sessions.schedule.shift_sessions.delete"), h.DeleteShiftSession)
+func (h *ShiftSessionHandler) RegisterRoutes(router fiber.Router) {
+	router.Use("/shift-sessions", middleware.TraceMiddleware)
+	openapi.Mount(router, h.auth.Authenticate, h.Endpoints())
 }
 
-// handleError handles errors by mapping them to standardized responses.
+// handleError renders err with the standard error envelope.
 func (h *ShiftSessionHandler) handleError(c *fiber.Ctx, err error) error {
-	if appErr, ok := err.(*errors.AppError); ok {
-		return c.Status(appErr.Code).JSON(responses.Fail(string(appErr.Type), appErr.Message))
-	}
-
-	return c.Status(http.StatusInternalServerError).JSON(responses.Fail("SYSTEM_ERROR", err.Error()))
+	return responses.HandleError(c, err)
 }
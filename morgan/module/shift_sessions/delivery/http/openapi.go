@@ -0,0 +1,26 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"github.com/siakup/morgan-be/libraries/validation"
+)
+
+type listQuery struct {
+	Page     int    `query:"page" default:"1"`
+	PageSize int    `query:"page_size" default:"10"`
+	Search   string `query:"search"`
+}
+
+// Endpoints lists the shift sessions routes: RegisterRoutes mounts them and the OpenAPI document describes them.
+func (h *ShiftSessionHandler) Endpoints() []openapi.Endpoint {
+	return []openapi.Endpoint{
+		{Method: fiber.MethodGet, Path: "/shift-sessions", Summary: "List shift sessions", Permissions: []string{PermissionView}, Query: listQuery{}, Response: []GetShiftSessionsResponse{}, Paginated: true, Handlers: []fiber.Handler{h.GetShiftSessions}},
+		{Method: fiber.MethodGet, Path: "/shift-sessions/:id", Summary: "Get a shift session", Permissions: []string{PermissionView}, Response: GetShiftSessionByIDResponse{}, Handlers: []fiber.Handler{h.GetShiftSessionByID}},
+		{Method: fiber.MethodPost, Path: "/shift-sessions", Summary: "Create a shift session", Permissions: []string{PermissionCreate}, Request: CreateShiftSessionRequest{}, Response: CreateShiftSessionResponse{}, Status: http.StatusCreated, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &CreateShiftSessionRequest{} }), h.CreateShiftSession}},
+		{Method: fiber.MethodPut, Path: "/shift-sessions/:id", Summary: "Update a shift session", Permissions: []string{PermissionEdit}, Request: UpdateShiftSessionRequest{}, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &UpdateShiftSessionRequest{} }), h.UpdateShiftSession}},
+		{Method: fiber.MethodDelete, Path: "/shift-sessions/:id", Summary: "Delete a shift session", Permissions: []string{PermissionDelete}, Handlers: []fiber.Handler{h.DeleteShiftSession}},
+	}
+}
@@ -1,12 +1,13 @@
 package shift_sessions
 
 import (
-	gofiber "github.com/gofiber/fiber/v2"
-	"go.uber.org/fx"
+	"github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/registry"
 	"github.com/siakup/morgan-be/morgan/module/shift_sessions/delivery/http"
 	"github.com/siakup/morgan-be/morgan/module/shift_sessions/domain"
 	"github.com/siakup/morgan-be/morgan/module/shift_sessions/repository/postgresql"
 	"github.com/siakup/morgan-be/morgan/module/shift_sessions/usecase"
+	"go.uber.org/fx"
 )
 
 // Module exports the roles module for Fx.
@@ -24,9 +25,16 @@ var Module = fx.Options(
 		),
 		http.NewShiftSessionHandler,
 	),
-	fx.Invoke(registerRoutes),
+	registry.Provide(newModule),
 )
 
-func registerRoutes(h *http.ShiftSessionHandler, app *gofiber.App) {
-	h.RegisterRoutes(app)
+func newModule(h *http.ShiftSessionHandler) registry.Module {
+	return registry.Module{
+		Name:        "shift_sessions",
+		Version:     fiber.V1,
+		BasePath:    "/shift-sessions",
+		Permissions: http.Permissions,
+		Endpoints:   h.Endpoints(),
+		Register:    h.RegisterRoutes,
+	}
 }
@@ -17,7 +17,7 @@ AND deleted_at IS NULL
 // Delete soft removes a shift session from the database.
 func (r *Repository) Delete(ctx context.Context, id string, deletedBy string) error {
 
-	_, err := r.db.Exec(ctx, queryDelete, pgx.NamedArgs{
+	_, err := r.conn(ctx).Exec(ctx, queryDelete, pgx.NamedArgs{
 		"id":         id,
 		"deleted_by": deletedBy,
 	})
@@ -1,9 +1,11 @@
 package postgresql
 
 import (
+	"context"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/siakup/morgan-be/framework/postgres"
 	"github.com/siakup/morgan-be/morgan/module/shift_sessions/domain"
 )
 
@@ -32,3 +34,8 @@ type Repository struct {
 func NewRepository(db *pgxpool.Pool) *Repository {
 	return &Repository{db: db}
 }
+
+// conn returns the transaction bound to ctx, so writes join the usecase's transaction.
+func (r *Repository) conn(ctx context.Context) postgres.Querier {
+	return postgres.Conn(ctx, r.db)
+}
@@ -17,7 +17,7 @@ var queryStore = `
 `
 
 func (r *Repository) Store(ctx context.Context, shiftSession *domain.ShiftSession) error {
-	rows, err := r.db.Query(ctx, queryStore, pgx.NamedArgs{
+	rows, err := r.conn(ctx).Query(ctx, queryStore, pgx.NamedArgs{
 		"name":       shiftSession.Name,
 		"start":      shiftSession.Start,
 		"end":        shiftSession.End,
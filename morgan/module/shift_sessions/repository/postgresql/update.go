@@ -21,7 +21,7 @@ var queryUpdate = `
 
 // Update modifies an existing shift session record.
 func (r *Repository) Update(ctx context.Context, shiftSession *domain.ShiftSession) error {
-	_, err := r.db.Exec(ctx, queryUpdate, pgx.NamedArgs{
+	_, err := r.conn(ctx).Exec(ctx, queryUpdate, pgx.NamedArgs{
 		"id":         shiftSession.Id,
 		"name":       shiftSession.Name,
 		"start":      shiftSession.Start,
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/morgan/module/shift_sessions/domain"
 )
@@ -15,7 +16,13 @@ func (u *UseCase) Create(ctx context.Context, shiftSession *domain.ShiftSession)
 
 	logger := zerolog.Ctx(ctx)
 
-	if err := u.repository.Store(ctx, shiftSession); err != nil {
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.Store(ctx, shiftSession); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entityShiftSession, EntityId: shiftSession.Id, Action: audit.ActionCreate, After: shiftSession})
+	}); err != nil {
 		logger.Error().
 			Str("func", "repository.Store").
 			Err(err).
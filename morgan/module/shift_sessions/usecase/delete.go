@@ -2,8 +2,11 @@ package usecase
 
 import (
 	"context"
+	errs "errors"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
 )
 
@@ -14,7 +17,21 @@ func (u *UseCase) Delete(ctx context.Context, id string, deletedBy string) error
 
 	logger := zerolog.Ctx(ctx)
 
-	if err := u.repository.Delete(ctx, id, deletedBy); err != nil {
+	current, err := u.repository.FindByID(ctx, id)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return errors.NotFound("shift session not found")
+		}
+		return errors.InternalServerError("failed to find shift session")
+	}
+
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.Delete(ctx, id, deletedBy); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entityShiftSession, EntityId: id, Action: audit.ActionDelete, Before: current})
+	}); err != nil {
 		logger.Error().
 			Str("func", "repository.Delete").
 			Err(err).
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/morgan/module/shift_sessions/domain"
 )
@@ -18,7 +19,7 @@ func (u *UseCase) Update(ctx context.Context, shiftSession *domain.ShiftSession)
 	logger := zerolog.Ctx(ctx)
 
 	// Verify exists
-	_, err := u.repository.FindByID(ctx, shiftSession.Id)
+	current, err := u.repository.FindByID(ctx, shiftSession.Id)
 	if err != nil {
 		if errs.Is(err, pgx.ErrNoRows) {
 			return errors.NotFound("shift session not found")
@@ -26,7 +27,13 @@ func (u *UseCase) Update(ctx context.Context, shiftSession *domain.ShiftSession)
 		return errors.InternalServerError("failed to find shift session")
 	}
 
-	if err := u.repository.Update(ctx, shiftSession); err != nil {
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.Update(ctx, shiftSession); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entityShiftSession, EntityId: shiftSession.Id, Action: audit.ActionUpdate, Before: current, After: shiftSession})
+	}); err != nil {
 		logger.Error().
 			Str("func", "repository.Update").
 			Err(err).
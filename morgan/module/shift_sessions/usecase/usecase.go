@@ -3,6 +3,8 @@ package usecase
 import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"github.com/siakup/morgan-be/framework/postgres"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/morgan/module/shift_sessions/domain"
 )
 
@@ -11,13 +13,20 @@ var _ domain.UseCase = (*UseCase)(nil)
 // UseCase implements the logic for shift sessions management.
 type UseCase struct {
 	repository domain.ShiftSessionRepository
+	tx         postgres.Transactor
+	recorder   audit.Recorder
 	tracer     trace.Tracer
 }
 
 // NewUseCase creates a new instance of ShiftSessions UseCase.
-func NewUseCase(repository domain.ShiftSessionRepository) *UseCase {
+func NewUseCase(repository domain.ShiftSessionRepository, tx postgres.Transactor, recorder audit.Recorder) *UseCase {
 	return &UseCase{
 		repository: repository,
+		tx:         tx,
+		recorder:   recorder,
 		tracer:     otel.Tracer("shift_sessions"),
 	}
 }
+
+// entityShiftSession is the audit entity name of a shift session.
+const entityShiftSession = "shift_session"
@@ -15,7 +15,9 @@ import (
 
 func TestUseCase_ShiftSessions(t *testing.T) {
 	mockRepo := new(mocks.ShiftSessionsRepositoryMock)
-	uc := usecase.NewUseCase(mockRepo)
+	mockRecorder := new(mocks.AuditRecorderMock)
+	mockRecorder.On("Record", mock.Anything, mock.Anything).Return(nil).Maybe()
+	uc := usecase.NewUseCase(mockRepo, new(mocks.TransactorMock), mockRecorder)
 
 	t.Run("FindAll", func(t *testing.T) {
 		ctx := context.Background()
@@ -91,6 +93,7 @@ func TestUseCase_ShiftSessions(t *testing.T) {
 
 		deletedBy := "user-1"
 
+		mockRepo.On("FindByID", mock.Anything, id).Return(&domain.ShiftSession{Id: id}, nil).Once()
 		mockRepo.On("Delete", mock.Anything, id, deletedBy).Return(nil).Once()
 
 		err := uc.Delete(ctx, id, deletedBy)
@@ -143,6 +146,7 @@ func TestUseCase_ShiftSessions(t *testing.T) {
 
 	t.Run("Delete_Error", func(t *testing.T) {
 		ctx := context.Background()
+		mockRepo.On("FindByID", mock.Anything, "ss1").Return(&domain.ShiftSession{Id: "ss1"}, nil).Once()
 		mockRepo.On("Delete", mock.Anything, "ss1", "user-1").Return(errors.New("delete failed")).Once()
 
 		err := uc.Delete(ctx, "ss1", "user-1")
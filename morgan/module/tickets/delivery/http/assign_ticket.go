@@ -0,0 +1,49 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/validation"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+type (
+	// AssignTicketRequest replaces the assignment, omitted fields are cleared.
+	AssignTicketRequest struct {
+		ShiftGroupId string `json:"shift_group_id" validate:"omitempty,uuid"`
+		AssigneeId   string `json:"assignee_id" validate:"omitempty,uuid"`
+	}
+)
+
+// AssignTicket handles PATCH /tickets/:id/assign
+func (h *TicketHandler) AssignTicket(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	req, ok := c.Locals(validation.ValidatedBodyKey).(*AssignTicketRequest)
+	if !ok || req == nil {
+		return h.handleError(c, errors.BadRequest("Invalid request body"))
+	}
+
+	userId, ok := c.Locals(middleware.XUserIdKey).(string)
+	if !ok || userId == "" {
+		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
+	}
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	ticket, err := h.useCase.Assign(ctx, domain.AssignTicketCommand{
+		Id:            c.Params("id"),
+		InstitutionId: institutionId,
+		ShiftGroupId:  req.ShiftGroupId,
+		AssigneeId:    req.AssigneeId,
+		AssignedBy:    userId,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success(toTicketResponse(ticket), "Ticket assigned"))
+}
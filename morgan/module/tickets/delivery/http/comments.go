@@ -0,0 +1,65 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/validation"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+type (
+	AddCommentRequest struct {
+		Body string `json:"body" validate:"required"`
+	}
+)
+
+// GetComments handles GET /tickets/:id/comments
+func (h *TicketHandler) GetComments(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	comments, err := h.useCase.FindComments(ctx, institutionId, c.Params("id"))
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	result := make([]CommentResponse, len(comments))
+	for i, comment := range comments {
+		result[i] = toCommentResponse(comment)
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success(result, "Ticket comments retrieved"))
+}
+
+// AddComment handles POST /tickets/:id/comments
+func (h *TicketHandler) AddComment(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	req, ok := c.Locals(validation.ValidatedBodyKey).(*AddCommentRequest)
+	if !ok || req == nil {
+		return h.handleError(c, errors.BadRequest("Invalid request body"))
+	}
+
+	userId, ok := c.Locals(middleware.XUserIdKey).(string)
+	if !ok || userId == "" {
+		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
+	}
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	comment, err := h.useCase.AddComment(ctx, domain.AddCommentCommand{
+		TicketId:      c.Params("id"),
+		InstitutionId: institutionId,
+		AuthorId:      userId,
+		Body:          req.Body,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(responses.Success(toCommentResponse(comment), "Comment added"))
+}
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/validation"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+type (
+	CreateTicketRequest struct {
+		Title           string `json:"title" validate:"required,max=200"`
+		Description     string `json:"description"`
+		DomainId        string `json:"domain_id" validate:"required,uuid"`
+		SeverityLevelId string `json:"severity_level_id" validate:"required,uuid"`
+		ShiftGroupId    string `json:"shift_group_id" validate:"omitempty,uuid"`
+	}
+)
+
+// CreateTicket handles POST /tickets
+func (h *TicketHandler) CreateTicket(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	req, ok := c.Locals(validation.ValidatedBodyKey).(*CreateTicketRequest)
+	if !ok || req == nil {
+		return h.handleError(c, errors.BadRequest("Invalid request body"))
+	}
+
+	userId, ok := c.Locals(middleware.XUserIdKey).(string)
+	if !ok || userId == "" {
+		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
+	}
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	ticket, err := h.useCase.Create(ctx, domain.CreateTicketCommand{
+		InstitutionId:   institutionId,
+		Title:           req.Title,
+		Description:     req.Description,
+		DomainId:        req.DomainId,
+		SeverityLevelId: req.SeverityLevelId,
+		ShiftGroupId:    req.ShiftGroupId,
+		ReporterId:      userId,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(responses.Success(toTicketResponse(ticket), "Ticket created"))
+}
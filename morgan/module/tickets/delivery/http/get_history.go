@@ -0,0 +1,28 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+)
+
+// GetHistory handles GET /tickets/:id/history
+func (h *TicketHandler) GetHistory(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	history, err := h.useCase.FindHistory(ctx, institutionId, c.Params("id"))
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	result := make([]HistoryResponse, len(history))
+	for i, entry := range history {
+		result[i] = toHistoryResponse(entry)
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success(result, "Ticket history retrieved"))
+}
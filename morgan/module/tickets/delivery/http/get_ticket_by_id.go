@@ -0,0 +1,23 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+)
+
+// GetTicketByID handles GET /tickets/:id
+func (h *TicketHandler) GetTicketByID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	ticket, err := h.useCase.Get(ctx, institutionId, c.Params("id"))
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success(toTicketResponse(ticket), "Ticket retrieved"))
+}
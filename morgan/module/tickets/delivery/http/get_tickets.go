@@ -0,0 +1,54 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/types"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// GetTickets handles GET /tickets
+func (h *TicketHandler) GetTickets(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	page, pageSize := parsePagination(c)
+
+	filter := domain.TicketFilter{
+		Pagination: types.Pagination{
+			Page: page,
+			Size: pageSize,
+		},
+		InstitutionId:   institutionId,
+		Status:          c.Query("status"),
+		DomainId:        c.Query("domain_id"),
+		SeverityLevelId: c.Query("severity_level_id"),
+		ShiftGroupId:    c.Query("shift_group_id"),
+		AssigneeId:      c.Query("assignee_id"),
+		ReporterId:      c.Query("reporter_id"),
+		Overdue:         c.QueryBool("overdue"),
+	}
+
+	tickets, total, err := h.useCase.FindAll(ctx, filter)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	result := make([]TicketResponse, len(tickets))
+	for i, t := range tickets {
+		result[i] = toTicketResponse(t)
+	}
+
+	meta := &responses.Meta{
+		Page:       page,
+		Size:       pageSize,
+		Total:      total,
+		TotalPages: (int(total) + pageSize - 1) / pageSize,
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.SuccessWithMeta(result, "Tickets retrieved", meta))
+}
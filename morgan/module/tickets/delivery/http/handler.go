@@ -0,0 +1,143 @@
+package http
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// Permission codes guarding the tickets routes.
+const (
+	PermissionView    = "tickets.ticketing.tickets.view"
+	PermissionCreate  = "tickets.ticketing.tickets.create"
+	PermissionEdit    = "tickets.ticketing.tickets.edit"
+	PermissionAssign  = "tickets.ticketing.tickets.assign"
+	PermissionComment = "tickets.ticketing.tickets.comment"
+)
+
+// Permissions lists every permission code required by the tickets routes.
+var Permissions = []string{PermissionView, PermissionCreate, PermissionEdit, PermissionAssign, PermissionComment}
+
+// TicketHandler handles HTTP requests for tickets module.
+type TicketHandler struct {
+	useCase domain.UseCase
+	auth    *middleware.AuthorizationMiddleware
+}
+
+// NewTicketHandler creates a new TicketHandler.
+func NewTicketHandler(useCase domain.UseCase, auth *middleware.AuthorizationMiddleware) *TicketHandler {
+	return &TicketHandler{
+		useCase: useCase,
+		auth:    auth,
+	}
+}
+
+// RegisterRoutes registers the routes for the tickets module.
+func (h *TicketHandler) RegisterRoutes(router fiber.Router) {
+	router.Use("/tickets", middleware.TraceMiddleware)
+	openapi.Mount(router, h.auth.Authenticate, h.Endpoints())
+}
+
+// handleError renders err with the standard error envelope.
+func (h *TicketHandler) handleError(c *fiber.Ctx, err error) error {
+	return responses.HandleError(c, err)
+}
+
+// maxPageSize caps the page_size query parameter.
+const maxPageSize = 100
+
+// parsePagination reads page and page_size from the query, clamping page to at least 1 and page_size to 1..maxPageSize.
+func parsePagination(c *fiber.Ctx) (int, int) {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size", "10"))
+
+	return max(page, 1), min(max(pageSize, 1), maxPageSize)
+}
+
+// TicketResponse is the JSON representation of a ticket.
+type TicketResponse struct {
+	Id              string     `json:"id"`
+	Title           string     `json:"title"`
+	Description     *string    `json:"description"`
+	DomainId        string     `json:"domain_id"`
+	SeverityLevelId string     `json:"severity_level_id"`
+	ReporterId      string     `json:"reporter_id"`
+	AssigneeId      *string    `json:"assignee_id"`
+	ShiftGroupId    *string    `json:"shift_group_id"`
+	Status          string     `json:"status"`
+	ResponseDueAt   *time.Time `json:"response_due_at"`
+	ResolutionDueAt *time.Time `json:"resolution_due_at"`
+	RespondedAt     *time.Time `json:"responded_at"`
+	ResolvedAt      *time.Time `json:"resolved_at"`
+	ClosedAt        *time.Time `json:"closed_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+func toTicketResponse(t *domain.Ticket) TicketResponse {
+	return TicketResponse{
+		Id:              t.Id,
+		Title:           t.Title,
+		Description:     t.Description,
+		DomainId:        t.DomainId,
+		SeverityLevelId: t.SeverityLevelId,
+		ReporterId:      t.ReporterId,
+		AssigneeId:      t.AssigneeId,
+		ShiftGroupId:    t.ShiftGroupId,
+		Status:          t.Status,
+		ResponseDueAt:   t.ResponseDueAt,
+		ResolutionDueAt: t.ResolutionDueAt,
+		RespondedAt:     t.RespondedAt,
+		ResolvedAt:      t.ResolvedAt,
+		ClosedAt:        t.ClosedAt,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+	}
+}
+
+// CommentResponse is the JSON representation of a ticket comment.
+type CommentResponse struct {
+	Id        string    `json:"id"`
+	AuthorId  string    `json:"author_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func toCommentResponse(c *domain.TicketComment) CommentResponse {
+	return CommentResponse{
+		Id:        c.Id,
+		AuthorId:  c.AuthorId,
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+// HistoryResponse is the JSON representation of a ticket history entry.
+type HistoryResponse struct {
+	Id        string    `json:"id"`
+	ActorId   string    `json:"actor_id"`
+	Action    string    `json:"action"`
+	Field     *string   `json:"field"`
+	OldValue  *string   `json:"old_value"`
+	NewValue  *string   `json:"new_value"`
+	Note      *string   `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func toHistoryResponse(h *domain.TicketHistory) HistoryResponse {
+	return HistoryResponse{
+		Id:        h.Id,
+		ActorId:   h.ActorId,
+		Action:    h.Action,
+		Field:     h.Field,
+		OldValue:  h.OldValue,
+		NewValue:  h.NewValue,
+		Note:      h.Note,
+		CreatedAt: h.CreatedAt,
+	}
+}
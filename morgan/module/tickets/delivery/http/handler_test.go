@@ -0,0 +1,189 @@
+package http_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/validation"
+	deliverhttp "github.com/siakup/morgan-be/morgan/module/tickets/delivery/http"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+	"github.com/siakup/morgan-be/morgan/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupTicketApp(useCase domain.UseCase) *fiber.App {
+	handler := deliverhttp.NewTicketHandler(useCase, nil)
+
+	app := fiber.New()
+
+	// Mock middleware
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals(middleware.XUserIdKey, "user-1")
+		c.Locals(middleware.XInstitutionId, "inst-1")
+		return c.Next()
+	})
+
+	app.Get("/tickets", handler.GetTickets)
+	app.Post("/tickets", validation.ValidateBody(func() interface{} { return &deliverhttp.CreateTicketRequest{} }), handler.CreateTicket)
+	app.Get("/tickets/:id", handler.GetTicketByID)
+	app.Put("/tickets/:id", validation.ValidateBody(func() interface{} { return &deliverhttp.UpdateTicketRequest{} }), handler.UpdateTicket)
+	app.Patch("/tickets/:id/assign", validation.ValidateBody(func() interface{} { return &deliverhttp.AssignTicketRequest{} }), handler.AssignTicket)
+	app.Patch("/tickets/:id/status", validation.ValidateBody(func() interface{} { return &deliverhttp.TransitionTicketRequest{} }), handler.TransitionTicket)
+	app.Get("/tickets/:id/comments", handler.GetComments)
+	app.Post("/tickets/:id/comments", validation.ValidateBody(func() interface{} { return &deliverhttp.AddCommentRequest{} }), handler.AddComment)
+	app.Get("/tickets/:id/history", handler.GetHistory)
+
+	return app
+}
+
+func jsonRequest(method, target string, body interface{}) *http.Request {
+	reqBytes, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, target, bytes.NewReader(reqBytes))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestTicketHandler_GetTickets(t *testing.T) {
+	mockUseCase := new(mocks.TicketsUseCaseMock)
+	app := setupTicketApp(mockUseCase)
+
+	t.Run("PageSizeClamped", func(t *testing.T) {
+		for query, size := range map[string]int{"page_size=0": 1, "page_size=-5": 1, "page_size=1000": 100} {
+			mockUseCase.On("FindAll", mock.Anything, mock.MatchedBy(func(f domain.TicketFilter) bool {
+				return f.Page == 1 && f.Size == size
+			})).Return([]*domain.Ticket{}, int64(3), nil).Once()
+
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/tickets?page=0&"+query, nil))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		mockUseCase.On("FindAll", mock.Anything, mock.MatchedBy(func(f domain.TicketFilter) bool {
+			return f.InstitutionId == "inst-1" && f.Overdue && f.Status == domain.StatusOpen
+		})).Return([]*domain.Ticket{{Id: "t1"}}, int64(1), nil).Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/tickets?status=open&overdue=true", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestTicketHandler_CreateTicket(t *testing.T) {
+	mockUseCase := new(mocks.TicketsUseCaseMock)
+	app := setupTicketApp(mockUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		mockUseCase.On("Create", mock.Anything, domain.CreateTicketCommand{
+			InstitutionId:   "inst-1",
+			Title:           "Server down",
+			DomainId:        "7f1a1c1e-0c5d-4f59-9d6b-0a2d7a9f1c11",
+			SeverityLevelId: "8a3c7b2e-7f55-4c3e-8a0e-2b9b1f7a4d22",
+			ReporterId:      "user-1",
+		}).Return(&domain.Ticket{Id: "t1", Status: domain.StatusOpen}, nil).Once()
+
+		resp, err := app.Test(jsonRequest(http.MethodPost, "/tickets", map[string]string{
+			"title":             "Server down",
+			"domain_id":         "7f1a1c1e-0c5d-4f59-9d6b-0a2d7a9f1c11",
+			"severity_level_id": "8a3c7b2e-7f55-4c3e-8a0e-2b9b1f7a4d22",
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("BadRequest", func(t *testing.T) {
+		resp, err := app.Test(jsonRequest(http.MethodPost, "/tickets", map[string]string{"title": "Missing refs"}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestTicketHandler_TransitionTicket(t *testing.T) {
+	mockUseCase := new(mocks.TicketsUseCaseMock)
+	app := setupTicketApp(mockUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		mockUseCase.On("Transition", mock.Anything, domain.TransitionTicketCommand{
+			Id:            "t1",
+			InstitutionId: "inst-1",
+			Status:        domain.StatusResolved,
+			Note:          "fixed",
+			ActorId:       "user-1",
+		}).Return(&domain.Ticket{Id: "t1", Status: domain.StatusResolved}, nil).Once()
+
+		resp, err := app.Test(jsonRequest(http.MethodPatch, "/tickets/t1/status", map[string]string{"status": "resolved", "note": "fixed"}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("UnknownStatus", func(t *testing.T) {
+		resp, err := app.Test(jsonRequest(http.MethodPatch, "/tickets/t1/status", map[string]string{"status": "done"}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		mockUseCase.On("Transition", mock.Anything, mock.Anything).Return(nil, errors.Conflict("ticket cannot move from closed to in_progress")).Once()
+
+		resp, err := app.Test(jsonRequest(http.MethodPatch, "/tickets/t1/status", map[string]string{"status": "in_progress"}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestTicketHandler_AssignTicket(t *testing.T) {
+	mockUseCase := new(mocks.TicketsUseCaseMock)
+	app := setupTicketApp(mockUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		mockUseCase.On("Assign", mock.Anything, mock.MatchedBy(func(cmd domain.AssignTicketCommand) bool {
+			return cmd.Id == "t1" && cmd.ShiftGroupId == "3d8f5a9c-1b2e-4c7d-9e0f-6a5b4c3d2e1f" && cmd.AssignedBy == "user-1"
+		})).Return(&domain.Ticket{Id: "t1"}, nil).Once()
+
+		resp, err := app.Test(jsonRequest(http.MethodPatch, "/tickets/t1/assign", map[string]string{"shift_group_id": "3d8f5a9c-1b2e-4c7d-9e0f-6a5b4c3d2e1f"}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestTicketHandler_Comments(t *testing.T) {
+	mockUseCase := new(mocks.TicketsUseCaseMock)
+	app := setupTicketApp(mockUseCase)
+
+	t.Run("AddComment", func(t *testing.T) {
+		mockUseCase.On("AddComment", mock.Anything, domain.AddCommentCommand{
+			TicketId:      "t1",
+			InstitutionId: "inst-1",
+			AuthorId:      "user-1",
+			Body:          "on it",
+		}).Return(&domain.TicketComment{Id: "c1", TicketId: "t1", Body: "on it"}, nil).Once()
+
+		resp, err := app.Test(jsonRequest(http.MethodPost, "/tickets/t1/comments", map[string]string{"body": "on it"}))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("GetHistory_NotFound", func(t *testing.T) {
+		mockUseCase.On("FindHistory", mock.Anything, "inst-1", "t9").Return(nil, errors.NotFound("ticket not found")).Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/tickets/t9/history", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		mockUseCase.AssertExpectations(t)
+	})
+}
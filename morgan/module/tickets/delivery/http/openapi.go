@@ -0,0 +1,35 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/openapi"
+	"github.com/siakup/morgan-be/libraries/validation"
+)
+
+type listQuery struct {
+	Page            int    `query:"page" default:"1"`
+	PageSize        int    `query:"page_size" default:"10"`
+	Status          string `query:"status"`
+	DomainId        string `query:"domain_id"`
+	SeverityLevelId string `query:"severity_level_id"`
+	ShiftGroupId    string `query:"shift_group_id"`
+	AssigneeId      string `query:"assignee_id"`
+	ReporterId      string `query:"reporter_id"`
+}
+
+// Endpoints lists the tickets routes: RegisterRoutes mounts them and the OpenAPI document describes them.
+func (h *TicketHandler) Endpoints() []openapi.Endpoint {
+	return []openapi.Endpoint{
+		{Method: fiber.MethodGet, Path: "/tickets", Summary: "List tickets", Permissions: []string{PermissionView}, Query: listQuery{}, Response: []TicketResponse{}, Paginated: true, Handlers: []fiber.Handler{h.GetTickets}},
+		{Method: fiber.MethodPost, Path: "/tickets", Summary: "Create a ticket", Permissions: []string{PermissionCreate}, Request: CreateTicketRequest{}, Response: TicketResponse{}, Status: http.StatusCreated, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &CreateTicketRequest{} }), h.CreateTicket}},
+		{Method: fiber.MethodGet, Path: "/tickets/:id", Summary: "Get a ticket", Permissions: []string{PermissionView}, Response: TicketResponse{}, Handlers: []fiber.Handler{h.GetTicketByID}},
+		{Method: fiber.MethodPut, Path: "/tickets/:id", Summary: "Update a ticket", Permissions: []string{PermissionEdit}, Request: UpdateTicketRequest{}, Response: TicketResponse{}, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &UpdateTicketRequest{} }), h.UpdateTicket}},
+		{Method: fiber.MethodPatch, Path: "/tickets/:id/assign", Summary: "Assign a ticket", Permissions: []string{PermissionAssign}, Request: AssignTicketRequest{}, Response: TicketResponse{}, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &AssignTicketRequest{} }), h.AssignTicket}},
+		{Method: fiber.MethodPatch, Path: "/tickets/:id/status", Summary: "Change the status of a ticket", Permissions: []string{PermissionEdit}, Request: TransitionTicketRequest{}, Response: TicketResponse{}, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &TransitionTicketRequest{} }), h.TransitionTicket}},
+		{Method: fiber.MethodGet, Path: "/tickets/:id/comments", Summary: "List the comments of a ticket", Permissions: []string{PermissionView}, Response: []CommentResponse{}, Handlers: []fiber.Handler{h.GetComments}},
+		{Method: fiber.MethodPost, Path: "/tickets/:id/comments", Summary: "Comment on a ticket", Permissions: []string{PermissionComment}, Request: AddCommentRequest{}, Response: CommentResponse{}, Status: http.StatusCreated, Handlers: []fiber.Handler{validation.ValidateBody(func() interface{} { return &AddCommentRequest{} }), h.AddComment}},
+		{Method: fiber.MethodGet, Path: "/tickets/:id/history", Summary: "List the history of a ticket", Permissions: []string{PermissionView}, Response: []HistoryResponse{}, Handlers: []fiber.Handler{h.GetHistory}},
+	}
+}
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/responses"
+	"github.com/siakup/morgan-be/libraries/validation"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+type (
+	TransitionTicketRequest struct {
+		Status string `json:"status" validate:"required,oneof=open in_progress on_hold resolved closed cancelled"`
+		Note   string `json:"note"`
+	}
+)
+
+// TransitionTicket handles PATCH /tickets/:id/status
+func (h *TicketHandler) TransitionTicket(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	req, ok := c.Locals(validation.ValidatedBodyKey).(*TransitionTicketRequest)
+	if !ok || req == nil {
+		return h.handleError(c, errors.BadRequest("Invalid request body"))
+	}
+
+	userId, ok := c.Locals(middleware.XUserIdKey).(string)
+	if !ok || userId == "" {
+		return h.handleError(c, errors.Unauthorized("Invalid or missing user context"))
+	}
+	institutionId, _ := c.Locals(middleware.XInstitutionId).(string)
+
+	ticket, err := h.useCase.Transition(ctx, domain.TransitionTicketCommand{
+		Id:            c.Params("id"),
+		InstitutionId: institutionId,
+		Status:        req.Status,
+		Note:          req.Note,
+		ActorId:       userId,
+	})
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return c.Status(http.StatusOK).JSON(responses.Success(toTicketResponse(ticket), "Ticket status changed"))
+}
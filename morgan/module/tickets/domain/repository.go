@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/siakup/morgan-be/libraries/types"
+)
+
+// History actions recorded for a ticket.
+const (
+	ActionCreated       = "created"
+	ActionUpdated       = "updated"
+	ActionStatusChanged = "status_changed"
+	ActionAssigned      = "assigned"
+)
+
+// Ticket represents an incident reported against a domain with a given severity.
+type Ticket struct {
+	Id              string     `object:"id"`
+	InstitutionId   string     `object:"institution_id"`
+	Title           string     `object:"title"`
+	Description     *string    `object:"description"`
+	DomainId        string     `object:"domain_id"`
+	SeverityLevelId string     `object:"severity_level_id"`
+	ReporterId      string     `object:"reporter_id"`
+	AssigneeId      *string    `object:"assignee_id"`    // Nullable until assigned
+	ShiftGroupId    *string    `object:"shift_group_id"` // Nullable until assigned
+	Status          string     `object:"status"`
+	ResponseDueAt   *time.Time `object:"response_due_at"`
+	ResolutionDueAt *time.Time `object:"resolution_due_at"`
+	RespondedAt     *time.Time `object:"responded_at"`
+	ResolvedAt      *time.Time `object:"resolved_at"`
+	ClosedAt        *time.Time `object:"closed_at"`
+	CreatedAt       time.Time  `object:"created_at"`
+	UpdatedAt       time.Time  `object:"updated_at"`
+	CreatedBy       *string    `object:"created_by"`
+	UpdatedBy       *string    `object:"updated_by"`
+}
+
+// TicketComment is a message posted on a ticket.
+type TicketComment struct {
+	Id        string    `object:"id"`
+	TicketId  string    `object:"ticket_id"`
+	AuthorId  string    `object:"author_id"`
+	Body      string    `object:"body"`
+	CreatedAt time.Time `object:"created_at"`
+}
+
+// TicketHistory is a single recorded change of a ticket.
+type TicketHistory struct {
+	Id        string    `object:"id"`
+	TicketId  string    `object:"ticket_id"`
+	ActorId   string    `object:"actor_id"`
+	Action    string    `object:"action"`
+	Field     *string   `object:"field"`
+	OldValue  *string   `object:"old_value"`
+	NewValue  *string   `object:"new_value"`
+	Note      *string   `object:"note"`
+	CreatedAt time.Time `object:"created_at"`
+}
+
+// TicketFilter represents filter options for listing tickets.
+type TicketFilter struct {
+	types.Pagination
+	InstitutionId   string
+	Status          string
+	DomainId        string
+	SeverityLevelId string
+	ShiftGroupId    string
+	AssigneeId      string
+	ReporterId      string
+	Overdue         bool // Only unresolved tickets past their resolution deadline
+}
+
+// TicketRepository defines the persistence layer contract.
+type TicketRepository interface {
+	FindAll(ctx context.Context, filter TicketFilter) ([]*Ticket, int64, error)
+	FindByID(ctx context.Context, id string) (*Ticket, error)
+	// Store persists a new ticket together with its history in one transaction.
+	Store(ctx context.Context, ticket *Ticket, history []*TicketHistory) error
+	// Update persists the ticket together with its history in one transaction.
+	Update(ctx context.Context, ticket *Ticket, history []*TicketHistory) error
+
+	FindComments(ctx context.Context, ticketId string) ([]*TicketComment, error)
+	StoreComment(ctx context.Context, comment *TicketComment) error
+	FindHistory(ctx context.Context, ticketId string) ([]*TicketHistory, error)
+
+	DomainExists(ctx context.Context, institutionId string, domainId string) (bool, error)
+	ShiftGroupExists(ctx context.Context, institutionId string, shiftGroupId string) (bool, error)
+	UserExists(ctx context.Context, institutionId string, userId string) (bool, error)
+}
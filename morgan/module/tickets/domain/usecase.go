@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"context"
+)
+
+// UseCase defines the business logic contract for the tickets module.
+type UseCase interface {
+	FindAll(ctx context.Context, filter TicketFilter) ([]*Ticket, int64, error)
+	Get(ctx context.Context, institutionId string, id string) (*Ticket, error)
+	Create(ctx context.Context, cmd CreateTicketCommand) (*Ticket, error)
+	Update(ctx context.Context, cmd UpdateTicketCommand) (*Ticket, error)
+	Assign(ctx context.Context, cmd AssignTicketCommand) (*Ticket, error)
+	Transition(ctx context.Context, cmd TransitionTicketCommand) (*Ticket, error)
+
+	FindComments(ctx context.Context, institutionId string, ticketId string) ([]*TicketComment, error)
+	AddComment(ctx context.Context, cmd AddCommentCommand) (*TicketComment, error)
+	FindHistory(ctx context.Context, institutionId string, ticketId string) ([]*TicketHistory, error)
+}
+
+// CreateTicketCommand encapsulates data for reporting a ticket.
+type CreateTicketCommand struct {
+	InstitutionId   string
+	Title           string
+	Description     string
+	DomainId        string
+	SeverityLevelId string
+	ShiftGroupId    string
+	ReporterId      string
+}
+
+// UpdateTicketCommand encapsulates changes to the details of a ticket.
+// A new severity level re-derives the due dates from the ticket creation time.
+type UpdateTicketCommand struct {
+	Id              string
+	InstitutionId   string
+	Title           string
+	Description     string
+	DomainId        string
+	SeverityLevelId string
+	UpdatedBy       string
+}
+
+// AssignTicketCommand encapsulates the assignment of a ticket to a shift group and/or user.
+type AssignTicketCommand struct {
+	Id            string
+	InstitutionId string
+	ShiftGroupId  string
+	AssigneeId    string
+	AssignedBy    string
+}
+
+// TransitionTicketCommand encapsulates a status change.
+type TransitionTicketCommand struct {
+	Id            string
+	InstitutionId string
+	Status        string
+	Note          string
+	ActorId       string
+}
+
+// AddCommentCommand encapsulates a new comment.
+type AddCommentCommand struct {
+	TicketId      string
+	InstitutionId string
+	AuthorId      string
+	Body          string
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"time"
+
+	severitylevels "github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
+)
+
+// Ticket statuses.
+const (
+	StatusOpen       = "open"
+	StatusInProgress = "in_progress"
+	StatusOnHold     = "on_hold"
+	StatusResolved   = "resolved"
+	StatusClosed     = "closed"
+	StatusCancelled  = "cancelled"
+)
+
+// transitions lists the statuses reachable from each status. Closed and cancelled are final.
+var transitions = map[string][]string{
+	StatusOpen:       {StatusInProgress, StatusOnHold, StatusResolved, StatusCancelled},
+	StatusInProgress: {StatusOnHold, StatusResolved, StatusCancelled},
+	StatusOnHold:     {StatusInProgress, StatusCancelled},
+	StatusResolved:   {StatusClosed, StatusInProgress},
+}
+
+// CanTransition reports whether a ticket may move from one status to another.
+func CanTransition(from, to string) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidStatus reports whether status is a known ticket status.
+func IsValidStatus(status string) bool {
+	switch status {
+	case StatusOpen, StatusInProgress, StatusOnHold, StatusResolved, StatusClosed, StatusCancelled:
+		return true
+	}
+	return false
+}
+
+// ApplyDeadline sets the due dates from the SLA deadline of the severity level,
+// which callers compute from the ticket creation.
+func (t *Ticket) ApplyDeadline(deadline severitylevels.SLADeadline) {
+	t.ResponseDueAt = deadline.ResponseDueAt
+	t.ResolutionDueAt = deadline.ResolutionDueAt
+}
+
+// MoveTo changes the status and maintains the lifecycle timestamps.
+// The first move out of open marks the ticket as responded; reopening clears the resolution.
+func (t *Ticket) MoveTo(status string, at time.Time) {
+	if t.RespondedAt == nil && status != StatusCancelled {
+		t.RespondedAt = &at
+	}
+
+	switch status {
+	case StatusResolved:
+		t.ResolvedAt = &at
+	case StatusClosed, StatusCancelled:
+		t.ClosedAt = &at
+	case StatusInProgress:
+		t.ResolvedAt = nil
+	}
+
+	t.Status = status
+}
@@ -0,0 +1,40 @@
+package tickets
+
+import (
+	"github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/registry"
+	"github.com/siakup/morgan-be/morgan/module/tickets/delivery/http"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+	"github.com/siakup/morgan-be/morgan/module/tickets/repository/postgresql"
+	"github.com/siakup/morgan-be/morgan/module/tickets/usecase"
+	"go.uber.org/fx"
+)
+
+// Module exports the tickets module for Fx.
+var Module = fx.Options(
+	fx.Provide(
+		postgresql.NewRepository,
+		fx.Annotate(
+			postgresql.NewRepository,
+			fx.As(new(domain.TicketRepository)),
+		),
+		usecase.NewUseCase,
+		fx.Annotate(
+			usecase.NewUseCase,
+			fx.As(new(domain.UseCase)),
+		),
+		http.NewTicketHandler,
+	),
+	registry.Provide(newModule),
+)
+
+func newModule(h *http.TicketHandler) registry.Module {
+	return registry.Module{
+		Name:        "tickets",
+		Version:     fiber.V1,
+		BasePath:    "/tickets",
+		Permissions: http.Permissions,
+		Endpoints:   h.Endpoints(),
+		Register:    h.RegisterRoutes,
+	}
+}
@@ -0,0 +1,49 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/object"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+var queryFindComments = `
+	SELECT id, ticket_id, author_id, body, created_at
+	FROM ticketing.ticket_comments
+	WHERE ticket_id = @ticket_id
+	ORDER BY created_at ASC
+`
+
+var queryStoreComment = `
+	INSERT INTO ticketing.ticket_comments (ticket_id, author_id, body)
+	VALUES (@ticket_id, @author_id, @body)
+	RETURNING id, created_at
+`
+
+// FindComments retrieves the comments of a ticket, oldest first.
+func (r *Repository) FindComments(ctx context.Context, ticketId string) ([]*domain.TicketComment, error) {
+	rows, err := r.db.Query(ctx, queryFindComments, pgx.NamedArgs{
+		"ticket_id": ticketId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[TicketCommentEntity])
+	if err != nil {
+		return nil, err
+	}
+
+	return object.ParseAll[*TicketCommentEntity, *domain.TicketComment](object.TagDB, object.TagObject, records)
+}
+
+// StoreComment persists a new comment.
+func (r *Repository) StoreComment(ctx context.Context, comment *domain.TicketComment) error {
+	return r.db.QueryRow(ctx, queryStoreComment, pgx.NamedArgs{
+		"ticket_id": comment.TicketId,
+		"author_id": comment.AuthorId,
+		"body":      comment.Body,
+	}).Scan(&comment.Id, &comment.CreatedAt)
+}
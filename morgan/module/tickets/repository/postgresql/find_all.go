@@ -0,0 +1,85 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/object"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// FindAll retrieves a list of tickets based on the provided filter.
+func (r *Repository) FindAll(ctx context.Context, filter domain.TicketFilter) ([]*domain.Ticket, int64, error) {
+	baseQuery := `
+		FROM ticketing.tickets
+		WHERE institution_id = @institution_id
+	`
+	args := pgx.NamedArgs{
+		"institution_id": filter.InstitutionId,
+	}
+
+	if filter.Status != "" {
+		baseQuery += " AND status = @status"
+		args["status"] = filter.Status
+	}
+
+	if filter.DomainId != "" {
+		baseQuery += " AND domain_id = @domain_id"
+		args["domain_id"] = filter.DomainId
+	}
+
+	if filter.SeverityLevelId != "" {
+		baseQuery += " AND severity_level_id = @severity_level_id"
+		args["severity_level_id"] = filter.SeverityLevelId
+	}
+
+	if filter.ShiftGroupId != "" {
+		baseQuery += " AND shift_group_id = @shift_group_id"
+		args["shift_group_id"] = filter.ShiftGroupId
+	}
+
+	if filter.AssigneeId != "" {
+		baseQuery += " AND assignee_id = @assignee_id"
+		args["assignee_id"] = filter.AssigneeId
+	}
+
+	if filter.ReporterId != "" {
+		baseQuery += " AND reporter_id = @reporter_id"
+		args["reporter_id"] = filter.ReporterId
+	}
+
+	if filter.Overdue {
+		baseQuery += " AND status IN ('open', 'in_progress', 'on_hold') AND resolution_due_at < now()"
+	}
+
+	// 1. Count Total
+	var total int64
+	countQuery := "SELECT count(id)" + baseQuery
+	if err := r.db.QueryRow(ctx, countQuery, args).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// 2. Select Data
+	selectQuery := "SELECT" + ticketColumns + baseQuery + " ORDER BY created_at DESC LIMIT @limit OFFSET @offset"
+
+	args["limit"] = filter.Pagination.GetLimit()
+	args["offset"] = filter.Pagination.GetOffset()
+
+	rows, err := r.db.Query(ctx, selectQuery, args)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	records, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[TicketEntity])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tickets, err := object.ParseAll[*TicketEntity, *domain.Ticket](object.TagDB, object.TagObject, records)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tickets, total, nil
+}
@@ -0,0 +1,55 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/object"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+const ticketColumns = `
+		id,
+		institution_id,
+		title,
+		description,
+		domain_id,
+		severity_level_id,
+		reporter_id,
+		assignee_id,
+		shift_group_id,
+		status,
+		response_due_at,
+		resolution_due_at,
+		responded_at,
+		resolved_at,
+		closed_at,
+		created_at,
+		updated_at,
+		created_by,
+		updated_by
+`
+
+var queryFindById = `
+	SELECT` + ticketColumns + `
+	FROM ticketing.tickets
+	WHERE id = @id
+	LIMIT 1
+`
+
+// FindByID retrieves a single ticket by its ID.
+func (r *Repository) FindByID(ctx context.Context, id string) (*domain.Ticket, error) {
+	rows, err := r.db.Query(ctx, queryFindById, pgx.NamedArgs{
+		"id": id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[TicketEntity])
+	if err != nil {
+		return nil, err
+	}
+
+	return object.Parse[*TicketEntity, *domain.Ticket](object.TagDB, object.TagObject, record)
+}
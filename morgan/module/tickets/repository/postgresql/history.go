@@ -0,0 +1,34 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/libraries/object"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+var queryFindHistory = `
+	SELECT id, ticket_id, actor_id, action, field, old_value, new_value, note, created_at
+	FROM ticketing.ticket_history
+	WHERE ticket_id = @ticket_id
+	ORDER BY created_at ASC
+`
+
+// FindHistory retrieves the recorded changes of a ticket, oldest first.
+func (r *Repository) FindHistory(ctx context.Context, ticketId string) ([]*domain.TicketHistory, error) {
+	rows, err := r.db.Query(ctx, queryFindHistory, pgx.NamedArgs{
+		"ticket_id": ticketId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[TicketHistoryEntity])
+	if err != nil {
+		return nil, err
+	}
+
+	return object.ParseAll[*TicketHistoryEntity, *domain.TicketHistory](object.TagDB, object.TagObject, records)
+}
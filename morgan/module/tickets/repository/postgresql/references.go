@@ -0,0 +1,55 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var queryDomainExists = `
+	SELECT EXISTS (
+		SELECT 1 FROM master.domains
+		WHERE id = @id AND status = true AND deleted_at IS NULL
+			AND (institution_id IS NULL OR institution_id = @institution_id)
+	)
+`
+
+var queryShiftGroupExists = `
+	SELECT EXISTS (
+		SELECT 1 FROM hr.shift_groups
+		WHERE id = @id AND status = true AND deleted_at IS NULL
+			AND (institution_id IS NULL OR institution_id = @institution_id)
+	)
+`
+
+var queryUserExists = `
+	SELECT EXISTS (
+		SELECT 1 FROM auth.users
+		WHERE id = @id AND institution_id = @institution_id
+			AND status = 'active' AND deleted_at IS NULL
+	)
+`
+
+// DomainExists reports whether an active domain is available to the institution.
+func (r *Repository) DomainExists(ctx context.Context, institutionId string, domainId string) (bool, error) {
+	return r.exists(ctx, queryDomainExists, institutionId, domainId)
+}
+
+// ShiftGroupExists reports whether an active shift group is available to the institution.
+func (r *Repository) ShiftGroupExists(ctx context.Context, institutionId string, shiftGroupId string) (bool, error) {
+	return r.exists(ctx, queryShiftGroupExists, institutionId, shiftGroupId)
+}
+
+// UserExists reports whether an active user belongs to the institution.
+func (r *Repository) UserExists(ctx context.Context, institutionId string, userId string) (bool, error) {
+	return r.exists(ctx, queryUserExists, institutionId, userId)
+}
+
+func (r *Repository) exists(ctx context.Context, query string, institutionId string, id string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(ctx, query, pgx.NamedArgs{
+		"id":             id,
+		"institution_id": institutionId,
+	}).Scan(&exists)
+	return exists, err
+}
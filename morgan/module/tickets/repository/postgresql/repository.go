@@ -0,0 +1,65 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+var _ domain.TicketRepository = (*Repository)(nil)
+
+// TicketEntity maps to ticketing.tickets table.
+type TicketEntity struct {
+	Id              string     `db:"id"`
+	InstitutionId   string     `db:"institution_id"`
+	Title           string     `db:"title"`
+	Description     *string    `db:"description"`
+	DomainId        string     `db:"domain_id"`
+	SeverityLevelId string     `db:"severity_level_id"`
+	ReporterId      string     `db:"reporter_id"`
+	AssigneeId      *string    `db:"assignee_id"`
+	ShiftGroupId    *string    `db:"shift_group_id"`
+	Status          string     `db:"status"`
+	ResponseDueAt   *time.Time `db:"response_due_at"`
+	ResolutionDueAt *time.Time `db:"resolution_due_at"`
+	RespondedAt     *time.Time `db:"responded_at"`
+	ResolvedAt      *time.Time `db:"resolved_at"`
+	ClosedAt        *time.Time `db:"closed_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+	UpdatedAt       time.Time  `db:"updated_at"`
+	CreatedBy       *string    `db:"created_by"`
+	UpdatedBy       *string    `db:"updated_by"`
+}
+
+// TicketCommentEntity maps to ticketing.ticket_comments table.
+type TicketCommentEntity struct {
+	Id        string    `db:"id"`
+	TicketId  string    `db:"ticket_id"`
+	AuthorId  string    `db:"author_id"`
+	Body      string    `db:"body"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// TicketHistoryEntity maps to ticketing.ticket_history table.
+type TicketHistoryEntity struct {
+	Id        string    `db:"id"`
+	TicketId  string    `db:"ticket_id"`
+	ActorId   string    `db:"actor_id"`
+	Action    string    `db:"action"`
+	Field     *string   `db:"field"`
+	OldValue  *string   `db:"old_value"`
+	NewValue  *string   `db:"new_value"`
+	Note      *string   `db:"note"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Repository implements domain.TicketRepository.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+// NewRepository creates a new Ticket Repository.
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
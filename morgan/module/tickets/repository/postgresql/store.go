@@ -0,0 +1,81 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+var queryStore = `
+	INSERT INTO ticketing.tickets (
+		institution_id, title, description, domain_id, severity_level_id,
+		reporter_id, assignee_id, shift_group_id, status,
+		response_due_at, resolution_due_at, created_at, created_by, updated_at, updated_by
+	) VALUES (
+		@institution_id, @title, @description, @domain_id, @severity_level_id,
+		@reporter_id, @assignee_id, @shift_group_id, @status,
+		@response_due_at, @resolution_due_at, @created_at, @created_by, @created_at, @updated_by
+	)
+	RETURNING id
+`
+
+var queryStoreHistory = `
+	INSERT INTO ticketing.ticket_history (
+		ticket_id, actor_id, action, field, old_value, new_value, note
+	) VALUES (
+		@ticket_id, @actor_id, @action, @field, @old_value, @new_value, @note
+	)
+`
+
+// Store persists a new ticket and its creation history in one transaction.
+func (r *Repository) Store(ctx context.Context, ticket *domain.Ticket, history []*domain.TicketHistory) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := tx.QueryRow(ctx, queryStore, pgx.NamedArgs{
+		"institution_id":    ticket.InstitutionId,
+		"title":             ticket.Title,
+		"description":       ticket.Description,
+		"domain_id":         ticket.DomainId,
+		"severity_level_id": ticket.SeverityLevelId,
+		"reporter_id":       ticket.ReporterId,
+		"assignee_id":       ticket.AssigneeId,
+		"shift_group_id":    ticket.ShiftGroupId,
+		"status":            ticket.Status,
+		"response_due_at":   ticket.ResponseDueAt,
+		"resolution_due_at": ticket.ResolutionDueAt,
+		"created_at":        ticket.CreatedAt,
+		"created_by":        ticket.CreatedBy,
+		"updated_by":        ticket.UpdatedBy,
+	}).Scan(&ticket.Id); err != nil {
+		return err
+	}
+
+	if err := storeHistory(ctx, tx, ticket.Id, history); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func storeHistory(ctx context.Context, tx pgx.Tx, ticketId string, history []*domain.TicketHistory) error {
+	for _, h := range history {
+		h.TicketId = ticketId
+		if _, err := tx.Exec(ctx, queryStoreHistory, pgx.NamedArgs{
+			"ticket_id": h.TicketId,
+			"actor_id":  h.ActorId,
+			"action":    h.Action,
+			"field":     h.Field,
+			"old_value": h.OldValue,
+			"new_value": h.NewValue,
+			"note":      h.Note,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
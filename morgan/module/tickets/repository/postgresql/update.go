@@ -0,0 +1,66 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+var queryUpdate = `
+	UPDATE ticketing.tickets
+	SET
+		title = @title,
+		description = @description,
+		domain_id = @domain_id,
+		severity_level_id = @severity_level_id,
+		assignee_id = @assignee_id,
+		shift_group_id = @shift_group_id,
+		status = @status,
+		response_due_at = @response_due_at,
+		resolution_due_at = @resolution_due_at,
+		responded_at = @responded_at,
+		resolved_at = @resolved_at,
+		closed_at = @closed_at,
+		updated_by = @updated_by,
+		updated_at = now()
+	WHERE id = @id
+`
+
+// Update modifies a ticket and appends its history in one transaction.
+func (r *Repository) Update(ctx context.Context, ticket *domain.Ticket, history []*domain.TicketHistory) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, queryUpdate, pgx.NamedArgs{
+		"id":                ticket.Id,
+		"title":             ticket.Title,
+		"description":       ticket.Description,
+		"domain_id":         ticket.DomainId,
+		"severity_level_id": ticket.SeverityLevelId,
+		"assignee_id":       ticket.AssigneeId,
+		"shift_group_id":    ticket.ShiftGroupId,
+		"status":            ticket.Status,
+		"response_due_at":   ticket.ResponseDueAt,
+		"resolution_due_at": ticket.ResolutionDueAt,
+		"responded_at":      ticket.RespondedAt,
+		"resolved_at":       ticket.ResolvedAt,
+		"closed_at":         ticket.ClosedAt,
+		"updated_by":        ticket.UpdatedBy,
+	})
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := storeHistory(ctx, tx, ticket.Id, history); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// Assign routes a ticket to a shift group and/or a user. Empty values clear the assignment.
+func (u *UseCase) Assign(ctx context.Context, cmd domain.AssignTicketCommand) (*domain.Ticket, error) {
+	ctx, span := u.tracer.Start(ctx, "Assign")
+	defer span.End()
+
+	ticket, err := u.load(ctx, cmd.InstitutionId, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ticket.Status == domain.StatusClosed || ticket.Status == domain.StatusCancelled {
+		return nil, errors.Conflict("ticket is " + ticket.Status + " and can no longer be assigned")
+	}
+
+	shiftGroupId := optional(cmd.ShiftGroupId)
+	assigneeId := optional(cmd.AssigneeId)
+
+	if shiftGroupId != nil && value(shiftGroupId) != value(ticket.ShiftGroupId) {
+		if err := u.checkShiftGroup(ctx, cmd.InstitutionId, *shiftGroupId); err != nil {
+			return nil, err
+		}
+	}
+	if assigneeId != nil && value(assigneeId) != value(ticket.AssigneeId) {
+		if err := u.checkAssignee(ctx, cmd.InstitutionId, *assigneeId); err != nil {
+			return nil, err
+		}
+	}
+
+	var history []*domain.TicketHistory
+	for _, h := range []*domain.TicketHistory{
+		change(cmd.AssignedBy, domain.ActionAssigned, "shift_group_id", ticket.ShiftGroupId, shiftGroupId),
+		change(cmd.AssignedBy, domain.ActionAssigned, "assignee_id", ticket.AssigneeId, assigneeId),
+	} {
+		if h != nil {
+			history = append(history, h)
+		}
+	}
+
+	if len(history) == 0 {
+		return ticket, nil
+	}
+
+	ticket.ShiftGroupId = shiftGroupId
+	ticket.AssigneeId = assigneeId
+	ticket.UpdatedBy = &cmd.AssignedBy
+
+	if err := u.repository.Update(ctx, ticket, history); err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("ticket not found")
+		}
+
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.Update").
+			Err(err).
+			Msg("failed to assign ticket")
+		return nil, errors.InternalServerError("failed to assign ticket")
+	}
+
+	return ticket, nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// FindComments retrieves the comments of a ticket.
+func (u *UseCase) FindComments(ctx context.Context, institutionId string, ticketId string) ([]*domain.TicketComment, error) {
+	ctx, span := u.tracer.Start(ctx, "FindComments")
+	defer span.End()
+
+	if _, err := u.load(ctx, institutionId, ticketId); err != nil {
+		return nil, err
+	}
+
+	comments, err := u.repository.FindComments(ctx, ticketId)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.FindComments").
+			Err(err).
+			Msg("failed to find ticket comments")
+		return nil, errors.InternalServerError("failed to find ticket comments")
+	}
+
+	return comments, nil
+}
+
+// AddComment posts a comment on a ticket. Cancelled and closed tickets do not accept comments.
+func (u *UseCase) AddComment(ctx context.Context, cmd domain.AddCommentCommand) (*domain.TicketComment, error) {
+	ctx, span := u.tracer.Start(ctx, "AddComment")
+	defer span.End()
+
+	ticket, err := u.load(ctx, cmd.InstitutionId, cmd.TicketId)
+	if err != nil {
+		return nil, err
+	}
+
+	if ticket.Status == domain.StatusClosed || ticket.Status == domain.StatusCancelled {
+		return nil, errors.Conflict("ticket is " + ticket.Status + " and no longer accepts comments")
+	}
+
+	comment := &domain.TicketComment{
+		TicketId: cmd.TicketId,
+		AuthorId: cmd.AuthorId,
+		Body:     cmd.Body,
+	}
+
+	if err := u.repository.StoreComment(ctx, comment); err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.StoreComment").
+			Err(err).
+			Msg("failed to store ticket comment")
+		return nil, errors.InternalServerError("failed to add ticket comment")
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// Create reports a new ticket. Due dates are derived from the severity level SLA targets.
+func (u *UseCase) Create(ctx context.Context, cmd domain.CreateTicketCommand) (*domain.Ticket, error) {
+	ctx, span := u.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	if err := u.checkDomain(ctx, cmd.InstitutionId, cmd.DomainId); err != nil {
+		return nil, err
+	}
+
+	createdAt := time.Now()
+	deadline, err := u.slaDeadline(ctx, cmd.SeverityLevelId, createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.ShiftGroupId != "" {
+		if err := u.checkShiftGroup(ctx, cmd.InstitutionId, cmd.ShiftGroupId); err != nil {
+			return nil, err
+		}
+	}
+
+	ticket := &domain.Ticket{
+		InstitutionId:   cmd.InstitutionId,
+		Title:           cmd.Title,
+		Description:     optional(cmd.Description),
+		DomainId:        cmd.DomainId,
+		SeverityLevelId: cmd.SeverityLevelId,
+		ReporterId:      cmd.ReporterId,
+		ShiftGroupId:    optional(cmd.ShiftGroupId),
+		Status:          domain.StatusOpen,
+		CreatedAt:       createdAt,
+		CreatedBy:       &cmd.ReporterId,
+		UpdatedBy:       &cmd.ReporterId,
+	}
+	ticket.UpdatedAt = ticket.CreatedAt
+	ticket.ApplyDeadline(*deadline)
+
+	history := []*domain.TicketHistory{{
+		ActorId:  cmd.ReporterId,
+		Action:   domain.ActionCreated,
+		NewValue: optional(ticket.Status),
+	}}
+
+	if err := u.repository.Store(ctx, ticket, history); err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.Store").
+			Err(err).
+			Msg("failed to store ticket")
+		return nil, errors.InternalServerError("failed to create ticket")
+	}
+
+	return ticket, nil
+}
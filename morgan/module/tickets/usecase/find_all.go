@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// FindAll retrieves tickets based on the filter.
+func (u *UseCase) FindAll(ctx context.Context, filter domain.TicketFilter) ([]*domain.Ticket, int64, error) {
+	ctx, span := u.tracer.Start(ctx, "FindAll")
+	defer span.End()
+
+	if filter.Status != "" && !domain.IsValidStatus(filter.Status) {
+		return nil, 0, errors.BadRequest("unknown ticket status " + filter.Status)
+	}
+
+	tickets, total, err := u.repository.FindAll(ctx, filter)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.FindAll").
+			Err(err).
+			Msg("failed to find tickets")
+		return nil, 0, errors.InternalServerError("failed to find tickets")
+	}
+
+	return tickets, total, nil
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// Get finds a ticket by its unique identifier.
+func (u *UseCase) Get(ctx context.Context, institutionId string, id string) (*domain.Ticket, error) {
+	ctx, span := u.tracer.Start(ctx, "Get")
+	defer span.End()
+
+	return u.load(ctx, institutionId, id)
+}
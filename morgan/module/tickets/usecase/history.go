@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// FindHistory retrieves the recorded changes of a ticket.
+func (u *UseCase) FindHistory(ctx context.Context, institutionId string, ticketId string) ([]*domain.TicketHistory, error) {
+	ctx, span := u.tracer.Start(ctx, "FindHistory")
+	defer span.End()
+
+	if _, err := u.load(ctx, institutionId, ticketId); err != nil {
+		return nil, err
+	}
+
+	history, err := u.repository.FindHistory(ctx, ticketId)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.FindHistory").
+			Err(err).
+			Msg("failed to find ticket history")
+		return nil, errors.InternalServerError("failed to find ticket history")
+	}
+
+	return history, nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// Transition moves a ticket through the status workflow.
+func (u *UseCase) Transition(ctx context.Context, cmd domain.TransitionTicketCommand) (*domain.Ticket, error) {
+	ctx, span := u.tracer.Start(ctx, "Transition")
+	defer span.End()
+
+	if !domain.IsValidStatus(cmd.Status) {
+		return nil, errors.BadRequest("unknown ticket status " + cmd.Status)
+	}
+
+	ticket, err := u.load(ctx, cmd.InstitutionId, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !domain.CanTransition(ticket.Status, cmd.Status) {
+		return nil, errors.Conflict("ticket cannot move from " + ticket.Status + " to " + cmd.Status)
+	}
+
+	history := change(cmd.ActorId, domain.ActionStatusChanged, "status", &ticket.Status, &cmd.Status)
+	history.Note = optional(cmd.Note)
+
+	ticket.MoveTo(cmd.Status, time.Now())
+	ticket.UpdatedBy = &cmd.ActorId
+
+	if err := u.repository.Update(ctx, ticket, []*domain.TicketHistory{history}); err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("ticket not found")
+		}
+
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.Update").
+			Err(err).
+			Msg("failed to change ticket status")
+		return nil, errors.InternalServerError("failed to change ticket status")
+	}
+
+	return ticket, nil
+}
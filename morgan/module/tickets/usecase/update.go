@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// Update changes the details of an unfinished ticket.
+// A new severity level re-derives the due dates from the ticket creation time.
+func (u *UseCase) Update(ctx context.Context, cmd domain.UpdateTicketCommand) (*domain.Ticket, error) {
+	ctx, span := u.tracer.Start(ctx, "Update")
+	defer span.End()
+
+	ticket, err := u.load(ctx, cmd.InstitutionId, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ticket.Status == domain.StatusClosed || ticket.Status == domain.StatusCancelled {
+		return nil, errors.Conflict("ticket is " + ticket.Status + " and can no longer be changed")
+	}
+
+	if cmd.DomainId != ticket.DomainId {
+		if err := u.checkDomain(ctx, cmd.InstitutionId, cmd.DomainId); err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.SeverityLevelId != ticket.SeverityLevelId {
+		deadline, err := u.slaDeadline(ctx, cmd.SeverityLevelId, ticket.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		ticket.ApplyDeadline(*deadline)
+	}
+
+	description := optional(cmd.Description)
+	var history []*domain.TicketHistory
+	for _, h := range []*domain.TicketHistory{
+		change(cmd.UpdatedBy, domain.ActionUpdated, "title", &ticket.Title, &cmd.Title),
+		change(cmd.UpdatedBy, domain.ActionUpdated, "description", ticket.Description, description),
+		change(cmd.UpdatedBy, domain.ActionUpdated, "domain_id", &ticket.DomainId, &cmd.DomainId),
+		change(cmd.UpdatedBy, domain.ActionUpdated, "severity_level_id", &ticket.SeverityLevelId, &cmd.SeverityLevelId),
+	} {
+		if h != nil {
+			history = append(history, h)
+		}
+	}
+
+	if len(history) == 0 {
+		return ticket, nil
+	}
+
+	ticket.Title = cmd.Title
+	ticket.Description = description
+	ticket.DomainId = cmd.DomainId
+	ticket.SeverityLevelId = cmd.SeverityLevelId
+	ticket.UpdatedBy = &cmd.UpdatedBy
+
+	if err := u.repository.Update(ctx, ticket, history); err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("ticket not found")
+		}
+
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.Update").
+			Err(err).
+			Msg("failed to update ticket")
+		return nil, errors.InternalServerError("failed to update ticket")
+	}
+
+	return ticket, nil
+}
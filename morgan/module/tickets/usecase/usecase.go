@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	errs "errors"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/errors"
+	severitylevels "github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ domain.UseCase = (*UseCase)(nil)
+
+// UseCase implements the logic for tickets management.
+type UseCase struct {
+	repository     domain.TicketRepository
+	severityLevels severitylevels.UseCase
+	tracer         trace.Tracer
+}
+
+// NewUseCase creates a new instance of Tickets UseCase.
+// Due dates are computed by the severity levels module.
+func NewUseCase(repository domain.TicketRepository, severityLevels severitylevels.UseCase) *UseCase {
+	return &UseCase{
+		repository:     repository,
+		severityLevels: severityLevels,
+		tracer:         otel.Tracer("tickets"),
+	}
+}
+
+// load finds a ticket of the institution, tickets of other institutions are reported as not found.
+func (u *UseCase) load(ctx context.Context, institutionId string, id string) (*domain.Ticket, error) {
+	ticket, err := u.repository.FindByID(ctx, id)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("ticket not found")
+		}
+
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.FindByID").
+			Err(err).
+			Msg("failed to find ticket by id")
+		return nil, errors.InternalServerError("failed to find ticket by id")
+	}
+
+	if ticket.InstitutionId != institutionId {
+		return nil, errors.NotFound("ticket not found")
+	}
+
+	return ticket, nil
+}
+
+// slaDeadline computes the due dates of an active severity level counted from start.
+func (u *UseCase) slaDeadline(ctx context.Context, severityLevelId string, start time.Time) (*severitylevels.SLADeadline, error) {
+	level, err := u.severityLevels.FindByID(ctx, severityLevelId)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "severityLevels.FindByID").
+			Err(err).
+			Msg("failed to find severity level")
+		return nil, errors.InternalServerError("failed to find severity level")
+	}
+	if level == nil || !level.Status {
+		return nil, errors.BadRequest("severity level not found or inactive")
+	}
+
+	deadline := level.Deadline(start)
+	return &deadline, nil
+}
+
+// checkDomain ensures the domain exists, is active and available to the institution.
+func (u *UseCase) checkDomain(ctx context.Context, institutionId string, domainId string) error {
+	exists, err := u.repository.DomainExists(ctx, institutionId, domainId)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.DomainExists").
+			Err(err).
+			Msg("failed to check domain")
+		return errors.InternalServerError("failed to check domain")
+	}
+	if !exists {
+		return errors.BadRequest("domain not found or inactive")
+	}
+
+	return nil
+}
+
+// checkShiftGroup ensures the shift group exists, is active and available to the institution.
+func (u *UseCase) checkShiftGroup(ctx context.Context, institutionId string, shiftGroupId string) error {
+	exists, err := u.repository.ShiftGroupExists(ctx, institutionId, shiftGroupId)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.ShiftGroupExists").
+			Err(err).
+			Msg("failed to check shift group")
+		return errors.InternalServerError("failed to check shift group")
+	}
+	if !exists {
+		return errors.BadRequest("shift group not found or inactive")
+	}
+
+	return nil
+}
+
+// checkAssignee ensures the assignee is an active user of the institution.
+func (u *UseCase) checkAssignee(ctx context.Context, institutionId string, userId string) error {
+	exists, err := u.repository.UserExists(ctx, institutionId, userId)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("func", "repository.UserExists").
+			Err(err).
+			Msg("failed to check assignee")
+		return errors.InternalServerError("failed to check assignee")
+	}
+	if !exists {
+		return errors.FromStatus(http.StatusUnprocessableEntity, "assignee not found in the institution")
+	}
+
+	return nil
+}
+
+// change records a field change, or nil when the value did not change.
+// Values are copied so later changes to the ticket do not alter the record.
+func change(actorId string, action string, field string, oldValue *string, newValue *string) *domain.TicketHistory {
+	if value(oldValue) == value(newValue) {
+		return nil
+	}
+
+	return &domain.TicketHistory{
+		ActorId:  actorId,
+		Action:   action,
+		Field:    &field,
+		OldValue: optional(value(oldValue)),
+		NewValue: optional(value(newValue)),
+	}
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// optional maps an empty string to nil.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
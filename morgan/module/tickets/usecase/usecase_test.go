@@ -0,0 +1,218 @@
+package usecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/siakup/morgan-be/libraries/errors"
+	severitylevels "github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+	"github.com/siakup/morgan-be/morgan/module/tickets/usecase"
+	"github.com/siakup/morgan-be/morgan/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUseCase_Tickets(t *testing.T) {
+	mockRepo := new(mocks.TicketsRepositoryMock)
+	mockLevels := new(mocks.SeverityLevelsUseCaseMock)
+	uc := usecase.NewUseCase(mockRepo, mockLevels)
+
+	level := &severitylevels.SeverityLevel{Id: "sl1", Status: true, ResponseSLA: 15 * time.Minute, ResolutionSLA: 4 * time.Hour}
+
+	t.Run("Create_AppliesSLA", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("DomainExists", mock.Anything, "i1", "d1").Return(true, nil).Once()
+		mockLevels.On("FindByID", mock.Anything, "sl1").Return(level, nil).Once()
+		mockRepo.On("ShiftGroupExists", mock.Anything, "i1", "sg1").Return(true, nil).Once()
+		mockRepo.On("Store", mock.Anything, mock.Anything, mock.MatchedBy(func(h []*domain.TicketHistory) bool {
+			return len(h) == 1 && h[0].Action == domain.ActionCreated
+		})).Return(nil).Once()
+
+		res, err := uc.Create(ctx, domain.CreateTicketCommand{
+			InstitutionId:   "i1",
+			Title:           "Server down",
+			DomainId:        "d1",
+			SeverityLevelId: "sl1",
+			ShiftGroupId:    "sg1",
+			ReporterId:      "u1",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusOpen, res.Status)
+		assert.Equal(t, res.CreatedAt.Add(15*time.Minute), *res.ResponseDueAt)
+		assert.Equal(t, res.CreatedAt.Add(4*time.Hour), *res.ResolutionDueAt)
+		assert.Equal(t, "sg1", *res.ShiftGroupId)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+
+	t.Run("Create_NoTargetNoDueDate", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("DomainExists", mock.Anything, "i1", "d1").Return(true, nil).Once()
+		mockLevels.On("FindByID", mock.Anything, "sl5").Return(&severitylevels.SeverityLevel{Id: "sl5", Status: true}, nil).Once()
+		mockRepo.On("Store", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := uc.Create(ctx, domain.CreateTicketCommand{InstitutionId: "i1", Title: "Question", DomainId: "d1", SeverityLevelId: "sl5", ReporterId: "u1"})
+		assert.NoError(t, err)
+		assert.Nil(t, res.ResponseDueAt)
+		assert.Nil(t, res.ResolutionDueAt)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+
+	t.Run("Create_InactiveSeverity", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("DomainExists", mock.Anything, "i1", "d1").Return(true, nil).Once()
+		mockLevels.On("FindByID", mock.Anything, "sl9").Return(&severitylevels.SeverityLevel{Id: "sl9"}, nil).Once()
+
+		_, err := uc.Create(ctx, domain.CreateTicketCommand{InstitutionId: "i1", Title: "x", DomainId: "d1", SeverityLevelId: "sl9", ReporterId: "u1"})
+		assert.Error(t, err)
+		assert.Equal(t, errors.ErrorTypeValidation, err.(*errors.AppError).Type)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+
+	t.Run("Create_UnknownDomain", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("DomainExists", mock.Anything, "i1", "d9").Return(false, nil).Once()
+
+		_, err := uc.Create(ctx, domain.CreateTicketCommand{InstitutionId: "i1", Title: "x", DomainId: "d9", SeverityLevelId: "sl1", ReporterId: "u1"})
+		assert.Error(t, err)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+
+	t.Run("Get_OtherInstitution", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", mock.Anything, "t1").Return(&domain.Ticket{Id: "t1", InstitutionId: "i2"}, nil).Once()
+
+		_, err := uc.Get(ctx, "i1", "t1")
+		assert.Error(t, err)
+		assert.Equal(t, errors.ErrorTypeNotFound, err.(*errors.AppError).Type)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+
+	t.Run("Update_SeverityRederivesDueDates", func(t *testing.T) {
+		ctx := context.Background()
+		created := time.Date(2026, 2, 16, 8, 0, 0, 0, time.UTC)
+		ticket := &domain.Ticket{Id: "t1", InstitutionId: "i1", Title: "Server down", DomainId: "d1", SeverityLevelId: "sl2", Status: domain.StatusOpen, CreatedAt: created}
+
+		mockRepo.On("FindByID", mock.Anything, "t1").Return(ticket, nil).Once()
+		mockLevels.On("FindByID", mock.Anything, "sl1").Return(level, nil).Once()
+		mockRepo.On("Update", mock.Anything, mock.Anything, mock.MatchedBy(func(h []*domain.TicketHistory) bool {
+			return len(h) == 1 && *h[0].Field == "severity_level_id"
+		})).Return(nil).Once()
+
+		res, err := uc.Update(ctx, domain.UpdateTicketCommand{Id: "t1", InstitutionId: "i1", Title: "Server down", DomainId: "d1", SeverityLevelId: "sl1", UpdatedBy: "u1"})
+		assert.NoError(t, err)
+		assert.Equal(t, created.Add(4*time.Hour), *res.ResolutionDueAt)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+
+	t.Run("Assign", func(t *testing.T) {
+		ctx := context.Background()
+		ticket := &domain.Ticket{Id: "t1", InstitutionId: "i1", Status: domain.StatusOpen}
+
+		mockRepo.On("FindByID", mock.Anything, "t1").Return(ticket, nil).Once()
+		mockRepo.On("ShiftGroupExists", mock.Anything, "i1", "sg1").Return(true, nil).Once()
+		mockRepo.On("UserExists", mock.Anything, "i1", "u2").Return(true, nil).Once()
+		mockRepo.On("Update", mock.Anything, mock.Anything, mock.MatchedBy(func(h []*domain.TicketHistory) bool {
+			return len(h) == 2 && h[0].Action == domain.ActionAssigned
+		})).Return(nil).Once()
+
+		res, err := uc.Assign(ctx, domain.AssignTicketCommand{Id: "t1", InstitutionId: "i1", ShiftGroupId: "sg1", AssigneeId: "u2", AssignedBy: "u1"})
+		assert.NoError(t, err)
+		assert.Equal(t, "u2", *res.AssigneeId)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+
+	t.Run("Assign_UnknownAssignee", func(t *testing.T) {
+		ctx := context.Background()
+		ticket := &domain.Ticket{Id: "t1", InstitutionId: "i1", Status: domain.StatusOpen}
+
+		mockRepo.On("FindByID", mock.Anything, "t1").Return(ticket, nil).Once()
+		mockRepo.On("UserExists", mock.Anything, "i1", "u9").Return(false, nil).Once()
+
+		_, err := uc.Assign(ctx, domain.AssignTicketCommand{Id: "t1", InstitutionId: "i1", AssigneeId: "u9", AssignedBy: "u1"})
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, err.(*errors.AppError).Code)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+
+	t.Run("Transition_Resolve", func(t *testing.T) {
+		ctx := context.Background()
+		ticket := &domain.Ticket{Id: "t1", InstitutionId: "i1", Status: domain.StatusInProgress}
+
+		mockRepo.On("FindByID", mock.Anything, "t1").Return(ticket, nil).Once()
+		mockRepo.On("Update", mock.Anything, mock.Anything, mock.MatchedBy(func(h []*domain.TicketHistory) bool {
+			return len(h) == 1 && *h[0].OldValue == domain.StatusInProgress && *h[0].NewValue == domain.StatusResolved && *h[0].Note == "fixed"
+		})).Return(nil).Once()
+
+		res, err := uc.Transition(ctx, domain.TransitionTicketCommand{Id: "t1", InstitutionId: "i1", Status: domain.StatusResolved, Note: "fixed", ActorId: "u1"})
+		assert.NoError(t, err)
+		assert.NotNil(t, res.ResolvedAt)
+		assert.NotNil(t, res.RespondedAt)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+
+	t.Run("Transition_NotAllowed", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", mock.Anything, "t1").Return(&domain.Ticket{Id: "t1", InstitutionId: "i1", Status: domain.StatusClosed}, nil).Once()
+
+		_, err := uc.Transition(ctx, domain.TransitionTicketCommand{Id: "t1", InstitutionId: "i1", Status: domain.StatusInProgress, ActorId: "u1"})
+		assert.Error(t, err)
+		assert.Equal(t, errors.ErrorTypeConflict, err.(*errors.AppError).Type)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+
+	t.Run("AddComment_Closed", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", mock.Anything, "t1").Return(&domain.Ticket{Id: "t1", InstitutionId: "i1", Status: domain.StatusCancelled}, nil).Once()
+
+		_, err := uc.AddComment(ctx, domain.AddCommentCommand{TicketId: "t1", InstitutionId: "i1", AuthorId: "u1", Body: "hi"})
+		assert.Error(t, err)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+
+	t.Run("AddComment", func(t *testing.T) {
+		ctx := context.Background()
+
+		mockRepo.On("FindByID", mock.Anything, "t1").Return(&domain.Ticket{Id: "t1", InstitutionId: "i1", Status: domain.StatusOpen}, nil).Once()
+		mockRepo.On("StoreComment", mock.Anything, mock.MatchedBy(func(c *domain.TicketComment) bool {
+			return c.TicketId == "t1" && c.Body == "on it"
+		})).Return(nil).Once()
+
+		_, err := uc.AddComment(ctx, domain.AddCommentCommand{TicketId: "t1", InstitutionId: "i1", AuthorId: "u1", Body: "on it"})
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+		mockLevels.AssertExpectations(t)
+	})
+}
@@ -1,15 +1,23 @@
 package http
 
 import (
-	"net/http"
-
 	"github.com/gofiber/fiber/v2"
-	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/libraries/middleware"
+	"github.com/siakup/morgan-be/libraries/openapi"
 	"github.com/siakup/morgan-be/libraries/responses"
 	"github.com/siakup/morgan-be/morgan/module/users/domain"
 )
 
+// Permission codes guarding the users routes.
+const (
+	PermissionView   = "users.iam.users.view"
+	PermissionCreate = "users.iam.users.create"
+	PermissionEdit   = "users.iam.users.edit"
+)
+
+// Permissions lists every permission code required by the users routes.
+var Permissions = []string{PermissionView, PermissionCreate, PermissionEdit}
+
 // UserHandler handles HTTP requests for users module.
 type UserHandler struct {
 	useCase domain.UseCase
@@ -25,22 +33,12 @@ func NewUserHandler(useCase domain.UseCase, auth *middleware.AuthorizationMiddle
 }
 
 // RegisterRoutes registers the routes for the users module.
-func (h *UserHandler) RegisterRoutes(app *fiber.App) {
-	group := app.Group("/users", middleware.TraceMiddleware)
-
-	group.Get("/", h.auth.Authenticate("users.iam.users.view"), h.GetUsers)
-
-	group.Post("/", h.auth.Authenticate("users.iam.users.create"), h.SyncUser)
-
-	group.Patch("/:id/status", h.auth.Authenticate("users.iam.users.edit"), h.UpdateStatus)
-	group.Post("/:id/roles", h.auth.Authenticate("users.iam.users.edit"), h.AssignRole)
+func (h *UserHandler) RegisterRoutes(router fiber.Router) {
+	router.Use("/users", middleware.TraceMiddleware)
+	openapi.Mount(router, h.auth.Authenticate, h.Endpoints())
 }
 
-// handleError handles errors by mapping them to standardized responses.
+// handleError renders err with the standard error envelope.
 func (h *UserHandler) handleError(c *fiber.Ctx, err error) error {
-	if appErr, ok := err.(*errors.AppError); ok {
-		return c.Status(appErr.Code).JSON(responses.Fail(string(appErr.Type), appErr.Message))
-	}
-
-	return c.Status(http.StatusInternalServerError).JSON(responses.Fail("SYSTEM_ERROR", err.Error()))
+	return responses.HandleError(c, err)
 }
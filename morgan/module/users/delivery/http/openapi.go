@@ -0,0 +1,25 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/siakup/morgan-be/libraries/openapi"
+)
+
+type listQuery struct {
+	Page     int    `query:"page" default:"1"`
+	PageSize int    `query:"page_size" default:"10"`
+	Status   string `query:"status"`
+	Search   string `query:"search"`
+}
+
+// Endpoints lists the users routes: RegisterRoutes mounts them and the OpenAPI document describes them.
+func (h *UserHandler) Endpoints() []openapi.Endpoint {
+	return []openapi.Endpoint{
+		{Method: fiber.MethodGet, Path: "/users", Summary: "List users", Permissions: []string{PermissionView}, Query: listQuery{}, Response: []GetUserResponse{}, Paginated: true, Handlers: []fiber.Handler{h.GetUsers}},
+		{Method: fiber.MethodPost, Path: "/users", Summary: "Sync a user from the identity provider", Permissions: []string{PermissionCreate}, Request: SyncUserRequest{}, Response: SyncUserResponse{}, Status: http.StatusCreated, Handlers: []fiber.Handler{h.SyncUser}},
+		{Method: fiber.MethodPatch, Path: "/users/:id/status", Summary: "Update the status of a user", Permissions: []string{PermissionEdit}, Request: UpdateStatusRequest{}, Handlers: []fiber.Handler{h.UpdateStatus}},
+		{Method: fiber.MethodPost, Path: "/users/:id/roles", Summary: "Assign a role to a user", Permissions: []string{PermissionEdit}, Request: AssignRoleRequest{}, Response: AssignRoleResponse{}, Status: http.StatusCreated, Handlers: []fiber.Handler{h.AssignRole}},
+	}
+}
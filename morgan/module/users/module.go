@@ -1,12 +1,13 @@
 package users
 
 import (
-	gofiber "github.com/gofiber/fiber/v2"
-	"go.uber.org/fx"
+	"github.com/siakup/morgan-be/framework/fiber"
+	"github.com/siakup/morgan-be/libraries/registry"
 	"github.com/siakup/morgan-be/morgan/module/users/delivery/http"
 	"github.com/siakup/morgan-be/morgan/module/users/domain"
 	"github.com/siakup/morgan-be/morgan/module/users/repository/postgresql"
 	"github.com/siakup/morgan-be/morgan/module/users/usecase"
+	"go.uber.org/fx"
 )
 
 // Module exports the users module for Fx.
@@ -24,9 +25,17 @@ var Module = fx.Options(
 		),
 		http.NewUserHandler,
 	),
-	fx.Invoke(registerRoutes),
+	registry.Provide(newModule),
 )
 
-func registerRoutes(h *http.UserHandler, app *gofiber.App) {
-	h.RegisterRoutes(app)
+func newModule(h *http.UserHandler) registry.Module {
+	return registry.Module{
+		Name:        "users",
+		Version:     fiber.V1,
+		BasePath:    "/users",
+		Permissions: http.Permissions,
+		Core:        true,
+		Endpoints:   h.Endpoints(),
+		Register:    h.RegisterRoutes,
+	}
 }
@@ -25,7 +25,7 @@ var queryAssignRole = `
 
 // AssignRole creates a new user-role assignment.
 func (r *Repository) AssignRole(ctx context.Context, role *domain.UserRole) error {
-	rows, err := r.db.Query(ctx, queryAssignRole, pgx.NamedArgs{
+	rows, err := r.conn(ctx).Query(ctx, queryAssignRole, pgx.NamedArgs{
 		"institution_id": role.InstitutionId,
 		"user_id":        role.UserId,
 		"role_id":        role.RoleId,
@@ -32,7 +32,7 @@ func (r *Repository) FindAll(ctx context.Context, filter domain.UserFilter) ([]*
 	// 2. Count Total
 	var total int64
 	countQuery := "SELECT count(id)" + baseQuery
-	if err := r.db.QueryRow(ctx, countQuery, args).Scan(&total); err != nil {
+	if err := r.conn(ctx).QueryRow(ctx, countQuery, args).Scan(&total); err != nil {
 		return nil, 0, err
 	}
 
@@ -49,7 +49,7 @@ func (r *Repository) FindAll(ctx context.Context, filter domain.UserFilter) ([]*
 		args["offset"] = filter.Pagination.GetOffset()
 	}
 
-	rows, err := r.db.Query(ctx, selectQuery, args)
+	rows, err := r.conn(ctx).Query(ctx, selectQuery, args)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -19,7 +19,7 @@ var queryFindBySubject = `
 
 // FindByExternalSubject retrieves a user by their external subject (sub) within an institution.
 func (r *Repository) FindByExternalSubject(ctx context.Context, institutionId string, subject string) (*domain.User, error) {
-	rows, err := r.db.Query(ctx, queryFindBySubject, pgx.NamedArgs{
+	rows, err := r.conn(ctx).Query(ctx, queryFindBySubject, pgx.NamedArgs{
 		"institution_id": institutionId,
 		"subject":        subject,
 	})
@@ -19,7 +19,7 @@ var queryFindById = `
 
 // FindByID retrieves a user by ID.
 func (r *Repository) FindByID(ctx context.Context, id string) (*domain.User, error) {
-	rows, err := r.db.Query(ctx, queryFindById, pgx.NamedArgs{
+	rows, err := r.conn(ctx).Query(ctx, queryFindById, pgx.NamedArgs{
 		"id": id,
 	})
 	if err != nil {
@@ -24,7 +24,7 @@ var queryStore = `
 
 // Store upserts a user record.
 func (r *Repository) Store(ctx context.Context, user *domain.User) error {
-	rows, err := r.db.Query(ctx, queryStore, pgx.NamedArgs{
+	rows, err := r.conn(ctx).Query(ctx, queryStore, pgx.NamedArgs{
 		"institution_id":    user.InstitutionId,
 		"external_subject":  user.ExternalSubject,
 		"identity_provider": user.IdentityProvider,
@@ -14,7 +14,7 @@ var queryUpdateStatus = `
 
 // UpdateStatus updates a user's status.
 func (r *Repository) UpdateStatus(ctx context.Context, id string, status string, updatedBy string) error {
-	_, err := r.db.Exec(ctx, queryUpdateStatus, pgx.NamedArgs{
+	_, err := r.conn(ctx).Exec(ctx, queryUpdateStatus, pgx.NamedArgs{
 		"id":         id,
 		"status":     status,
 		"updated_by": updatedBy,
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/events"
 	"github.com/siakup/morgan-be/morgan/module/users/domain"
 )
 
@@ -33,7 +35,22 @@ func (u *UseCase) AssignRole(ctx context.Context, cmd domain.AssignRoleCommand)
 		IsActive:      true,
 	}
 
-	if err := u.repository.AssignRole(ctx, userRole); err != nil {
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.AssignRole(ctx, userRole); err != nil {
+			return err
+		}
+
+		if err := u.recorder.Record(ctx, audit.Entry{Entity: entityUserRole, EntityId: userRole.Id, Action: audit.ActionAssign, After: userRole}); err != nil {
+			return err
+		}
+
+		return events.Emit(ctx, u.publisher, userRole.InstitutionId, events.UserRoleAssigned{
+			UserRoleId: userRole.Id,
+			UserId:     userRole.UserId,
+			RoleId:     userRole.RoleId,
+			GroupId:    userRole.GroupId,
+		})
+	}); err != nil {
 		logger.Error().
 			Str("func", "repository.AssignRole").
 			Err(err).
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
 	"github.com/siakup/morgan-be/morgan/module/users/domain"
 )
@@ -63,7 +64,13 @@ func (u *UseCase) SyncUser(ctx context.Context, institutionId string, token stri
 	}
 
 	// Upsert User Locally
-	if err := u.repository.Store(ctx, user); err != nil {
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.Store(ctx, user); err != nil {
+			return err
+		}
+
+		return u.recorder.Record(ctx, audit.Entry{Entity: entityUser, EntityId: user.Id, Action: audit.ActionCreate, After: user})
+	}); err != nil {
 		logger.Error().Str("func", "repository.Store").Err(err).Msg("failed to store synced user")
 		return nil, errors.InternalServerError("failed to store synced user")
 	}
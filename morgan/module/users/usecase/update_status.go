@@ -2,9 +2,13 @@ package usecase
 
 import (
 	"context"
+	errs "errors"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
+	"github.com/siakup/morgan-be/libraries/audit"
 	"github.com/siakup/morgan-be/libraries/errors"
+	"github.com/siakup/morgan-be/libraries/events"
 )
 
 // UpdateStatus updates a user's status.
@@ -20,7 +24,40 @@ func (u *UseCase) UpdateStatus(ctx context.Context, id string, status string, up
 		return errors.BadRequest("invalid status")
 	}
 
-	if err := u.repository.UpdateStatus(ctx, id, status, updatedBy); err != nil {
+	current, err := u.repository.FindByID(ctx, id)
+	if err != nil {
+		if errs.Is(err, pgx.ErrNoRows) {
+			return errors.NotFound("user not found")
+		}
+		logger.Error().
+			Str("func", "repository.FindByID").
+			Err(err).
+			Msg("failed to find user")
+		return errors.InternalServerError("failed to find user")
+	}
+
+	// Setting the current status again changes nothing, so there is nothing to record
+	if current.Status == status {
+		return nil
+	}
+
+	if err := u.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.repository.UpdateStatus(ctx, id, status, updatedBy); err != nil {
+			return err
+		}
+
+		if err := u.recorder.Record(ctx, audit.Entry{
+			Entity:   entityUser,
+			EntityId: id,
+			Action:   audit.ActionUpdate,
+			Before:   map[string]any{"status": current.Status},
+			After:    map[string]any{"status": status},
+		}); err != nil {
+			return err
+		}
+
+		return events.Emit(ctx, u.publisher, current.InstitutionId, events.UserStatusChanged{UserId: id, From: current.Status, To: status})
+	}); err != nil {
 		logger.Error().
 			Str("func", "repository.UpdateStatus").
 			Err(err).
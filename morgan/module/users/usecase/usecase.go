@@ -3,6 +3,9 @@ package usecase
 import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"github.com/siakup/morgan-be/framework/postgres"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/events"
 	"github.com/siakup/morgan-be/libraries/idp"
 	"github.com/siakup/morgan-be/morgan/module/users/domain"
 )
@@ -12,15 +15,27 @@ var _ domain.UseCase = (*UseCase)(nil)
 // UseCase implements the logic for users module.
 type UseCase struct {
 	repository domain.UserRepository
+	tx         postgres.Transactor
 	idp        idp.IDPProvider
-	tracer     trace.Tracer	
+	recorder   audit.Recorder
+	publisher  events.Publisher
+	tracer     trace.Tracer
 }
 
 // NewUseCase creates a new instance of Users UseCase.
-func NewUseCase(repository domain.UserRepository, idp idp.IDPProvider) *UseCase {
+func NewUseCase(repository domain.UserRepository, tx postgres.Transactor, idp idp.IDPProvider, recorder audit.Recorder, publisher events.Publisher) *UseCase {
 	return &UseCase{
 		repository: repository,
+		tx:         tx,
 		idp:        idp,
+		recorder:   recorder,
+		publisher:  publisher,
 		tracer:     otel.Tracer("users"),
 	}
 }
+
+// Audit entity names used by the users module.
+const (
+	entityUser     = "user"
+	entityUserRole = "user_role"
+)
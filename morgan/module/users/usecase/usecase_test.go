@@ -5,12 +5,15 @@ import (
 	"errors"
 	"testing"
 
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/mock"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/libraries/events"
 	"github.com/siakup/morgan-be/libraries/idp/client"
+	"github.com/siakup/morgan-be/libraries/publisher"
 	"github.com/siakup/morgan-be/morgan/module/users/domain"
 	"github.com/siakup/morgan-be/morgan/module/users/usecase"
 	"github.com/siakup/morgan-be/morgan/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestUseCase_Users(t *testing.T) {
@@ -18,7 +21,11 @@ func TestUseCase_Users(t *testing.T) {
 	mockIDPProvider := new(mocks.IDPProviderMock)
 	mockIDPClient := new(mocks.IDPClientMock)
 
-	uc := usecase.NewUseCase(mockRepo, mockIDPProvider)
+	mockRecorder := new(mocks.AuditRecorderMock)
+	mockRecorder.On("Record", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockPublisher := new(mocks.EventPublisherMock)
+	mockPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	uc := usecase.NewUseCase(mockRepo, new(mocks.TransactorMock), mockIDPProvider, mockRecorder, mockPublisher)
 
 	t.Run("FindAll", func(t *testing.T) {
 		ctx := context.Background()
@@ -89,12 +96,35 @@ func TestUseCase_Users(t *testing.T) {
 		status := "active"
 		by := "admin"
 
+		mockRepo.On("FindByID", mock.Anything, id).Return(&domain.User{Id: id, Status: "pending"}, nil).Once()
 		mockRepo.On("UpdateStatus", mock.Anything, id, status, by).Return(nil).Once()
 
 		err := uc.UpdateStatus(ctx, id, status, by)
 		assert.NoError(t, err)
 
 		mockRepo.AssertExpectations(t)
+		mockRecorder.AssertCalled(t, "Record", mock.Anything, mock.MatchedBy(func(entry audit.Entry) bool {
+			return entry.Entity == "user" && entry.EntityId == id && entry.Action == audit.ActionUpdate
+		}))
+		mockPublisher.AssertCalled(t, "Publish", mock.Anything, mock.MatchedBy(func(e publisher.Event) bool {
+			event, err := events.Decode[events.UserStatusChanged](e.Body())
+			return err == nil && event.Data.From == "pending" && event.Data.To == status
+		}))
+	})
+
+	t.Run("UpdateStatus_Unchanged", func(t *testing.T) {
+		ctx := context.Background()
+		id := "u1"
+
+		mockRepo.On("FindByID", mock.Anything, id).Return(&domain.User{Id: id, Status: "active"}, nil).Once()
+		updated, recorded := len(mockRepo.Calls), len(mockRecorder.Calls)
+
+		err := uc.UpdateStatus(ctx, id, "active", "admin")
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+		assert.Len(t, mockRepo.Calls, updated+1, "expected only FindByID")
+		assert.Len(t, mockRecorder.Calls, recorded, "expected no audit entry")
 	})
 
 	t.Run("AssignRole", func(t *testing.T) {
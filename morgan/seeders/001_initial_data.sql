@@ -8,10 +8,11 @@
 -- ============================================================================
 -- 1. INSTITUTIONS
 -- ============================================================================
-INSERT INTO auth.institutions (id, code, name, description, is_active) VALUES
-    ('550e8400-e29b-41d4-a716-446655440001'::UUID, 'UP', 'Universitas Pertamina', 'Universitas Pertamina Jakarta', true),
-    ('550e8400-e29b-41d4-a716-446655440002'::UUID, 'ITB', 'Institut Teknologi Bandung', 'ITB Bandung', true),
-    ('550e8400-e29b-41d4-a716-446655440003'::UUID, 'UI', 'Universitas Indonesia', 'UI Jakarta', true)
+-- features lists the non-core modules (see libraries/registry) enabled for the institution.
+INSERT INTO auth.institutions (id, code, name, description, features, is_active) VALUES
+    ('550e8400-e29b-41d4-a716-446655440001'::UUID, 'UP', 'Universitas Pertamina', 'Universitas Pertamina Jakarta', '["domains", "shift_sessions", "shift_groups", "severity_levels", "attendances", "tickets"]'::JSONB, true),
+    ('550e8400-e29b-41d4-a716-446655440002'::UUID, 'ITB', 'Institut Teknologi Bandung', 'ITB Bandung', '["domains"]'::JSONB, true),
+    ('550e8400-e29b-41d4-a716-446655440003'::UUID, 'UI', 'Universitas Indonesia', 'UI Jakarta', '[]'::JSONB, true)
 ON CONFLICT DO NOTHING;
 
 -- ============================================================================
@@ -59,17 +60,35 @@ INSERT INTO iam.permissions (id, institution_id, code, description, module, sub_
     ('550e8400-e29b-41d4-a716-446655440118'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'severity_levels.hr.severity_levels.view', 'View Severity Levels', 'severity_levels', 'hr', 'severity_levels', 'view', 'both', true),
     ('550e8400-e29b-41d4-a716-446655440119'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'severity_levels.hr.severity_levels.create', 'Create Severity Level', 'severity_levels', 'hr', 'severity_levels', 'create', 'both', true),
     ('550e8400-e29b-41d4-a716-446655440120'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'severity_levels.hr.severity_levels.edit', 'Edit Severity Level', 'severity_levels', 'hr', 'severity_levels', 'edit', 'both', true),
+    ('550e8400-e29b-41d4-a716-446655440134'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'severity_levels.hr.severity_levels.delete', 'Delete Severity Level', 'severity_levels', 'hr', 'severity_levels', 'delete', 'api', true),
 
     -- Shift Groups
     ('550e8400-e29b-41d4-a716-446655440121'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'shift_groups.hr.shift_groups.view', 'View Shift Groups', 'shift_groups', 'hr', 'shift_groups', 'view', 'both', true),
     ('550e8400-e29b-41d4-a716-446655440122'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'shift_groups.hr.shift_groups.create', 'Create Shift Group', 'shift_groups', 'hr', 'shift_groups', 'create', 'both', true),
     ('550e8400-e29b-41d4-a716-446655440123'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'shift_groups.hr.shift_groups.edit', 'Edit Shift Group', 'shift_groups', 'hr', 'shift_groups', 'edit', 'both', true),
+    ('550e8400-e29b-41d4-a716-446655440133'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'shift_groups.hr.shift_groups.delete', 'Delete Shift Group', 'shift_groups', 'hr', 'shift_groups', 'delete', 'api', true),
 
     -- Domains
     ('550e8400-e29b-41d4-a716-446655440124'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'domains.organization.domains.view', 'View Domains', 'domains', 'organization', 'domains', 'view', 'both', true),
     ('550e8400-e29b-41d4-a716-446655440125'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'domains.organization.domains.create', 'Create Domain', 'domains', 'organization', 'domains', 'create', 'both', true),
     ('550e8400-e29b-41d4-a716-446655440126'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'domains.organization.domains.edit', 'Edit Domain', 'domains', 'organization', 'domains', 'edit', 'both', true),
 
+    -- Attendances
+    ('550e8400-e29b-41d4-a716-446655440129'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'attendances.hr.attendances.view', 'View Attendances', 'attendances', 'hr', 'attendances', 'view', 'both', true),
+    ('550e8400-e29b-41d4-a716-446655440130'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'attendances.hr.attendances.clock', 'Clock In/Out', 'attendances', 'hr', 'attendances', 'clock', 'both', true),
+    ('550e8400-e29b-41d4-a716-446655440131'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'attendances.hr.attendances.edit', 'Edit Attendance Grace Periods', 'attendances', 'hr', 'attendances', 'edit', 'both', true),
+    ('550e8400-e29b-41d4-a716-446655440132'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'attendances.hr.attendances.approve', 'Approve Attendance Corrections', 'attendances', 'hr', 'attendances', 'approve', 'both', true),
+
+    -- Tickets
+    ('550e8400-e29b-41d4-a716-446655440135'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'tickets.ticketing.tickets.view', 'View Tickets', 'tickets', 'ticketing', 'tickets', 'view', 'both', true),
+    ('550e8400-e29b-41d4-a716-446655440136'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'tickets.ticketing.tickets.create', 'Report Ticket', 'tickets', 'ticketing', 'tickets', 'create', 'both', true),
+    ('550e8400-e29b-41d4-a716-446655440137'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'tickets.ticketing.tickets.edit', 'Edit Ticket and Status', 'tickets', 'ticketing', 'tickets', 'edit', 'both', true),
+    ('550e8400-e29b-41d4-a716-446655440138'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'tickets.ticketing.tickets.assign', 'Assign Ticket', 'tickets', 'ticketing', 'tickets', 'assign', 'both', true),
+    ('550e8400-e29b-41d4-a716-446655440139'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'tickets.ticketing.tickets.comment', 'Comment on Ticket', 'tickets', 'ticketing', 'tickets', 'comment', 'both', true),
+
+    -- Audit
+    ('550e8400-e29b-41d4-a716-446655440140'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'audit.iam.audit_logs.view', 'View Audit Logs', 'audit', 'iam', 'audit_logs', 'view', 'both', true),
+
     -- System Admin
     ('550e8400-e29b-41d4-a716-446655440127'::UUID, '550e8400-e29b-41d4-a716-446655440001'::UUID, 'system.admin.admin.admin', 'System Admin', 'system', 'admin', 'admin', 'admin', 'both', true)
 ON CONFLICT DO NOTHING;
@@ -120,10 +139,25 @@ AND code IN (
     'shift_groups.hr.shift_groups.view',
     'shift_groups.hr.shift_groups.create',
     'shift_groups.hr.shift_groups.edit',
+    'shift_groups.hr.shift_groups.delete',
+    'severity_levels.hr.severity_levels.view',
+    'severity_levels.hr.severity_levels.create',
+    'severity_levels.hr.severity_levels.edit',
+    'severity_levels.hr.severity_levels.delete',
     'shift_sessions.schedule.shift_sessions.view',
     'shift_sessions.schedule.shift_sessions.create',
     'shift_sessions.schedule.shift_sessions.edit',
-    'shift_sessions.schedule.shift_sessions.delete'
+    'shift_sessions.schedule.shift_sessions.delete',
+    'attendances.hr.attendances.view',
+    'attendances.hr.attendances.clock',
+    'attendances.hr.attendances.edit',
+    'attendances.hr.attendances.approve',
+    'tickets.ticketing.tickets.view',
+    'tickets.ticketing.tickets.create',
+    'tickets.ticketing.tickets.edit',
+    'tickets.ticketing.tickets.assign',
+    'tickets.ticketing.tickets.comment',
+    'audit.iam.audit_logs.view'
 )
 ON CONFLICT (role_id, permission_id) DO NOTHING;
 
@@ -143,7 +177,15 @@ AND code IN (
     'shift_groups.hr.shift_groups.view',
     'shift_sessions.schedule.shift_sessions.view',
     'shift_sessions.schedule.shift_sessions.edit',
-    'severity_levels.hr.severity_levels.view'
+    'severity_levels.hr.severity_levels.view',
+    'attendances.hr.attendances.view',
+    'attendances.hr.attendances.clock',
+    'attendances.hr.attendances.approve',
+    'tickets.ticketing.tickets.view',
+    'tickets.ticketing.tickets.create',
+    'tickets.ticketing.tickets.edit',
+    'tickets.ticketing.tickets.assign',
+    'tickets.ticketing.tickets.comment'
 )
 ON CONFLICT (role_id, permission_id) DO NOTHING;
 
@@ -162,7 +204,13 @@ AND code IN (
     'domains.organization.domains.view',
     'shift_groups.hr.shift_groups.view',
     'shift_sessions.schedule.shift_sessions.view',
-    'severity_levels.hr.severity_levels.view'
+    'severity_levels.hr.severity_levels.view',
+    'attendances.hr.attendances.view',
+    'attendances.hr.attendances.clock',
+    'tickets.ticketing.tickets.view',
+    'tickets.ticketing.tickets.create',
+    'tickets.ticketing.tickets.edit',
+    'tickets.ticketing.tickets.comment'
 )
 ON CONFLICT (role_id, permission_id) DO NOTHING;
 
@@ -177,7 +225,11 @@ WHERE institution_id = '550e8400-e29b-41d4-a716-446655440001'::UUID
 AND code IN (
     'users.iam.users.view',
     'roles.iam.roles.view',
-    'domains.organization.domains.view'
+    'domains.organization.domains.view',
+    'attendances.hr.attendances.clock',
+    'tickets.ticketing.tickets.view',
+    'tickets.ticketing.tickets.create',
+    'tickets.ticketing.tickets.comment'
 )
 ON CONFLICT (role_id, permission_id) DO NOTHING;
 
@@ -240,12 +292,12 @@ ON CONFLICT DO NOTHING;
 -- ============================================================================
 -- 9. SEVERITY_LEVELS (in master schema)
 -- ============================================================================
-INSERT INTO master.severity_levels (id, name, status) VALUES
-    ('550e8400-e29b-41d4-a716-446655440501'::UUID, 'Critical', true),
-    ('550e8400-e29b-41d4-a716-446655440502'::UUID, 'High', true),
-    ('550e8400-e29b-41d4-a716-446655440503'::UUID, 'Medium', true),
-    ('550e8400-e29b-41d4-a716-446655440504'::UUID, 'Low', true),
-    ('550e8400-e29b-41d4-a716-446655440505'::UUID, 'Info', true)
+INSERT INTO master.severity_levels (id, name, status, rank, response_sla_minutes, resolution_sla_minutes, color, icon) VALUES
+    ('550e8400-e29b-41d4-a716-446655440501'::UUID, 'Critical', true, 1, 15, 240, '#D32F2F', 'alert-octagon'),
+    ('550e8400-e29b-41d4-a716-446655440502'::UUID, 'High', true, 2, 60, 480, '#F57C00', 'alert-triangle'),
+    ('550e8400-e29b-41d4-a716-446655440503'::UUID, 'Medium', true, 3, 240, 1440, '#FBC02D', 'alert-circle'),
+    ('550e8400-e29b-41d4-a716-446655440504'::UUID, 'Low', true, 4, 480, 4320, '#388E3C', 'info'),
+    ('550e8400-e29b-41d4-a716-446655440505'::UUID, 'Info', true, 5, 1440, 10080, '#1976D2', 'message-circle')
 ON CONFLICT DO NOTHING;
 
 -- ============================================================================
@@ -332,13 +384,13 @@ ON CONFLICT DO NOTHING;
 -- Sessions for New Staff (IT, HK, Dosen)
 INSERT INTO auth.sessions (session_id, institution_id, user_id, external_subject, roles, access_token, expires_at) VALUES
     ('test-it-session-01', '550e8400-e29b-41d4-a716-446655440001'::UUID, '550e8400-e29b-41d4-a716-446655440305', 'it_staff@university.edu', 
-        '[{"role_id":"550e8400-e29b-41d4-a716-446655440205","role_name":"User","groups":["IT Department"],"permissions":["users.iam.users.view","roles.iam.roles.view","domains.organization.domains.view"]}]'::JSONB, 
+        '[{"role_id":"550e8400-e29b-41d4-a716-446655440205","role_name":"User","groups":["IT Department"],"permissions":["users.iam.users.view","roles.iam.roles.view","domains.organization.domains.view","attendances.hr.attendances.clock"]}]'::JSONB, 
         'test-it-token-123', NOW() + INTERVAL '30 days'),
     ('test-hk-session-01', '550e8400-e29b-41d4-a716-446655440001'::UUID, '550e8400-e29b-41d4-a716-446655440306', 'hk_staff@university.edu', 
-        '[{"role_id":"550e8400-e29b-41d4-a716-446655440205","role_name":"User","groups":["Operations Department"],"permissions":["users.iam.users.view","roles.iam.roles.view","domains.organization.domains.view"]}]'::JSONB, 
+        '[{"role_id":"550e8400-e29b-41d4-a716-446655440205","role_name":"User","groups":["Operations Department"],"permissions":["users.iam.users.view","roles.iam.roles.view","domains.organization.domains.view","attendances.hr.attendances.clock"]}]'::JSONB, 
         'test-hk-token-123', NOW() + INTERVAL '30 days'),
     ('test-dosen-session-01', '550e8400-e29b-41d4-a716-446655440001'::UUID, '550e8400-e29b-41d4-a716-446655440307', 'dosen_staff@university.edu', 
-        '[{"role_id":"550e8400-e29b-41d4-a716-446655440205","role_name":"User","groups":["Academic Department"],"permissions":["users.iam.users.view","roles.iam.roles.view","domains.organization.domains.view"]}]'::JSONB, 
+        '[{"role_id":"550e8400-e29b-41d4-a716-446655440205","role_name":"User","groups":["Academic Department"],"permissions":["users.iam.users.view","roles.iam.roles.view","domains.organization.domains.view","attendances.hr.attendances.clock"]}]'::JSONB, 
         'test-dosen-token-123', NOW() + INTERVAL '30 days')
 ON CONFLICT DO NOTHING;
 
@@ -5,10 +5,10 @@ import (
 	"testing"
 	"time"
 
-	"github.com/stretchr/testify/assert"
 	libtypes "github.com/siakup/morgan-be/libraries/types"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
 	shiftGroupsRepo "github.com/siakup/morgan-be/morgan/module/shift_groups/repository/postgresql"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestShiftGroupsRepository(t *testing.T) {
@@ -18,9 +18,13 @@ func TestShiftGroupsRepository(t *testing.T) {
 	ctx := context.Background()
 	repo := shiftGroupsRepo.NewRepository(testPool)
 
+	var instID string
+	err := testPool.QueryRow(ctx, "SELECT id FROM auth.institutions WHERE code = 'TECH-UNI'").Scan(&instID)
+	assert.NoError(t, err)
+
 	// Fetch a valid user ID for audit log
 	var userID string
-	err := testPool.QueryRow(ctx, "SELECT id FROM auth.users LIMIT 1").Scan(&userID)
+	err = testPool.QueryRow(ctx, "SELECT id FROM auth.users LIMIT 1").Scan(&userID)
 	if err != nil {
 
 		t.Logf("Warning: Could not fetch user for audit: %v", err)
@@ -30,13 +34,14 @@ func TestShiftGroupsRepository(t *testing.T) {
 	t.Run("CRUD", func(t *testing.T) {
 		// 1. Create
 		newGroup := &domain.ShiftGroup{
-			Id:        "sg-integration-test-01",
-			Name:      "IT",
-			Status:    true,
-			CreatedAt: time.Now(),
-			CreatedBy: &userID,
-			UpdatedAt: time.Now(),
-			UpdatedBy: &userID,
+			Id:            "sg-integration-test-01",
+			InstitutionId: &instID,
+			Name:          "IT",
+			Status:        true,
+			CreatedAt:     time.Now(),
+			CreatedBy:     &userID,
+			UpdatedAt:     time.Now(),
+			UpdatedBy:     &userID,
 		}
 
 		err := repo.Store(ctx, newGroup)
@@ -49,6 +54,7 @@ func TestShiftGroupsRepository(t *testing.T) {
 		assert.Equal(t, newGroup.Id, fetchedGroup.Id)
 		assert.Equal(t, newGroup.Name, fetchedGroup.Name)
 		assert.Equal(t, newGroup.Status, fetchedGroup.Status)
+		assert.Equal(t, newGroup.InstitutionId, fetchedGroup.InstitutionId)
 
 		// 3. Update
 		newGroup.Name = "IT Updated"
@@ -64,7 +70,7 @@ func TestShiftGroupsRepository(t *testing.T) {
 		assert.False(t, fetchedGroupAfterUpdate.Status)
 
 		// 4. Delete
-		err = repo.Delete(ctx, newGroup.Id, userID)
+		err = repo.Delete(ctx, instID, newGroup.Id, userID)
 		assert.NoError(t, err)
 
 		deletedGroup, err := repo.FindByID(ctx, newGroup.Id)
@@ -76,19 +82,21 @@ func TestShiftGroupsRepository(t *testing.T) {
 		// Insert some data first to ensure there's something to find
 		for i := 0; i < 3; i++ {
 			sg := &domain.ShiftGroup{
-				Id:        "sg-findall-" + string(rune(i)),
-				Name:      "Group " + string(rune(i)),
-				Status:    true,
-				CreatedAt: time.Now(),
-				CreatedBy: &userID,
-				UpdatedAt: time.Now(),
-				UpdatedBy: &userID,
+				Id:            "sg-findall-" + string(rune(i)),
+				InstitutionId: &instID,
+				Name:          "Group " + string(rune(i)),
+				Status:        true,
+				CreatedAt:     time.Now(),
+				CreatedBy:     &userID,
+				UpdatedAt:     time.Now(),
+				UpdatedBy:     &userID,
 			}
 			_ = repo.Store(ctx, sg)
 		}
 
 		filter := domain.ShiftGroupFilter{
-			Pagination: libtypes.Pagination{Page: 1, Size: 10},
+			Pagination:    libtypes.Pagination{Page: 1, Size: 10},
+			InstitutionId: instID,
 		}
 
 		groups, total, err := repo.FindAll(ctx, filter)
@@ -0,0 +1,209 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/siakup/morgan-be/morgan/module/attendances/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// AttendancesUseCaseMock is a mock for Attendances UseCase
+type AttendancesUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *AttendancesUseCaseMock) FindAll(ctx context.Context, filter domain.AttendanceFilter) ([]*domain.Attendance, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*domain.Attendance), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *AttendancesUseCaseMock) Get(ctx context.Context, id string) (*domain.Attendance, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Attendance), args.Error(1)
+}
+
+func (m *AttendancesUseCaseMock) ClockIn(ctx context.Context, cmd domain.ClockInCommand) (*domain.Attendance, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Attendance), args.Error(1)
+}
+
+func (m *AttendancesUseCaseMock) ClockOut(ctx context.Context, cmd domain.ClockOutCommand) (*domain.Attendance, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Attendance), args.Error(1)
+}
+
+func (m *AttendancesUseCaseMock) DailySummary(ctx context.Context, institutionId string, workDate time.Time) ([]*domain.DailySummary, error) {
+	args := m.Called(ctx, institutionId, workDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.DailySummary), args.Error(1)
+}
+
+func (m *AttendancesUseCaseMock) GetGracePeriod(ctx context.Context, institutionId string, shiftGroupId string) (*domain.GracePeriod, error) {
+	args := m.Called(ctx, institutionId, shiftGroupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GracePeriod), args.Error(1)
+}
+
+func (m *AttendancesUseCaseMock) SetGracePeriod(ctx context.Context, institutionId string, gracePeriod *domain.GracePeriod) error {
+	args := m.Called(ctx, institutionId, gracePeriod)
+	return args.Error(0)
+}
+
+func (m *AttendancesUseCaseMock) FindAllCorrections(ctx context.Context, filter domain.CorrectionFilter) ([]*domain.AttendanceCorrection, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*domain.AttendanceCorrection), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *AttendancesUseCaseMock) RequestCorrection(ctx context.Context, cmd domain.RequestCorrectionCommand) (*domain.AttendanceCorrection, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AttendanceCorrection), args.Error(1)
+}
+
+func (m *AttendancesUseCaseMock) ReviewCorrection(ctx context.Context, cmd domain.ReviewCorrectionCommand) (*domain.AttendanceCorrection, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AttendanceCorrection), args.Error(1)
+}
+
+// AttendancesRepositoryMock is a mock for Attendances Repository
+type AttendancesRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *AttendancesRepositoryMock) FindAll(ctx context.Context, filter domain.AttendanceFilter) ([]*domain.Attendance, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*domain.Attendance), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *AttendancesRepositoryMock) FindByID(ctx context.Context, id string) (*domain.Attendance, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Attendance), args.Error(1)
+}
+
+func (m *AttendancesRepositoryMock) FindByUserSessionDate(ctx context.Context, userId string, shiftSessionId string, workDate time.Time) (*domain.Attendance, error) {
+	args := m.Called(ctx, userId, shiftSessionId, workDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Attendance), args.Error(1)
+}
+
+func (m *AttendancesRepositoryMock) FindOpenByUserSession(ctx context.Context, userId string, shiftSessionId string) (*domain.Attendance, error) {
+	args := m.Called(ctx, userId, shiftSessionId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Attendance), args.Error(1)
+}
+
+func (m *AttendancesRepositoryMock) Store(ctx context.Context, attendance *domain.Attendance) error {
+	args := m.Called(ctx, attendance)
+	return args.Error(0)
+}
+
+func (m *AttendancesRepositoryMock) Update(ctx context.Context, attendance *domain.Attendance) error {
+	args := m.Called(ctx, attendance)
+	return args.Error(0)
+}
+
+func (m *AttendancesRepositoryMock) FindShiftWindow(ctx context.Context, shiftSessionId string) (*domain.ShiftWindow, error) {
+	args := m.Called(ctx, shiftSessionId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShiftWindow), args.Error(1)
+}
+
+func (m *AttendancesRepositoryMock) FindGracePeriod(ctx context.Context, shiftGroupId string) (*domain.GracePeriod, error) {
+	args := m.Called(ctx, shiftGroupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GracePeriod), args.Error(1)
+}
+
+func (m *AttendancesRepositoryMock) FindShiftGroupIDs(ctx context.Context, userId string) ([]string, error) {
+	args := m.Called(ctx, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *AttendancesRepositoryMock) UpsertGracePeriod(ctx context.Context, gracePeriod *domain.GracePeriod) error {
+	args := m.Called(ctx, gracePeriod)
+	return args.Error(0)
+}
+
+func (m *AttendancesRepositoryMock) FindShiftGroupOwner(ctx context.Context, institutionId string, shiftGroupId string) (*string, error) {
+	args := m.Called(ctx, institutionId, shiftGroupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*string), args.Error(1)
+}
+
+func (m *AttendancesRepositoryMock) FindAllCorrections(ctx context.Context, filter domain.CorrectionFilter) ([]*domain.AttendanceCorrection, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*domain.AttendanceCorrection), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *AttendancesRepositoryMock) FindCorrectionByID(ctx context.Context, id string) (*domain.AttendanceCorrection, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AttendanceCorrection), args.Error(1)
+}
+
+func (m *AttendancesRepositoryMock) StoreCorrection(ctx context.Context, correction *domain.AttendanceCorrection) error {
+	args := m.Called(ctx, correction)
+	return args.Error(0)
+}
+
+func (m *AttendancesRepositoryMock) ReviewCorrection(ctx context.Context, correction *domain.AttendanceCorrection, attendance *domain.Attendance) error {
+	args := m.Called(ctx, correction, attendance)
+	return args.Error(0)
+}
+
+func (m *AttendancesRepositoryMock) DailySummary(ctx context.Context, institutionId string, workDate time.Time) ([]*domain.DailySummary, error) {
+	args := m.Called(ctx, institutionId, workDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.DailySummary), args.Error(1)
+}
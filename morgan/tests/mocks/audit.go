@@ -0,0 +1,66 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/siakup/morgan-be/libraries/audit"
+	"github.com/siakup/morgan-be/morgan/module/audit/domain"
+)
+
+// AuditRecorderMock is a mock implementation of audit.Recorder
+type AuditRecorderMock struct {
+	mock.Mock
+}
+
+func (m *AuditRecorderMock) Record(ctx context.Context, entry audit.Entry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+// AuditUseCaseMock is a mock implementation of domain.UseCase
+type AuditUseCaseMock struct {
+	AuditRecorderMock
+}
+
+func (m *AuditUseCaseMock) FindAll(ctx context.Context, filter domain.LogFilter) ([]*domain.Log, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, int64(0), args.Error(2)
+	}
+	return args.Get(0).([]*domain.Log), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *AuditUseCaseMock) Get(ctx context.Context, institutionId string, id string) (*domain.Log, error) {
+	args := m.Called(ctx, institutionId, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Log), args.Error(1)
+}
+
+// AuditLogRepositoryMock is a mock implementation of domain.LogRepository
+type AuditLogRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *AuditLogRepositoryMock) FindAll(ctx context.Context, filter domain.LogFilter) ([]*domain.Log, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, int64(0), args.Error(2)
+	}
+	return args.Get(0).([]*domain.Log), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *AuditLogRepositoryMock) FindByID(ctx context.Context, id string) (*domain.Log, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Log), args.Error(1)
+}
+
+func (m *AuditLogRepositoryMock) Store(ctx context.Context, log *domain.Log) error {
+	args := m.Called(ctx, log)
+	return args.Error(0)
+}
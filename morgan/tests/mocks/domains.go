@@ -3,8 +3,8 @@ package mocks
 import (
 	"context"
 
-	"github.com/stretchr/testify/mock"
 	"github.com/siakup/morgan-be/morgan/module/domains/domain"
+	"github.com/stretchr/testify/mock"
 )
 
 // DomainsUseCaseMock is a mock for Domains UseCase
@@ -20,8 +20,8 @@ func (m *DomainsUseCaseMock) FindAll(ctx context.Context, filter domain.DomainFi
 	return args.Get(0).([]*domain.Domain), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *DomainsUseCaseMock) Get(ctx context.Context, id string) (*domain.Domain, error) {
-	args := m.Called(ctx, id)
+func (m *DomainsUseCaseMock) Get(ctx context.Context, institutionId string, id string) (*domain.Domain, error) {
+	args := m.Called(ctx, institutionId, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -38,8 +38,8 @@ func (m *DomainsUseCaseMock) Update(ctx context.Context, domain *domain.Domain)
 	return args.Error(0)
 }
 
-func (m *DomainsUseCaseMock) Delete(ctx context.Context, id string, deletedBy string) error {
-	args := m.Called(ctx, id, deletedBy)
+func (m *DomainsUseCaseMock) Delete(ctx context.Context, institutionId string, id string, deletedBy string) error {
+	args := m.Called(ctx, institutionId, id, deletedBy)
 	return args.Error(0)
 }
 
@@ -74,7 +74,7 @@ func (m *DomainsRepositoryMock) Update(ctx context.Context, domain *domain.Domai
 	return args.Error(0)
 }
 
-func (m *DomainsRepositoryMock) Delete(ctx context.Context, id string, deletedBy string) error {
-	args := m.Called(ctx, id, deletedBy)
+func (m *DomainsRepositoryMock) Delete(ctx context.Context, institutionId string, id string, deletedBy string) error {
+	args := m.Called(ctx, institutionId, id, deletedBy)
 	return args.Error(0)
 }
@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/siakup/morgan-be/libraries/publisher"
+)
+
+// EventPublisherMock is a mock implementation of events.Publisher
+type EventPublisherMock struct {
+	mock.Mock
+}
+
+func (m *EventPublisherMock) Publish(ctx context.Context, event publisher.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/siakup/morgan-be/morgan/module/severity_levels/domain"
@@ -42,3 +43,70 @@ func (m *SeverityLevelsUseCaseMock) Delete(ctx context.Context, id string, delet
 	args := m.Called(ctx, id, deletedBy)
 	return args.Error(0)
 }
+
+func (m *SeverityLevelsUseCaseMock) Reorder(ctx context.Context, ids []string, updatedBy string) error {
+	args := m.Called(ctx, ids, updatedBy)
+	return args.Error(0)
+}
+
+func (m *SeverityLevelsUseCaseMock) SLADeadline(ctx context.Context, id string, start time.Time) (*domain.SLADeadline, error) {
+	args := m.Called(ctx, id, start)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SLADeadline), args.Error(1)
+}
+
+// SeverityLevelsRepositoryMock is a mock implementation of domain.SeverityLevelRepository
+type SeverityLevelsRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *SeverityLevelsRepositoryMock) FindAll(ctx context.Context, filter domain.SeverityLevelFilter) ([]*domain.SeverityLevel, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, int64(0), args.Error(2)
+	}
+	return args.Get(0).([]*domain.SeverityLevel), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *SeverityLevelsRepositoryMock) FindByID(ctx context.Context, id string) (*domain.SeverityLevel, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SeverityLevel), args.Error(1)
+}
+
+func (m *SeverityLevelsRepositoryMock) Store(ctx context.Context, severityLevel *domain.SeverityLevel) error {
+	args := m.Called(ctx, severityLevel)
+	return args.Error(0)
+}
+
+func (m *SeverityLevelsRepositoryMock) Update(ctx context.Context, severityLevel *domain.SeverityLevel) error {
+	args := m.Called(ctx, severityLevel)
+	return args.Error(0)
+}
+
+func (m *SeverityLevelsRepositoryMock) Delete(ctx context.Context, id string, deletedBy string) error {
+	args := m.Called(ctx, id, deletedBy)
+	return args.Error(0)
+}
+
+func (m *SeverityLevelsRepositoryMock) FindActiveIDs(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *SeverityLevelsRepositoryMock) NextRank(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *SeverityLevelsRepositoryMock) Reorder(ctx context.Context, ids []string, updatedBy string) error {
+	args := m.Called(ctx, ids, updatedBy)
+	return args.Error(0)
+}
@@ -3,8 +3,8 @@ package mocks
 import (
 	"context"
 
-	"github.com/stretchr/testify/mock"
 	"github.com/siakup/morgan-be/morgan/module/shift_groups/domain"
+	"github.com/stretchr/testify/mock"
 )
 
 // ShiftGroupsUseCaseMock is a mock implementation of domain.UseCase
@@ -22,8 +22,8 @@ func (m *ShiftGroupsUseCaseMock) FindAll(ctx context.Context, filter domain.Shif
 }
 
 // FindByID mocks the FindByID method
-func (m *ShiftGroupsUseCaseMock) FindByID(ctx context.Context, id string) (*domain.ShiftGroup, error) {
-	args := m.Called(ctx, id)
+func (m *ShiftGroupsUseCaseMock) FindByID(ctx context.Context, institutionId string, id string) (*domain.ShiftGroup, error) {
+	args := m.Called(ctx, institutionId, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -43,7 +43,74 @@ func (m *ShiftGroupsUseCaseMock) Update(ctx context.Context, shiftGroup *domain.
 }
 
 // Delete mocks the Delete method
-func (m *ShiftGroupsUseCaseMock) Delete(ctx context.Context, id string, deletedBy string) error {
-	args := m.Called(ctx, id, deletedBy)
+func (m *ShiftGroupsUseCaseMock) Delete(ctx context.Context, institutionId string, id string, deletedBy string) error {
+	args := m.Called(ctx, institutionId, id, deletedBy)
+	return args.Error(0)
+}
+
+// FindMembers mocks the FindMembers method
+func (m *ShiftGroupsUseCaseMock) FindMembers(ctx context.Context, id string, institutionId string) ([]string, error) {
+	args := m.Called(ctx, id, institutionId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// SetMembers mocks the SetMembers method
+func (m *ShiftGroupsUseCaseMock) SetMembers(ctx context.Context, cmd domain.SetMembersCommand) ([]string, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// ShiftGroupsRepositoryMock is a mock for ShiftGroups Repository
+type ShiftGroupsRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *ShiftGroupsRepositoryMock) FindAll(ctx context.Context, filter domain.ShiftGroupFilter) ([]*domain.ShiftGroup, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*domain.ShiftGroup), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *ShiftGroupsRepositoryMock) FindByID(ctx context.Context, id string) (*domain.ShiftGroup, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShiftGroup), args.Error(1)
+}
+
+func (m *ShiftGroupsRepositoryMock) Store(ctx context.Context, shiftGroup *domain.ShiftGroup) error {
+	args := m.Called(ctx, shiftGroup)
+	return args.Error(0)
+}
+
+func (m *ShiftGroupsRepositoryMock) Update(ctx context.Context, shiftGroup *domain.ShiftGroup) error {
+	args := m.Called(ctx, shiftGroup)
+	return args.Error(0)
+}
+
+func (m *ShiftGroupsRepositoryMock) Delete(ctx context.Context, institutionId string, id string, deletedBy string) error {
+	args := m.Called(ctx, institutionId, id, deletedBy)
+	return args.Error(0)
+}
+
+func (m *ShiftGroupsRepositoryMock) FindMemberIDs(ctx context.Context, id string, institutionId string) ([]string, error) {
+	args := m.Called(ctx, id, institutionId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *ShiftGroupsRepositoryMock) ReplaceMembers(ctx context.Context, id string, institutionId string, userIds []string, updatedBy string) error {
+	args := m.Called(ctx, id, institutionId, userIds, updatedBy)
 	return args.Error(0)
 }
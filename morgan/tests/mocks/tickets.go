@@ -0,0 +1,152 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/siakup/morgan-be/morgan/module/tickets/domain"
+)
+
+// TicketsUseCaseMock is a mock for Tickets UseCase
+type TicketsUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *TicketsUseCaseMock) FindAll(ctx context.Context, filter domain.TicketFilter) ([]*domain.Ticket, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*domain.Ticket), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *TicketsUseCaseMock) Get(ctx context.Context, institutionId string, id string) (*domain.Ticket, error) {
+	args := m.Called(ctx, institutionId, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Ticket), args.Error(1)
+}
+
+func (m *TicketsUseCaseMock) Create(ctx context.Context, cmd domain.CreateTicketCommand) (*domain.Ticket, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Ticket), args.Error(1)
+}
+
+func (m *TicketsUseCaseMock) Update(ctx context.Context, cmd domain.UpdateTicketCommand) (*domain.Ticket, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Ticket), args.Error(1)
+}
+
+func (m *TicketsUseCaseMock) Assign(ctx context.Context, cmd domain.AssignTicketCommand) (*domain.Ticket, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Ticket), args.Error(1)
+}
+
+func (m *TicketsUseCaseMock) Transition(ctx context.Context, cmd domain.TransitionTicketCommand) (*domain.Ticket, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Ticket), args.Error(1)
+}
+
+func (m *TicketsUseCaseMock) FindComments(ctx context.Context, institutionId string, ticketId string) ([]*domain.TicketComment, error) {
+	args := m.Called(ctx, institutionId, ticketId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TicketComment), args.Error(1)
+}
+
+func (m *TicketsUseCaseMock) AddComment(ctx context.Context, cmd domain.AddCommentCommand) (*domain.TicketComment, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TicketComment), args.Error(1)
+}
+
+func (m *TicketsUseCaseMock) FindHistory(ctx context.Context, institutionId string, ticketId string) ([]*domain.TicketHistory, error) {
+	args := m.Called(ctx, institutionId, ticketId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TicketHistory), args.Error(1)
+}
+
+// TicketsRepositoryMock is a mock for Tickets Repository
+type TicketsRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *TicketsRepositoryMock) FindAll(ctx context.Context, filter domain.TicketFilter) ([]*domain.Ticket, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]*domain.Ticket), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *TicketsRepositoryMock) FindByID(ctx context.Context, id string) (*domain.Ticket, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Ticket), args.Error(1)
+}
+
+func (m *TicketsRepositoryMock) Store(ctx context.Context, ticket *domain.Ticket, history []*domain.TicketHistory) error {
+	args := m.Called(ctx, ticket, history)
+	return args.Error(0)
+}
+
+func (m *TicketsRepositoryMock) Update(ctx context.Context, ticket *domain.Ticket, history []*domain.TicketHistory) error {
+	args := m.Called(ctx, ticket, history)
+	return args.Error(0)
+}
+
+func (m *TicketsRepositoryMock) FindComments(ctx context.Context, ticketId string) ([]*domain.TicketComment, error) {
+	args := m.Called(ctx, ticketId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TicketComment), args.Error(1)
+}
+
+func (m *TicketsRepositoryMock) StoreComment(ctx context.Context, comment *domain.TicketComment) error {
+	args := m.Called(ctx, comment)
+	return args.Error(0)
+}
+
+func (m *TicketsRepositoryMock) FindHistory(ctx context.Context, ticketId string) ([]*domain.TicketHistory, error) {
+	args := m.Called(ctx, ticketId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TicketHistory), args.Error(1)
+}
+
+func (m *TicketsRepositoryMock) DomainExists(ctx context.Context, institutionId string, domainId string) (bool, error) {
+	args := m.Called(ctx, institutionId, domainId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *TicketsRepositoryMock) ShiftGroupExists(ctx context.Context, institutionId string, shiftGroupId string) (bool, error) {
+	args := m.Called(ctx, institutionId, shiftGroupId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *TicketsRepositoryMock) UserExists(ctx context.Context, institutionId string, userId string) (bool, error) {
+	args := m.Called(ctx, institutionId, userId)
+	return args.Bool(0), args.Error(1)
+}
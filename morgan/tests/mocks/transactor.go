@@ -0,0 +1,13 @@
+package mocks
+
+import (
+	"context"
+)
+
+// TransactorMock is a mock implementation of postgres.Transactor.
+// It runs the function directly, without a database transaction.
+type TransactorMock struct{}
+
+func (m *TransactorMock) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}